@@ -16,8 +16,11 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
 	"github.com/keikoproj/manager/pkg/grpc/proto/application"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"strings"
 )
 
 // EDIT THIS FILE!  THIS IS SCAFFOLDING FOR YOU TO OWN!
@@ -37,13 +40,199 @@ type ApplicationStatus struct {
 	RetryCount int `json:"retryCount"`
 	//ErrorDescription in case of error
 	ErrorDescription string `json:"errorDescription,omitempty"`
+	//FailedGeneration is the generation which failed with the permanent error. It is not retried until the spec changes
+	// +optional
+	FailedGeneration int64 `json:"failedGeneration,omitempty"`
+	//ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	//Conditions contains Ready, Reconciling, Degraded and Stalled conditions
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+	//Environments contains the managed namespace chosen for each of the environments and its state
+	// +optional
+	Environments []EnvironmentStatus `json:"environments,omitempty"`
+	//ReadyEnvironments is the number of ready environments out of the total. ex: 2/3
+	// +optional
+	ReadyEnvironments string `json:"readyEnvironments,omitempty"`
+	//Rollout contains the progress of the latest spec through the environments
+	// +optional
+	Rollout *RolloutStatus `json:"rollout,omitempty"`
+	//RemovedEnvironments contains the last environments removed from the application and what happened to their managed namespaces
+	// +optional
+	RemovedEnvironments []EnvironmentRemoval `json:"removedEnvironments,omitempty"`
+}
+
+const (
+	//PrunePolicyPrune deletes the managed namespace of the removed environment
+	PrunePolicyPrune = "Prune"
+	//PrunePolicyRetain leaves the managed namespace of the removed environment without the application as the owner
+	PrunePolicyRetain = "Retain"
+)
+
+// EnvironmentStatus defines the observed state of the application environment
+type EnvironmentStatus struct {
+	//Name of the environment
+	Name string `json:"name"`
+	//NamespaceName is the name of the managed namespace created for the environment
+	//Once chosen, the name changes only if the environment overrides it
+	NamespaceName string `json:"namespaceName"`
+	//ClusterName is the cluster the namespace is created in
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+	//State of the managed namespace
+	// +optional
+	State State `json:"state,omitempty"`
+	//ErrorDescription of the managed namespace in case of error
+	// +optional
+	ErrorDescription string `json:"errorDescription,omitempty"`
+	//Outdated is true while the environment waits for the rollout of the latest spec
+	// +optional
+	Outdated bool `json:"outdated,omitempty"`
+	//ReadySince is the time the managed namespace became ready with the latest spec. Rollout soak time starts from here
+	// +optional
+	ReadySince *metav1.Time `json:"readySince,omitempty"`
+}
+
+const (
+	//RolloutAllAtOnce updates all the environments together
+	RolloutAllAtOnce = "AllAtOnce"
+	//RolloutProgressive updates the environments one after another in the listed order
+	RolloutProgressive = "Progressive"
+)
+
+//RolloutPhase is the phase of the progressive rollout
+type RolloutPhase string
+
+const (
+	//RolloutProgressing is used while the updated environment is getting ready
+	RolloutProgressing RolloutPhase = "Progressing"
+	//RolloutSoaking is used while the updated environment is ready for less than the soak time
+	RolloutSoaking RolloutPhase = "Soaking"
+	//RolloutAwaitingPromotion is used while the next environment waits for the manual promotion
+	RolloutAwaitingPromotion RolloutPhase = "AwaitingPromotion"
+	//RolloutHalted is used when the updated environment failed. Next environments are not updated until it recovers
+	RolloutHalted RolloutPhase = "Halted"
+	//RolloutCompleted is used once all the environments are updated
+	RolloutCompleted RolloutPhase = "Completed"
+)
+
+// RolloutStatus defines the progress of the progressive rollout
+type RolloutStatus struct {
+	//Phase of the rollout
+	Phase RolloutPhase `json:"phase"`
+	//Environment is the next environment waiting to be updated
+	// +optional
+	Environment string `json:"environment,omitempty"`
+	//Message explains what the rollout is waiting for
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// EnvironmentRemoval records the clean up of the environment removed from the application
+type EnvironmentRemoval struct {
+	//Name of the environment
+	Name string `json:"name"`
+	//NamespaceName is the name of the managed namespace created for the environment
+	NamespaceName string `json:"namespaceName"`
+	//PrunePolicy applied to the managed namespace
+	PrunePolicy string `json:"prunePolicy"`
+	//RemovalTime is the time managed namespace got deleted or released
+	RemovalTime metav1.Time `json:"removalTime"`
+}
+
+//DefaultNamingPattern is used to name the managed namespaces of the environments unless configured otherwise
+const DefaultNamingPattern = "{app}-{env}"
+
+//EnvironmentNamespaceName returns the name of the managed namespace created for the environment
+//Name override of the environment takes precedence. Otherwise the name chosen earlier is kept so that
+//changing the naming pattern doesn't rename the existing namespaces. New environments use the naming pattern
+func (a *Application) EnvironmentNamespaceName(env *application.Environment, pattern string) (string, error) {
+	name := env.NamespaceName
+	if name == "" {
+		name = a.RecordedNamespaceName(env.Name)
+	}
+	if name == "" {
+		if pattern == "" {
+			pattern = DefaultNamingPattern
+		}
+		var clusterName string
+		if env.Namespace != nil {
+			clusterName = env.Namespace.ClusterName
+		}
+		name = pattern
+		for placeholder, value := range map[string]string{"{app}": a.Spec.AppName, "{env}": env.Name, "{cluster}": clusterName, "{team}": a.Spec.Team} {
+			if value == "" && strings.Contains(pattern, placeholder) {
+				return "", fmt.Errorf("naming pattern %s uses %s but it is not provided for environment %s", pattern, placeholder, env.Name)
+			}
+			name = strings.ReplaceAll(name, placeholder, value)
+		}
+	}
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return "", fmt.Errorf("namespace name %s of environment %s is invalid: %s", name, env.Name, strings.Join(errs, ", "))
+	}
+	return name, nil
+}
+
+//RecordedNamespaceName returns the name of the managed namespace recorded in the status for the environment
+func (a *Application) RecordedNamespaceName(envName string) string {
+	for _, env := range a.Status.Environments {
+		if env.Name == envName {
+			return env.NamespaceName
+		}
+	}
+	return ""
+}
+
+//EnvironmentSummary returns the overall state of the application based on the state of each of the environments
+func (s *ApplicationStatus) EnvironmentSummary() (State, string) {
+	failed, pending := 0, 0
+	for _, env := range s.Environments {
+		//Environment waiting for the rollout is pending irrespective of the state of the earlier spec
+		if env.Outdated {
+			pending++
+			continue
+		}
+		switch env.State {
+		case Ready:
+		case Warning, Error, Failed:
+			failed++
+		default:
+			pending++
+		}
+	}
+	if failed > 0 {
+		state := Warning
+		if failed >= len(s.Environments) {
+			state = Error
+		}
+		return state, fmt.Sprintf("managed namespace failed in %d of %d environments", failed, len(s.Environments))
+	}
+	if pending > 0 {
+		return Pending, fmt.Sprintf("waiting for the managed namespace in %d of %d environments", pending, len(s.Environments))
+	}
+	return Ready, ""
+}
+
+//IsFailed returns true if the reconcile of the current spec failed with the permanent error
+func (a *Application) IsFailed() bool {
+	return a.Status.State == Failed && a.Status.FailedGeneration == a.Generation
+}
+
+//MarkReconciled records the outcome of the reconcile of the current spec as the standard conditions
+func (a *Application) MarkReconciled(state State) {
+	a.Status.ObservedGeneration = a.Generation
+	SetStateConditions(&a.Status.Conditions, state, a.Status.ErrorDescription)
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=applications,scope=Cluster,shortName=app,singular=application
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="current state of the target application"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="whether the current spec is reconciled"
+// +kubebuilder:printcolumn:name="Environments",type="string",JSONPath=".status.readyEnvironments",description="ready environments out of the total"
 // +kubebuilder:printcolumn:name="RetryCount",type="integer",JSONPath=".status.retryCount",description="Retry count"
+// +kubebuilder:printcolumn:name="Rollout",type="string",JSONPath=".status.rollout.phase",description="phase of the progressive rollout",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="time passed since managed application creation"
 // Application is the Schema for the Application API
 type Application struct {
@@ -0,0 +1,65 @@
+package v1alpha1_test
+
+import (
+	"github.com/keikoproj/manager/api/v1alpha1"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("ApplicationStatus", func() {
+	Describe("EnvironmentSummary", func() {
+		It("should summarize the state of the environments", func() {
+			for _, entry := range []struct {
+				name         string
+				environments []v1alpha1.EnvironmentStatus
+				state        v1alpha1.State
+				desc         string
+			}{
+				{
+					name:  "no environments",
+					state: v1alpha1.Ready,
+				},
+				{
+					name:         "all ready",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Ready}, {State: v1alpha1.Ready}},
+					state:        v1alpha1.Ready,
+				},
+				{
+					name:         "some pending",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Ready}, {State: v1alpha1.Pending}, {}},
+					state:        v1alpha1.Pending,
+					desc:         "waiting for the managed namespace in 2 of 3 environments",
+				},
+				{
+					name:         "ready but outdated",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Ready}, {State: v1alpha1.Ready, Outdated: true}},
+					state:        v1alpha1.Pending,
+					desc:         "waiting for the managed namespace in 1 of 2 environments",
+				},
+				{
+					name:         "failed but outdated",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Ready}, {State: v1alpha1.Error, Outdated: true}},
+					state:        v1alpha1.Pending,
+					desc:         "waiting for the managed namespace in 1 of 2 environments",
+				},
+				{
+					name:         "some failed",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Ready}, {State: v1alpha1.Warning}, {State: v1alpha1.Pending}},
+					state:        v1alpha1.Warning,
+					desc:         "managed namespace failed in 1 of 3 environments",
+				},
+				{
+					name:         "all failed",
+					environments: []v1alpha1.EnvironmentStatus{{State: v1alpha1.Error}, {State: v1alpha1.Failed}},
+					state:        v1alpha1.Error,
+					desc:         "managed namespace failed in 2 of 2 environments",
+				},
+			} {
+				status := &v1alpha1.ApplicationStatus{Environments: entry.environments}
+				state, desc := status.EnvironmentSummary()
+				Expect(state).To(Equal(entry.state), entry.name)
+				Expect(desc).To(Equal(entry.desc), entry.name)
+			}
+		})
+	})
+})
@@ -17,6 +17,7 @@ package v1alpha1
 
 import (
 	"github.com/keikoproj/manager/pkg/grpc/proto/cluster"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -35,6 +36,10 @@ const (
 	Ready   State = "Ready"
 	Warning State = "Warning"
 	Error   State = "Error"
+	//Pending is used while waiting for the agent to apply the namespace in the cluster
+	Pending State = "Pending"
+	//Failed is used when the reconcile failed with the permanent error. Retries are stopped until the spec changes
+	Failed State = "Failed"
 )
 
 // ClusterStatus defines the observed state of Cluster
@@ -45,15 +50,120 @@ type ClusterStatus struct {
 	RetryCount int `json:"retryCount"`
 	//ErrorDescription in case of error
 	ErrorDescription string `json:"errorDescription,omitempty"`
+	//FailedGeneration is the generation which failed with the permanent error. It is not retried until the spec changes
+	// +optional
+	FailedGeneration int64 `json:"failedGeneration,omitempty"`
+	//ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
 	//Total Number of Namespaces in the managed cluster
 	NamespaceCount int `json:"namespaceCount"`
+	//NamespaceStateCounts contains the number of managed namespaces in the managed cluster per state
+	// +optional
+	NamespaceStateCounts map[State]int `json:"namespaceStateCounts,omitempty"`
+	//KubernetesVersion of the managed cluster
+	// +optional
+	KubernetesVersion string `json:"kubernetesVersion,omitempty"`
+	//NodeCount is the number of nodes in the managed cluster
+	// +optional
+	NodeCount int `json:"nodeCount,omitempty"`
+	//AllocatableCPU is the total allocatable cpu across all the nodes in the managed cluster
+	// +optional
+	AllocatableCPU *resource.Quantity `json:"allocatableCPU,omitempty"`
+	//AllocatableMemory is the total allocatable memory across all the nodes in the managed cluster
+	// +optional
+	AllocatableMemory *resource.Quantity `json:"allocatableMemory,omitempty"`
+	//APILatency is the managed cluster api server latency observed in the last probe
+	// +optional
+	APILatency *metav1.Duration `json:"apiLatency,omitempty"`
+	//LastProbeTime is the last time managed cluster got probed successfully
+	// +optional
+	LastProbeTime *metav1.Time `json:"lastProbeTime,omitempty"`
+	//Conditions contains Reachable, Authenticated and RBACHealthy conditions of the managed cluster
+	//along with Ready, Reconciling, Degraded and Stalled conditions
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+	//TokenRotation contains the bearer token rotation details
+	// +optional
+	TokenRotation *TokenRotationStatus `json:"tokenRotation,omitempty"`
+}
+
+const (
+	//DeletionPolicyBlock blocks the cluster deletion while managed namespaces exist
+	DeletionPolicyBlock = "Block"
+	//DeletionPolicyCascade deletes the namespaces in the managed cluster along with the managed namespaces
+	DeletionPolicyCascade = "Cascade"
+)
+
+const (
+	//ClusterModeDirect is used when manager connects to the cluster api server directly
+	ClusterModeDirect = "direct"
+	//ClusterModeAgent is used when agent running in the cluster pulls the desired state from manager
+	ClusterModeAgent = "agent"
+)
+
+//IsAgentMode returns true if the cluster is managed through the agent running in the cluster
+func (c *Cluster) IsAgentMode() bool {
+	return c.Spec.Mode == ClusterModeAgent
+}
+
+//IsFailed returns true if the reconcile of the current spec failed with the permanent error
+func (c *Cluster) IsFailed() bool {
+	return c.Status.State == Failed && c.Status.FailedGeneration == c.Generation
+}
+
+//MarkReconciled records the outcome of the reconcile of the current spec as the standard conditions
+func (c *Cluster) MarkReconciled(state State) {
+	c.Status.ObservedGeneration = c.Generation
+	SetStateConditions(&c.Status.Conditions, state, c.Status.ErrorDescription)
+}
+
+type TokenRotationResult string
+
+const (
+	TokenRotationSucceeded TokenRotationResult = "Succeeded"
+	TokenRotationFailed    TokenRotationResult = "Failed"
+)
+
+// TokenRotationStatus defines the bearer token rotation state of the managed cluster
+type TokenRotationStatus struct {
+	//CurrentTokenSecret is the service account token secret name in the managed cluster which backs the bearer token in use
+	// +optional
+	CurrentTokenSecret string `json:"currentTokenSecret,omitempty"`
+	//LastRotationTime is the time when token got rotated successfully last time
+	// +optional
+	LastRotationTime *metav1.Time `json:"lastRotationTime,omitempty"`
+	//NextRotationTime is the time when token is due for rotation
+	// +optional
+	NextRotationTime *metav1.Time `json:"nextRotationTime,omitempty"`
+	//History contains the most recent rotation attempts
+	// +optional
+	History []TokenRotationRecord `json:"history,omitempty"`
+}
+
+// TokenRotationRecord represents one token rotation attempt
+type TokenRotationRecord struct {
+	//Time of the rotation attempt
+	Time metav1.Time `json:"time"`
+	//Result of the rotation attempt
+	Result TokenRotationResult `json:"result"`
+	//TokenSecret is the service account token secret name created in the managed cluster
+	// +optional
+	TokenSecret string `json:"tokenSecret,omitempty"`
+	//Message contains the error description in case of failure
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:path=clusters,scope=Namespaced,shortName=cl,singular=cluster
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="current state of the target cluster"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="whether the current spec is reconciled"
 // +kubebuilder:printcolumn:name="RetryCount",type="integer",JSONPath=".status.retryCount",description="Retry count"
+// +kubebuilder:printcolumn:name="Version",type="string",JSONPath=".status.kubernetesVersion",description="kubernetes version of the target cluster"
+// +kubebuilder:printcolumn:name="Nodes",type="integer",JSONPath=".status.nodeCount",description="number of nodes in the target cluster"
+// +kubebuilder:printcolumn:name="Namespaces",type="integer",JSONPath=".status.namespaceCount",description="number of managed namespaces in the target cluster"
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="time passed since managed cluster registration"
 // Cluster is the Schema for the clusters API
 type Cluster struct {
@@ -0,0 +1,118 @@
+/*
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ConditionType represents the type of the condition
+type ConditionType string
+
+const (
+	//Reachable condition represents whether the managed cluster api server is reachable
+	Reachable ConditionType = "Reachable"
+	//Authenticated condition represents whether the manager credentials are accepted by the managed cluster
+	Authenticated ConditionType = "Authenticated"
+	//RBACHealthy condition represents whether the manager has all the permissions it needs in the managed cluster
+	RBACHealthy ConditionType = "RBACHealthy"
+
+	//Standard conditions understood by kstatus, Argo CD and kubectl wait. All the resources report them
+
+	//ReadyCondition represents whether the current spec is reconciled successfully
+	ReadyCondition ConditionType = "Ready"
+	//ReconcilingCondition represents whether the controller is still working towards the current spec
+	ReconcilingCondition ConditionType = "Reconciling"
+	//DegradedCondition represents whether the last reconcile failed
+	DegradedCondition ConditionType = "Degraded"
+	//StalledCondition represents whether the controller stopped retrying until the spec changes
+	StalledCondition ConditionType = "Stalled"
+)
+
+// Condition represents an observation of the resource state
+type Condition struct {
+	//Type of the condition
+	Type ConditionType `json:"type"`
+	//Status of the condition, one of True, False, Unknown
+	Status corev1.ConditionStatus `json:"status"`
+	//LastTransitionTime is the last time the condition transitioned from one status to another
+	// +optional
+	LastTransitionTime metav1.Time `json:"lastTransitionTime,omitempty"`
+	//Reason contains a programmatic identifier indicating the reason for the condition's last transition
+	// +optional
+	Reason string `json:"reason,omitempty"`
+	//Message contains a human readable message indicating details about the transition
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+//SetCondition adds or updates the condition in the list. LastTransitionTime changes only if status changes
+func SetCondition(conditions *[]Condition, condition Condition) {
+	for i := range *conditions {
+		existing := &(*conditions)[i]
+		if existing.Type != condition.Type {
+			continue
+		}
+		if existing.Status != condition.Status {
+			existing.LastTransitionTime = metav1.Now()
+		}
+		existing.Status = condition.Status
+		existing.Reason = condition.Reason
+		existing.Message = condition.Message
+		return
+	}
+	condition.LastTransitionTime = metav1.Now()
+	*conditions = append(*conditions, condition)
+}
+
+//SetStateConditions sets the standard conditions based on the state of the resource
+func SetStateConditions(conditions *[]Condition, state State, message string) {
+	ready, reconciling, degraded, stalled := corev1.ConditionFalse, corev1.ConditionFalse, corev1.ConditionFalse, corev1.ConditionFalse
+	reason := string(state)
+	switch state {
+	case Ready:
+		ready = corev1.ConditionTrue
+		reason = "Reconciled"
+		message = ""
+	case Pending:
+		reconciling = corev1.ConditionTrue
+	case Warning, Error:
+		reconciling, degraded = corev1.ConditionTrue, corev1.ConditionTrue
+	case Failed:
+		degraded, stalled = corev1.ConditionTrue, corev1.ConditionTrue
+	}
+	SetCondition(conditions, Condition{Type: ReadyCondition, Status: ready, Reason: reason, Message: message})
+	SetCondition(conditions, Condition{Type: ReconcilingCondition, Status: reconciling, Reason: reason, Message: message})
+	SetCondition(conditions, Condition{Type: DegradedCondition, Status: degraded, Reason: reason, Message: message})
+	SetCondition(conditions, Condition{Type: StalledCondition, Status: stalled, Reason: reason, Message: message})
+}
+
+//GetCondition returns the condition with the type if present
+func GetCondition(conditions []Condition, conditionType ConditionType) *Condition {
+	for i := range conditions {
+		if conditions[i].Type == conditionType {
+			return &conditions[i]
+		}
+	}
+	return nil
+}
+
+//IsConditionTrue returns true only if the condition is present with status True
+func IsConditionTrue(conditions []Condition, conditionType ConditionType) bool {
+	condition := GetCondition(conditions, conditionType)
+	return condition != nil && condition.Status == corev1.ConditionTrue
+}
@@ -16,6 +16,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	"fmt"
 	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
@@ -28,6 +29,13 @@ type ManagedNamespaceSpec struct {
 	namespace.Namespace `json:",inline"`
 }
 
+const (
+	//DeletionPolicyDelete deletes the namespace in the managed cluster
+	DeletionPolicyDelete = "Delete"
+	//DeletionPolicyOrphan leaves the namespace as is in the managed cluster
+	DeletionPolicyOrphan = "Orphan"
+)
+
 // ManagedNamespaceStatus defines the observed state of ManagedNamespace
 type ManagedNamespaceStatus struct {
 	//State of the resource
@@ -36,12 +44,119 @@ type ManagedNamespaceStatus struct {
 	RetryCount int `json:"retryCount"`
 	//ErrorDescription in case of error
 	ErrorDescription string `json:"errorDescription,omitempty"`
+	//FailedGeneration is the generation which failed with the permanent error. It is not retried until the spec changes
+	// +optional
+	FailedGeneration int64 `json:"failedGeneration,omitempty"`
+	//ObservedGeneration is the generation last processed by the controller
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	//Conditions contains Ready, Reconciling, Degraded and Stalled conditions
+	// +optional
+	Conditions []Condition `json:"conditions,omitempty"`
+	//ClusterName is the cluster chosen by the placement when namespace uses cluster selector
+	//Once chosen, namespace stays in the cluster
+	// +optional
+	ClusterName string `json:"clusterName,omitempty"`
+	//Clusters contains the state of the namespace in each of the target clusters
+	// +optional
+	Clusters []ClusterNamespaceStatus `json:"clusters,omitempty"`
+}
+
+// ClusterNamespaceStatus defines the observed state of the namespace in one of the target clusters
+type ClusterNamespaceStatus struct {
+	//ClusterName is the name of the target cluster
+	ClusterName string `json:"clusterName"`
+	//Namespace is the name of the namespace created in the target cluster
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+	//State of the namespace in the target cluster
+	State State `json:"state,omitempty"`
+	//ErrorDescription in case of error
+	ErrorDescription string `json:"errorDescription,omitempty"`
+	//LastSyncTime is the last time namespace got reconciled successfully in the target cluster
+	// +optional
+	LastSyncTime *metav1.Time `json:"lastSyncTime,omitempty"`
+}
+
+//TargetClusterNames returns the clusters in which the namespace to be created.
+//Explicit cluster names in the spec take precedence over the cluster chosen by the placement
+func (m *ManagedNamespace) TargetClusterNames() []string {
+	var names []string
+	if m.Spec.ClusterName != "" {
+		names = append(names, m.Spec.ClusterName)
+	}
+	for _, name := range m.Spec.ClusterNames {
+		if name == "" || containsString(names, name) {
+			continue
+		}
+		names = append(names, name)
+	}
+	if len(names) == 0 && m.Status.ClusterName != "" {
+		names = append(names, m.Status.ClusterName)
+	}
+	return names
+}
+
+//ClusterStatus returns the status of the namespace in the cluster if present
+func (m *ManagedNamespace) ClusterStatus(clusterName string) *ClusterNamespaceStatus {
+	for i := range m.Status.Clusters {
+		if m.Status.Clusters[i].ClusterName == clusterName {
+			return &m.Status.Clusters[i]
+		}
+	}
+	return nil
+}
+
+//IsFailed returns true if the reconcile of the current spec failed with the permanent error
+func (m *ManagedNamespace) IsFailed() bool {
+	return m.Status.State == Failed && m.Status.FailedGeneration == m.Generation
+}
+
+//MarkReconciled records the outcome of the reconcile of the current spec as the standard conditions
+func (m *ManagedNamespace) MarkReconciled(state State) {
+	m.Status.ObservedGeneration = m.Generation
+	SetStateConditions(&m.Status.Conditions, state, m.Status.ErrorDescription)
+}
+
+//ClusterSummary returns the overall state of the namespace based on the state in each of the target clusters
+func (s *ManagedNamespaceStatus) ClusterSummary() (State, string) {
+	failed, pending := 0, 0
+	for _, cs := range s.Clusters {
+		switch cs.State {
+		case Error:
+			failed++
+		case Pending:
+			pending++
+		}
+	}
+	if failed > 0 {
+		state := Warning
+		if failed >= len(s.Clusters) {
+			state = Error
+		}
+		return state, fmt.Sprintf("namespace failed in %d of %d clusters", failed, len(s.Clusters))
+	}
+	if pending > 0 {
+		return Pending, fmt.Sprintf("waiting for the agent in %d of %d clusters", pending, len(s.Clusters))
+	}
+	return Ready, ""
+}
+
+func containsString(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
 }
 
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:printcolumn:name="State",type="string",JSONPath=".status.state",description="current state of the managed namespace"
+// +kubebuilder:printcolumn:name="Ready",type="string",JSONPath=".status.conditions[?(@.type==\"Ready\")].status",description="whether the current spec is reconciled"
 // +kubebuilder:printcolumn:name="RetryCount",type="integer",JSONPath=".status.retryCount",description="Retry count"
+// +kubebuilder:printcolumn:name="Cluster",type="string",JSONPath=".status.clusterName",description="cluster chosen by the placement",priority=1
 // +kubebuilder:printcolumn:name="Age",type="date",JSONPath=".metadata.creationTimestamp",description="time passed since managed namespace created"
 // +kubebuilder:resource:path=managednamespaces,scope=Namespaced,shortName=mns,singular=managednamespace
 // ManagedNamespace is the Schema for the managednamespaces API
@@ -0,0 +1,89 @@
+package main
+
+import (
+	"context"
+	"flag"
+	"github.com/keikoproj/manager/pkg/agent"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"k8s.io/client-go/rest"
+	"k8s.io/client-go/tools/clientcmd"
+	"os"
+	"os/signal"
+	"syscall"
+	"time"
+
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+)
+
+var (
+	tls                = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
+	caFile             = flag.String("ca_file", "", "The file containing the CA root cert file")
+	serverAddr         = flag.String("server_addr", "localhost:10000", "The manager server address in the format of host:port")
+	serverHostOverride = flag.String("server_host_override", "", "The server name use to verify the hostname returned by TLS handshake")
+	clusterName        = flag.String("cluster_name", os.Getenv("CLUSTER_NAME"), "Name of the cluster agent is running in as registered with the manager")
+	token              = flag.String("token", os.Getenv("AGENT_TOKEN"), "Agent token returned during the cluster registration")
+	interval           = flag.Duration("interval", 60*time.Second, "How often agent syncs with the manager")
+)
+
+func main() {
+	log.New()
+	log := log.Logger(context.Background(), "agent", "main")
+
+	flag.Parse()
+	if *clusterName == "" || *token == "" {
+		log.Error(nil, "cluster name and agent token must be provided")
+		os.Exit(1)
+	}
+
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		config, err = clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+		if err != nil {
+			log.Error(err, "unable to load the kubernetes config")
+			os.Exit(1)
+		}
+	}
+	k8sClient, err := k8s.NewK8sManagedClusterClient(config)
+	if err != nil {
+		log.Error(err, "unable to create the kubernetes client")
+		os.Exit(1)
+	}
+
+	opts := []grpc.DialOption{grpc.WithPerRPCCredentials(agent.TokenCredentials{Token: *token, Secure: *tls})}
+	if *tls {
+		creds, err := credentials.NewClientTLSFromFile(*caFile, *serverHostOverride)
+		if err != nil {
+			log.Error(err, "failed to create TLS credentials")
+			os.Exit(1)
+		}
+		opts = append(opts, grpc.WithTransportCredentials(creds))
+	} else {
+		opts = append(opts, grpc.WithInsecure())
+	}
+	conn, err := grpc.Dial(*serverAddr, opts...)
+	if err != nil {
+		log.Error(err, "failed to create grpc connection")
+		os.Exit(1)
+	}
+	defer conn.Close()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	a := &agent.Agent{
+		ClusterName: *clusterName,
+		Client:      apis.NewAgentServiceClient(conn),
+		K8sClient:   k8sClient,
+		Interval:    *interval,
+	}
+	log.Info("Agent is up and running", "cluster", *clusterName, "server", *serverAddr)
+	a.Run(ctx)
+}
@@ -0,0 +1,291 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/grpc"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"time"
+
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	pb "github.com/keikoproj/manager/pkg/grpc/proto/application"
+)
+
+// NewAppCommand returns a new instance of an `manager app` command
+func NewAppCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "app",
+		Short: "Manage application operations",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: `  # Create an application from the Application manifest
+  manager app create -f app.yaml
+  # Get the application along with the status of each environment
+  manager app get my-app -o yaml
+  # Add an environment to the application
+  manager app add-env my-app -f qa-env.yaml
+`,
+	}
+
+	command.AddCommand(NewAppCreateCommand())
+	command.AddCommand(NewAppGetCommand())
+	command.AddCommand(NewAppListCommand())
+	command.AddCommand(NewAppUpdateCommand())
+	command.AddCommand(NewAppDeleteCommand())
+	command.AddCommand(NewAppAddEnvCommand())
+	command.AddCommand(NewAppRemoveEnvCommand())
+	return command
+}
+
+//NewAppCreateCommand creates the application from the Application manifest
+func NewAppCreateCommand() *cobra.Command {
+	var (
+		fileName string
+		output   string
+	)
+
+	var command = &cobra.Command{
+		Use:     "create",
+		Short:   fmt.Sprintf("%s app create", "manager"),
+		Long:    "Create the application. Manager creates a managed namespace for each environment",
+		Example: "manager app create -f app.yaml",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			app := readApplicationFile(fileName)
+			resp, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().CreateApplication(ctx, app)
+			utils.StopIfError(err)
+			utils.StopIfError(printApplication(output, resp))
+		},
+	}
+
+	command.Flags().StringVarP(&fileName, "file", "f", "", "Application manifest in yaml or json format")
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewAppGetCommand gets the application along with the environment status
+func NewAppGetCommand() *cobra.Command {
+	var (
+		output string
+		watch  bool
+	)
+
+	var command = &cobra.Command{
+		Use:     "get NAME",
+		Short:   fmt.Sprintf("%s app get", "manager"),
+		Long:    "Get the application along with the status of the namespace in each environment",
+		Example: "manager app get my-app -o yaml",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().GetApplication(ctx, &apis.GetApplicationRequest{Name: args[0]})
+			utils.StopIfError(err)
+			utils.StopIfError(printApplication(output, resp))
+			if watch {
+				watchResource("Application", resp.Name, resp.ResourceVersion, output)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "After getting the application, watch for the changes")
+	return command
+}
+
+//NewAppListCommand lists all the applications
+func NewAppListCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "list",
+		Short:   fmt.Sprintf("%s app list", "manager"),
+		Long:    "List the applications managed by manager",
+		Example: "manager app list",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			client := grpc.NewConnectionOrDie().NewApplicationClientOrDie()
+			var items []*apis.Application
+			req := &apis.ListApplicationsRequest{}
+			for {
+				resp, err := client.ListApplications(ctx, req)
+				utils.StopIfError(err)
+				items = append(items, resp.Items...)
+				if resp.NextPageToken == "" {
+					break
+				}
+				req.PageToken = resp.NextPageToken
+			}
+			utils.StopIfError(printOutput(output, items, func(w io.Writer) {
+				fmt.Fprintln(w, "NAME\tSTATE\tENVIRONMENTS\tAGE")
+				for _, app := range items {
+					fmt.Fprintf(w, "%s\t%s\t%d/%d\t%s\n", app.Name, app.Status.State, app.Status.ReadyEnvironments, len(app.Status.Environments), age(app.CreationTimestamp))
+				}
+			}))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewAppUpdateCommand updates the application from the Application manifest
+func NewAppUpdateCommand() *cobra.Command {
+	var (
+		fileName string
+		output   string
+	)
+
+	var command = &cobra.Command{
+		Use:     "update",
+		Short:   fmt.Sprintf("%s app update", "manager"),
+		Long:    "Update the application spec and labels. Provide metadata.resourceVersion to avoid overwriting concurrent changes",
+		Example: "manager app update -f app.yaml",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			app := readApplicationFile(fileName)
+			resp, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().UpdateApplication(ctx, app)
+			utils.StopIfError(err)
+			utils.StopIfError(printApplication(output, resp))
+		},
+	}
+
+	command.Flags().StringVarP(&fileName, "file", "f", "", "Application manifest in yaml or json format")
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewAppDeleteCommand deletes the application
+func NewAppDeleteCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:     "delete NAME",
+		Short:   fmt.Sprintf("%s app delete", "manager"),
+		Long:    "Delete the application",
+		Example: "manager app delete my-app",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			_, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().DeleteApplication(ctx, &apis.DeleteApplicationRequest{Name: args[0]})
+			utils.StopIfError(err)
+			fmt.Printf("Successfully deleted %s application\n", args[0])
+		},
+	}
+	return command
+}
+
+//NewAppAddEnvCommand adds an environment to the application
+func NewAppAddEnvCommand() *cobra.Command {
+	var (
+		fileName string
+		output   string
+	)
+
+	var command = &cobra.Command{
+		Use:     "add-env NAME",
+		Short:   fmt.Sprintf("%s app add-env", "manager"),
+		Long:    "Add an environment to the application. File must contain the environment name and namespace",
+		Example: "manager app add-env my-app -f qa-env.yaml",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			env := &pb.Environment{}
+			utils.StopIfError(readInputFile(fileName, env))
+			req := &apis.AddEnvironmentRequest{
+				Name:        args[0],
+				Environment: env,
+			}
+			resp, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().AddEnvironment(ctx, req)
+			utils.StopIfError(err)
+			utils.StopIfError(printApplication(output, resp))
+		},
+	}
+
+	command.Flags().StringVarP(&fileName, "file", "f", "", "Environment in yaml or json format")
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewAppRemoveEnvCommand removes an environment from the application
+func NewAppRemoveEnvCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "remove-env NAME ENVIRONMENT",
+		Short:   fmt.Sprintf("%s app remove-env", "manager"),
+		Long:    "Remove an environment from the application",
+		Example: "manager app remove-env my-app qa",
+		Args:    cobra.ExactArgs(2),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			req := &apis.RemoveEnvironmentRequest{
+				Name:            args[0],
+				EnvironmentName: args[1],
+			}
+			resp, err := grpc.NewConnectionOrDie().NewApplicationClientOrDie().RemoveEnvironment(ctx, req)
+			utils.StopIfError(err)
+			utils.StopIfError(printApplication(output, resp))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//readApplicationFile reads the Application manifest and converts it to the api request
+func readApplicationFile(fileName string) *apis.Application {
+	cr := &v1alpha1.Application{}
+	utils.StopIfError(readInputFile(fileName, cr))
+	spec := cr.Spec.Application
+	return &apis.Application{
+		Name:            cr.Name,
+		Labels:          cr.Labels,
+		Spec:            &spec,
+		ResourceVersion: cr.ResourceVersion,
+	}
+}
+
+func printApplication(output string, app *apis.Application) error {
+	return printOutput(output, app, func(w io.Writer) {
+		fmt.Fprintf(w, "Name:\t%s\n", app.Name)
+		fmt.Fprintf(w, "State:\t%s\n", app.Status.State)
+		if app.Status.ErrorDescription != "" {
+			fmt.Fprintf(w, "Error:\t%s\n", app.Status.ErrorDescription)
+		}
+		if len(app.Status.Environments) == 0 {
+			return
+		}
+		fmt.Fprintf(w, "Environments:\t%d/%d ready\n\n", app.Status.ReadyEnvironments, len(app.Status.Environments))
+		fmt.Fprintln(w, "ENVIRONMENT\tNAMESPACE\tCLUSTER\tSTATE\tERROR")
+		for _, env := range app.Status.Environments {
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%s\n", env.Name, env.NamespaceName, "", env.State, env.ErrorDescription)
+			for _, cl := range env.Clusters {
+				fmt.Fprintf(w, "\t%s\t%s\t%s\t%s\n", cl.Namespace, cl.ClusterName, cl.State, cl.ErrorDescription)
+			}
+		}
+	})
+}
+
+//age returns the human readable time elapsed since the RFC3339 timestamp
+func age(timestamp string) string {
+	t, err := time.Parse(time.RFC3339, timestamp)
+	if err != nil {
+		return ""
+	}
+	return time.Since(t).Round(time.Second).String()
+}
@@ -3,11 +3,14 @@ package commands
 import (
 	"context"
 	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
 	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/internal/utils"
 	"github.com/keikoproj/manager/pkg/grpc"
 	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/rbac"
 	"github.com/spf13/cobra"
+	"io"
 	"k8s.io/api/rbac/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
@@ -17,6 +20,9 @@ import (
 
 	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
 	pb "github.com/keikoproj/manager/pkg/grpc/proto/cluster"
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // NewClusterCommand returns a new instance of an `manager cluster` command
@@ -32,11 +38,17 @@ func NewClusterCommand() *cobra.Command {
   manager cluster register -ctx admins@iksm-ppd-usw2-k8s
   #	Remove managed cluster from manager
   manager cluster unregister -c admins@iksm-ppd-usw2-k8s
+  # Describe the cluster along with the namespaces hosted on it
+  manager cluster describe iksm-ppd-usw2-k8s
 `,
 	}
 
 	command.AddCommand(NewClusterRegisterCommand())
 	command.AddCommand(NewClusterUnregisterCommand())
+	command.AddCommand(NewClusterSyncRBACCommand())
+	command.AddCommand(NewClusterListCommand())
+	command.AddCommand(NewClusterGetCommand())
+	command.AddCommand(NewClusterDescribeCommand())
 	return command
 }
 
@@ -49,29 +61,43 @@ func NewClusterRegisterCommand() *cobra.Command {
 		self           bool
 		serviceAccount string
 		configContext  string
+		labels         map[string]string
+		deletionPolicy string
+		mode           string
+		agentAddr      string
+		escalation     bool
 	)
 
 	var command = &cobra.Command{
 		Use:     "register",
 		Short:   fmt.Sprintf("%s cluster register", "manager"),
 		Long:    "Add/register managed cluster with manager",
-		Example: "manager cluster register -c admins@iksm-ppd-usw2-k8s",
+		Example: "manager cluster register -c admins@iksm-ppd-usw2-k8s -l env=prod,region=us-west-2",
 		Run: func(c *cobra.Command, args []string) {
 			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
 			defer cancel()
+			if mode == v1alpha1.ClusterModeAgent && agentAddr == "" {
+				log.Fatalf("--agent-server-addr is required in agent mode")
+			}
 			conf, name := getManagedClusterKubeConfig(configContext)
 			managedClusterClient := k8s.NewK8sManagedClusterClientDoOrDie(conf)
+			var token string
 			if serviceAccount == "" {
-				createRBACInManagedCluster(ctx, managedClusterClient)
-				serviceAccount = common.ManagerServiceAccountName
+				createRBACInManagedCluster(ctx, managedClusterClient, managedClusterRules(ctx, managedClusterClient, escalation))
+				token = managerToken(ctx, managedClusterClient)
+			} else {
+				var err error
+				token, err = managedClusterClient.GetServiceAccountTokenSecret(ctx, serviceAccount, common.SystemNameSpace)
+				utils.StopIfError(err)
 			}
-			token, err := managedClusterClient.GetServiceAccountTokenSecret(ctx, serviceAccount, common.SystemNameSpace)
-			utils.StopIfError(err)
 			fmt.Printf("token received successfully\n")
 			//Create cluster request
 			cl := &pb.Cluster{
-				Name:  name,
-				Cloud: "AWS",
+				Name:           name,
+				Cloud:          "AWS",
+				Labels:         labels,
+				DeletionPolicy: deletionPolicy,
+				Mode:           mode,
 				Config: &pb.Config{
 					Host:        conf.Host,
 					BearerToken: token,
@@ -90,13 +116,51 @@ func NewClusterRegisterCommand() *cobra.Command {
 			//Call Server
 			resp, err := grpc.NewConnectionOrDie().NewClusterClientOrDie().RegisterCluster(ctx, cl)
 			utils.StopIfError(err)
+			if mode == v1alpha1.ClusterModeAgent {
+				createAgentSecretInManagedCluster(ctx, managedClusterClient, name, resp.Config.AgentToken, agentAddr)
+				fmt.Printf("Agent token stored in %s secret. Deploy the agent in the cluster to complete the registration\n", common.AgentSecretName)
+			}
 			fmt.Printf("Successfully registerd %s cluster\n", resp.Name)
 		},
 	}
 
 	command.Flags().BoolVarP(&self, "self", "i", false, "To self manage keiko manager cluster itself. Default = false")
 	command.Flags().StringVarP(&serviceAccount, "service-account", "s", "", fmt.Sprintf("System namespace service account to use for kubernetes resource management. If not set then default \"%s\" SA will be created", common.ManagerServiceAccountName))
+	command.Flags().StringToStringVarP(&labels, "labels", "l", nil, "Labels to be added to the cluster. Namespaces can select the cluster using these labels. ex: env=prod,region=us-west-2")
+	command.Flags().StringVarP(&deletionPolicy, "deletion-policy", "d", "", "What happens to the managed namespaces when the cluster is unregistered. Allowed values are Block, Cascade and Orphan. Default = Block")
+	command.Flags().StringVarP(&mode, "mode", "m", "", "How manager reaches the cluster. Allowed values are direct and agent. Use agent if manager can not reach the cluster api server. Default = direct")
+	command.Flags().StringVar(&agentAddr, "agent-server-addr", "", "Manager server address in the format of host:port to be used by the agent. Required in agent mode")
 	command.Flags().StringVarP(&configContext, "use-context", "c", "", "context to be used from user kubeconfig file. This kubeconfig context must have cluster admin access to create required RBAC in the target cluster if service account is not provided")
+	command.Flags().BoolVar(&escalation, "allow-escalation", false, allowEscalationUsage)
+
+	return command
+}
+
+//NewClusterSyncRBACCommand grants the manager the permissions needed by the current namespace templates in the target cluster
+//Manager never grants the permissions to itself. It reports the missing permissions in RBACHealthy condition of the cluster instead
+func NewClusterSyncRBACCommand() *cobra.Command {
+	var (
+		configContext string
+		escalation    bool
+	)
+
+	var command = &cobra.Command{
+		Use:     "sync-rbac",
+		Short:   fmt.Sprintf("%s cluster sync-rbac", "manager"),
+		Long:    "Update the manager cluster role in the registered cluster with the kinds used in the namespace templates",
+		Example: "manager cluster sync-rbac -c admins@iksm-ppd-usw2-k8s",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			conf, name := getManagedClusterKubeConfig(configContext)
+			managedClusterClient := k8s.NewK8sManagedClusterClientDoOrDie(conf)
+			createRBACInManagedCluster(ctx, managedClusterClient, managedClusterRules(ctx, managedClusterClient, escalation))
+			fmt.Printf("Successfully updated the manager cluster role in %s cluster\n", name)
+		},
+	}
+
+	command.Flags().StringVarP(&configContext, "use-context", "c", "", "context to be used from user kubeconfig file. This kubeconfig context must have cluster admin access to update the manager cluster role")
+	command.Flags().BoolVar(&escalation, "allow-escalation", false, allowEscalationUsage)
 
 	return command
 }
@@ -132,11 +196,136 @@ func NewClusterUnregisterCommand() *cobra.Command {
 		},
 	}
 
-	command.Flags().StringVarP(&clusterName, "cluster-name", "c", "", "Unregister the cluster with the manager which deletes the service account from target cluster too")
+	command.Flags().StringVarP(&clusterName, "cluster-name", "c", "", "Unregister the cluster with the manager which removes the service account, cluster role and cluster role binding from target cluster too")
 
 	return command
 }
 
+//NewClusterListCommand lists the registered clusters
+func NewClusterListCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "list",
+		Short:   fmt.Sprintf("%s cluster list", "manager"),
+		Long:    "List the clusters registered with manager",
+		Example: "manager cluster list -o yaml",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewClusterClientOrDie().ListClusters(ctx, &apis.ListClustersRequest{})
+			utils.StopIfError(err)
+			utils.StopIfError(printOutput(output, resp.Items, func(w io.Writer) {
+				fmt.Fprintln(w, "NAME\tMODE\tSTATE\tVERSION\tNODES\tNAMESPACES")
+				for _, cl := range resp.Items {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\t%d\t%d\n", cl.Name, clusterMode(cl), cl.Status.State, cl.Status.KubernetesVersion, cl.Status.NodeCount, cl.Status.NamespaceCount)
+				}
+			}))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewClusterGetCommand gets the cluster spec and status with the credentials redacted
+func NewClusterGetCommand() *cobra.Command {
+	var (
+		output string
+		watch  bool
+	)
+
+	var command = &cobra.Command{
+		Use:     "get NAME",
+		Short:   fmt.Sprintf("%s cluster get", "manager"),
+		Long:    "Get the cluster spec and status. Credentials are never returned",
+		Example: "manager cluster get iksm-ppd-usw2-k8s --watch",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewClusterClientOrDie().GetCluster(ctx, &apis.GetClusterRequest{ClusterName: args[0]})
+			utils.StopIfError(err)
+			utils.StopIfError(printOutput(output, resp, func(w io.Writer) {
+				printClusterInfo(w, resp)
+			}))
+			if watch {
+				watchResource("Cluster", resp.Name, resp.ResourceVersion, output)
+			}
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	command.Flags().BoolVarP(&watch, "watch", "w", false, "After getting the cluster, watch for the changes")
+	return command
+}
+
+//NewClusterDescribeCommand describes the cluster along with the hosted namespaces and the recent events
+func NewClusterDescribeCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "describe NAME",
+		Short:   fmt.Sprintf("%s cluster describe", "manager"),
+		Long:    "Describe the cluster along with the namespaces hosted on it and the recent events",
+		Example: "manager cluster describe iksm-ppd-usw2-k8s",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewClusterClientOrDie().DescribeCluster(ctx, &apis.GetClusterRequest{ClusterName: args[0]})
+			utils.StopIfError(err)
+			utils.StopIfError(printOutput(output, resp, func(w io.Writer) {
+				printClusterInfo(w, resp.Cluster)
+				fmt.Fprintln(w, "\nNAMESPACES")
+				fmt.Fprintln(w, "MANAGED NAMESPACE\tNAMESPACE\tSTATE\tERROR")
+				for _, ns := range resp.Namespaces {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", ns.ManagedNamespace, ns.Namespace, ns.State, ns.ErrorDescription)
+				}
+				fmt.Fprintln(w, "\nEVENTS")
+				fmt.Fprintln(w, "LAST SEEN\tTYPE\tREASON\tMESSAGE")
+				for _, e := range resp.Events {
+					fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", age(e.LastTimestamp), e.Type, e.Reason, e.Message)
+				}
+			}))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+func printClusterInfo(w io.Writer, cl *apis.ClusterInfo) {
+	fmt.Fprintf(w, "Name:\t%s\n", cl.Name)
+	fmt.Fprintf(w, "Mode:\t%s\n", clusterMode(cl))
+	if cl.Spec.Config != nil {
+		fmt.Fprintf(w, "Host:\t%s\n", cl.Spec.Config.Host)
+	}
+	fmt.Fprintf(w, "Labels:\t%v\n", cl.Labels)
+	fmt.Fprintf(w, "State:\t%s\n", cl.Status.State)
+	if cl.Status.ErrorDescription != "" {
+		fmt.Fprintf(w, "Error:\t%s\n", cl.Status.ErrorDescription)
+	}
+	fmt.Fprintf(w, "Version:\t%s\n", cl.Status.KubernetesVersion)
+	fmt.Fprintf(w, "Nodes:\t%d\n", cl.Status.NodeCount)
+	fmt.Fprintf(w, "Allocatable:\tcpu=%s memory=%s\n", cl.Status.AllocatableCPU, cl.Status.AllocatableMemory)
+	fmt.Fprintf(w, "Namespaces:\t%d %v\n", cl.Status.NamespaceCount, cl.Status.NamespaceStateCounts)
+	for _, cond := range cl.Status.Conditions {
+		fmt.Fprintf(w, "Condition %s:\t%s %s\n", cond.Type, cond.Status, cond.Message)
+	}
+}
+
+func clusterMode(cl *apis.ClusterInfo) string {
+	if cl.Spec.Mode == "" {
+		return v1alpha1.ClusterModeDirect
+	}
+	return cl.Spec.Mode
+}
+
 func getManagedClusterKubeConfig(contextName string) (*rest.Config, string) {
 
 	configAccess := clientcmd.NewDefaultPathOptions()
@@ -157,13 +346,70 @@ func getManagedClusterKubeConfig(contextName string) (*rest.Config, string) {
 	return conf, clstContext.Cluster
 }
 
-func createRBACInManagedCluster(ctx context.Context, client *k8s.Client) {
+//allowEscalationUsage describes the opt in for the template roles granting the permissions manager doesn't have
+const allowEscalationUsage = "Allow the manager to create the template roles and role bindings granting the permissions it doesn't have. " +
+	"This grants escalate on roles and bind on roles and cluster roles in all the namespaces which lets the manager bind any role including cluster-admin. Default = false"
+
+//managedClusterRules returns the cluster role rules manager needs for the namespace templates available in the manager
+//Escalation rules are included only if the admin allows them
+func managedClusterRules(ctx context.Context, client *k8s.Client, escalation bool) []v1.PolicyRule {
+	templateClient := grpc.NewConnectionOrDie().NewTemplateClientOrDie()
+	ruleSets := [][]v1.PolicyRule{rbac.BaseRules()}
+	req := &apis.ListTemplatesRequest{}
+	for {
+		resp, err := templateClient.List(ctx, req)
+		utils.StopIfError(err)
+		for _, t := range resp.Items {
+			if t.Spec == nil {
+				continue
+			}
+			rules, err := rbac.TemplateRules(t.Spec.NsResources, client.ResourceFor)
+			if err != nil {
+				//Kinds which are not available in the cluster can not be granted anyway
+				fmt.Printf("skipping template %s: %v\n", t.Name, err)
+				continue
+			}
+			ruleSets = append(ruleSets, rules)
+			if escalation {
+				ruleSets = append(ruleSets, rbac.EscalationRules(t.Spec.NsResources))
+			}
+		}
+		if resp.NextPageToken == "" {
+			break
+		}
+		req.PageToken = resp.NextPageToken
+	}
+	return rbac.Merge(ruleSets...)
+}
+
+//managerToken returns the token of the manager service account. Token is minted in the first manager token secret if it doesn't exist yet
+//Manager alternates between the manager token secrets while rotating the token
+func managerToken(ctx context.Context, client *k8s.Client) string {
+	secret, err := client.GetK8sSecret(ctx, common.ManagerTokenSecretA, common.SystemNameSpace)
+	if err != nil {
+		if !apierrs.IsNotFound(err) {
+			utils.StopIfError(err)
+		}
+		secret, err = client.CreateServiceAccountToken(ctx, common.ManagerTokenSecretA, common.ManagerServiceAccountName, common.SystemNameSpace)
+		utils.StopIfError(err)
+	}
+	return string(secret.Data[corev1.ServiceAccountTokenKey])
+}
+
+func createRBACInManagedCluster(ctx context.Context, client *k8s.Client, rules []v1.PolicyRule) {
 	//Create ServiceAccount
 	err := client.CreateServiceAccountForCluster(ctx, common.ManagerServiceAccountName, common.SystemNameSpace)
 	utils.StopIfError(err)
 
 	//Create Cluster Role
-	err = client.CreateOrUpdateClusterRole(ctx, common.ManagerClusterRole)
+	//Rules cover the kinds used in the namespace templates. Admin re-runs "manager cluster sync-rbac" when templates introduce new kinds
+	err = client.CreateOrUpdateClusterRole(ctx, common.ManagerClusterRole, rules)
+	utils.StopIfError(err)
+
+	//Create Role and RoleBinding to manage the bearer tokens in system namespace
+	err = client.CreateOrUpdateRole(ctx, rbac.TokenRole(), common.SystemNameSpace)
+	utils.StopIfError(err)
+	err = client.CreateOrUpdateRoleBinding(ctx, rbac.TokenRoleBinding(), common.SystemNameSpace)
 	utils.StopIfError(err)
 
 	sub := v1.Subject{
@@ -177,8 +423,14 @@ func createRBACInManagedCluster(ctx context.Context, client *k8s.Client) {
 }
 
 func removeRBACInManagedCluster(ctx context.Context, client *k8s.Client) {
+	//Delete Role and RoleBinding in system namespace
+	err := client.DeleteRoleBinding(ctx, common.ManagerTokenRoleBinding, common.SystemNameSpace)
+	utils.StopIfError(err)
+	err = client.DeleteRole(ctx, common.ManagerTokenRole, common.SystemNameSpace)
+	utils.StopIfError(err)
+
 	//Delete Cluster RoleBinding
-	err := client.DeleteClusterRoleBinding(ctx, common.ManagerClusterRoleBinding)
+	err = client.DeleteClusterRoleBinding(ctx, common.ManagerClusterRoleBinding)
 	utils.StopIfError(err)
 
 	//Delete Cluster Role
@@ -189,3 +441,20 @@ func removeRBACInManagedCluster(ctx context.Context, client *k8s.Client) {
 	err = client.DeleteServiceAccount(ctx, common.ManagerServiceAccountName, common.SystemNameSpace)
 	utils.StopIfError(err)
 }
+
+//createAgentSecretInManagedCluster stores the agent token and manager address to be used by the agent
+func createAgentSecretInManagedCluster(ctx context.Context, client *k8s.Client, clusterName string, token string, serverAddr string) {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.AgentSecretName,
+			Namespace: common.SystemNameSpace,
+		},
+		StringData: map[string]string{
+			"clusterName": clusterName,
+			"token":       token,
+			"serverAddr":  serverAddr,
+		},
+	}
+	err := client.CreateOrUpdateK8sSecret(ctx, secret, common.SystemNameSpace)
+	utils.StopIfError(err)
+}
@@ -0,0 +1,56 @@
+package commands
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"os"
+	"text/tabwriter"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	outputTable = "table"
+	outputJSON  = "json"
+	outputYAML  = "yaml"
+)
+
+//printOutput prints the object in the requested output format
+//table function is used to print the human readable table format
+func printOutput(output string, obj interface{}, table func(w io.Writer)) error {
+	switch output {
+	case "", outputTable:
+		w := tabwriter.NewWriter(os.Stdout, 0, 0, 2, ' ', 0)
+		table(w)
+		return w.Flush()
+	case outputJSON:
+		b, err := json.MarshalIndent(obj, "", "  ")
+		if err != nil {
+			return err
+		}
+		fmt.Println(string(b))
+	case outputYAML:
+		b, err := yaml.Marshal(obj)
+		if err != nil {
+			return err
+		}
+		fmt.Print(string(b))
+	default:
+		return fmt.Errorf("unknown output format %s. Allowed values are table, json and yaml", output)
+	}
+	return nil
+}
+
+//readInputFile reads the json or yaml file into the object
+func readInputFile(fileName string, obj interface{}) error {
+	if fileName == "" {
+		return fmt.Errorf("input file must be provided with --file")
+	}
+	b, err := ioutil.ReadFile(fileName)
+	if err != nil {
+		return err
+	}
+	return yaml.Unmarshal(b, obj)
+}
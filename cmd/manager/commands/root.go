@@ -1,6 +1,7 @@
 package commands
 
 import (
+	"flag"
 	"github.com/spf13/cobra"
 )
 
@@ -15,7 +16,12 @@ func NewCommand() *cobra.Command {
 		},
 	}
 
+	//Connection and authentication flags are defined by the grpc client package
+	command.PersistentFlags().AddGoFlagSet(flag.CommandLine)
+
 	command.AddCommand(NewClusterCommand())
+	command.AddCommand(NewAppCommand())
+	command.AddCommand(NewTemplateCommand())
 
 	return command
 }
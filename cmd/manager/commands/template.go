@@ -0,0 +1,106 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/grpc"
+	"github.com/spf13/cobra"
+	"io"
+	"os"
+	"strings"
+	"time"
+
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+)
+
+// NewTemplateCommand returns a new instance of an `manager template` command
+func NewTemplateCommand() *cobra.Command {
+	var command = &cobra.Command{
+		Use:   "template",
+		Short: "Discover namespace templates",
+		Run: func(c *cobra.Command, args []string) {
+			c.HelpFunc()(c, args)
+			os.Exit(1)
+		},
+		Example: `  # List the templates available in the manager
+  manager template list
+  # Describe the params of the template and the namespaces using it
+  manager template describe default-template
+`,
+	}
+
+	command.AddCommand(NewTemplateListCommand())
+	command.AddCommand(NewTemplateDescribeCommand())
+	return command
+}
+
+//NewTemplateListCommand lists the template catalog
+func NewTemplateListCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "list",
+		Short:   fmt.Sprintf("%s template list", "manager"),
+		Long:    "List the namespace templates along with the description and the params",
+		Example: "manager template list",
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewTemplateClientOrDie().Catalog(ctx, &apis.CatalogRequest{})
+			utils.StopIfError(err)
+			utils.StopIfError(printOutput(output, resp.Entries, func(w io.Writer) {
+				fmt.Fprintln(w, "NAME\tPARAMS\tNAMESPACES\tDESCRIPTION")
+				for _, entry := range resp.Entries {
+					var params []string
+					for _, p := range entry.Params {
+						params = append(params, p.Name)
+					}
+					fmt.Fprintf(w, "%s\t%s\t%d\t%s\n", entry.Name, strings.Join(params, ","), len(entry.Namespaces), entry.Description)
+				}
+			}))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
+
+//NewTemplateDescribeCommand describes the template params and usage
+func NewTemplateDescribeCommand() *cobra.Command {
+	var (
+		output string
+	)
+
+	var command = &cobra.Command{
+		Use:     "describe NAME",
+		Short:   fmt.Sprintf("%s template describe", "manager"),
+		Long:    "Describe the template params with the default values and the managed namespaces using the template",
+		Example: "manager template describe default-template",
+		Args:    cobra.ExactArgs(1),
+		Run: func(c *cobra.Command, args []string) {
+			ctx, cancel := context.WithTimeout(context.Background(), 30*time.Second)
+			defer cancel()
+			resp, err := grpc.NewConnectionOrDie().NewTemplateClientOrDie().Catalog(ctx, &apis.CatalogRequest{Name: args[0]})
+			utils.StopIfError(err)
+			if len(resp.Entries) == 0 {
+				utils.StopIfError(fmt.Errorf("template %s not found", args[0]))
+			}
+			entry := resp.Entries[0]
+			utils.StopIfError(printOutput(output, entry, func(w io.Writer) {
+				fmt.Fprintf(w, "Name:\t%s\n", entry.Name)
+				fmt.Fprintf(w, "Description:\t%s\n", entry.Description)
+				fmt.Fprintf(w, "Namespaces:\t%s\n\n", strings.Join(entry.Namespaces, ","))
+				fmt.Fprintln(w, "PARAM\tREQUIRED\tDEFAULT\tDESCRIPTION")
+				for _, p := range entry.Params {
+					fmt.Fprintf(w, "%s\t%t\t%s\t%s\n", p.Name, p.Required, p.DefaultValue, p.Description)
+				}
+			}))
+		},
+	}
+
+	command.Flags().StringVarP(&output, "output", "o", outputTable, "Output format. One of table, json or yaml")
+	return command
+}
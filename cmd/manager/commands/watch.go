@@ -0,0 +1,54 @@
+package commands
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"io"
+
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+)
+
+//watchResource streams the changes of the resource until the user interrupts
+//Watch resumes after the resource version printed by the get command so no change is missed in between
+func watchResource(kind string, name string, resourceVersion string, output string) {
+	req := &apis.WatchRequest{
+		Kinds:           []string{kind},
+		Name:            name,
+		ResourceVersion: resourceVersion,
+	}
+	client := grpc.NewConnectionOrDie().NewWatchClientOrDie()
+	stream, err := client.Watch(context.Background(), req)
+	utils.StopIfError(err)
+	for {
+		event, err := stream.Recv()
+		if err == io.EOF {
+			return
+		}
+		switch status.Code(err) {
+		case codes.OutOfRange:
+			//Resource version is too old, start from the current state
+			req.ResourceVersion = ""
+			stream, err = client.Watch(context.Background(), req)
+			utils.StopIfError(err)
+			continue
+		case codes.ResourceExhausted:
+			//Watch got dropped, resume after the last received event
+			stream, err = client.Watch(context.Background(), req)
+			utils.StopIfError(err)
+			continue
+		}
+		utils.StopIfError(err)
+		req.ResourceVersion = event.ResourceVersion
+		utils.StopIfError(printOutput(output, event, func(w io.Writer) {
+			transition := event.State
+			if event.PreviousState != event.State {
+				transition = fmt.Sprintf("%s -> %s", event.PreviousState, event.State)
+			}
+			fmt.Fprintf(w, "%s\t%s\t%s\t%s\n", event.Type, event.Name, transition, event.ErrorDescription)
+		}))
+	}
+}
@@ -1,28 +1,43 @@
 package controllers
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"fmt"
 	"github.com/go-logr/logr"
+	"github.com/keikoproj/manager/internal/config"
 	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/retry"
+	"github.com/keikoproj/manager/pkg/tracing"
 	"github.com/pborman/uuid"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/source"
+	"strings"
+	"time"
 
 	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
 	common2 "github.com/keikoproj/manager/controllers/common"
 	controllercommon "github.com/keikoproj/manager/controllers/common"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
 const (
 	applicationFinalizerName = "application.finalizers.manager.keikoproj.io"
+	//Number of removed environments to be kept in the status
+	maxRemovedEnvironments = 10
 )
 
 // ApplicationReconciler reconciles a Application object
@@ -44,6 +59,7 @@ func (r *ApplicationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	}()
 
 	ctx := context.WithValue(context.Background(), requestId, uuid.New())
+	ctx = audit.NewContext(ctx, &audit.Request{Principal: auditPrincipal, Operation: "Application/Reconcile"})
 	log := log.Logger(ctx, "controllers", "application_controller", "Reconcile")
 	log = log.WithValues("namespace", req.NamespacedName)
 	log.Info("Start of the request")
@@ -53,6 +69,8 @@ func (r *ApplicationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 	if err := r.Get(ctx, req.NamespacedName, &app); err != nil {
 		return ctrl.Result{}, ignoreNotFound(err)
 	}
+	ctx, span := tracing.StartReconcile(ctx, "Application/Reconcile", "Application", &app)
+	defer span.End()
 	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient}
 
 	// Isit being deleted?
@@ -64,6 +82,11 @@ func (r *ApplicationReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error)
 			commonClient.UpdateMeta(ctx, &app)
 		}
 
+		if app.IsFailed() {
+			log.Info("Reconcile failed permanently for the current spec. Waiting for the spec change", "error", app.Status.ErrorDescription)
+			return ctrl.Result{}, nil
+		}
+
 		return r.HandleReconcile(ctx, &app)
 
 	} else {
@@ -93,65 +116,411 @@ func (r *ApplicationReconciler) HandleReconcile(ctx context.Context, app *manage
 	// we should just create the mns from here
 	// only thing i gotta do is, add the params to mns params
 
-	//This will handle the (common) params to be propagated to namespaces
-	for k, v := range app.Spec.AppParams {
-		for _, env := range app.Spec.Environments {
-			if _, ok := env.Namespace.Params[k]; !ok {
-				env.Namespace.Params[k] = v
+	//Names are resolved upfront so that a bad name doesn't leave the application half applied
+	var environments []managerv1alpha1.EnvironmentStatus
+	previous := app.Status.Environments
+	desired := make(map[string]bool)
+	for _, env := range app.Spec.Environments {
+		name, err := app.EnvironmentNamespaceName(env, config.Props.NamespaceNamingPattern())
+		if err == nil && desired[name] {
+			err = fmt.Errorf("namespace name %s is used by more than one environment", name)
+		}
+		if err != nil {
+			log.Error(err, "Unable to name the managed namespace", "environment", env.Name)
+			desc := fmt.Sprintf("Unable to name the managed namespace due to error %s", err.Error())
+			return r.reconcileFailed(ctx, app, desc, retry.Permanent(err))
+		}
+		desired[name] = true
+		envStatus := managerv1alpha1.EnvironmentStatus{Name: env.Name, NamespaceName: name}
+		for _, prevStatus := range previous {
+			if prevStatus.Name == env.Name && prevStatus.NamespaceName == name {
+				envStatus.ReadySince = prevStatus.ReadySince
 			}
 		}
+		environments = append(environments, envStatus)
 	}
+	app.Status.Environments = environments
+
+	namespaces := environmentNamespaces(app, environments)
+
+	//Progressive rollout updates the environments in the listed order. Environments after the one the rollout
+	//waits on keep the managed namespace as is
+	progressive := app.Spec.Rollout != nil && app.Spec.Rollout.Strategy == managerv1alpha1.RolloutProgressive
+	var rollout *managerv1alpha1.RolloutStatus
+	var soakRemaining time.Duration
+	var prev *managerv1alpha1.ManagedNamespace
+	promoted := false
+	for i, env := range app.Spec.Environments {
+		name := environments[i].NamespaceName
+		log := log.WithValues("namespaceName", name)
+
+		var existing managerv1alpha1.ManagedNamespace
+		found := true
+		err := r.Get(ctx, types.NamespacedName{Namespace: common.ManagerDeployedNamespace, Name: name}, &existing)
+		if err == nil && !metav1.IsControlledBy(&existing, app) {
+			err = retry.Permanent(fmt.Errorf("managed namespace %s is not created by this application", name))
+		} else if apierrs.IsNotFound(err) {
+			found = false
+			err = nil
+		}
+		if err != nil {
+			log.Error(err, "Unable to claim the managed namespace")
+			desc := fmt.Sprintf("Unable to claim the managed namespace due to error %s", err.Error())
+			return r.reconcileFailed(ctx, app, desc, err)
+		}
 
-	for _, env := range app.Spec.Environments {
-		//Lets construct the name based on appName and env
-		//TODO: Allow overwriting the namespace name in future
-		name := fmt.Sprintf("%s-%s", app.Spec.AppName, env.Name)
-		log = log.WithValues("namespaceName", name)
 		mns := &managerv1alpha1.ManagedNamespace{
 			ObjectMeta: metav1.ObjectMeta{
 				Name:      name,
 				Namespace: common.ManagerDeployedNamespace,
+				Labels: map[string]string{
+					common.ApplicationLabel: app.Name,
+					common.EnvironmentLabel: env.Name,
+				},
 			},
 			Spec: managerv1alpha1.ManagedNamespaceSpec{
-				Namespace: *env.Namespace,
+				Namespace: *namespaces[i],
 			},
 		}
 
+		upToDate := found && sameNamespaceSpec(&existing.Spec.Namespace, namespaces[i])
+		if progressive && !upToDate {
+			if rollout == nil && i > 0 {
+				rollout, soakRemaining = r.rolloutGate(app, &environments[i-1], prev, env.Name)
+			}
+			if rollout != nil {
+				log.Info("Environment is waiting for the rollout", "phase", rollout.Phase, "reason", rollout.Message)
+				environments[i].Outdated = true
+				prev = nil
+				continue
+			}
+		}
+		//Managed namespace updated in this reconcile is not ready for the next environment yet
+		prev = nil
+		if upToDate {
+			prev = &existing
+		} else {
+			environments[i].ReadySince = nil
+		}
+
+		//Namespace reconciles are linked to the request which changed the application
+		tracing.Propagate(app, mns)
+
 		if err := ctrl.SetControllerReference(app, mns, r.Scheme); err != nil {
 			log.Error(err, "Unable to set the controller reference")
 			desc := fmt.Sprintf("Unable to set the controller reference due to error %s", err.Error())
-			r.Recorder.Event(app, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-			app.Status = managerv1alpha1.ApplicationStatus{RetryCount: app.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-			return commonClient.UpdateStatus(ctx, app, managerv1alpha1.Error, errRequeueTime)
+			//Managed namespace is owned by some other controller
+			return r.reconcileFailed(ctx, app, desc, retry.Permanent(err))
 		}
 
 		//Apply patch
 		//applyOpts := []client.PatchOption{client.ForceOwnership, client.FieldOwner("application-controller")}
 
-		err := r.K8sSelfClient.CreateOrUpdateManagedNamespace(ctx, mns, common.ManagerDeployedNamespace)
+		err = r.K8sSelfClient.CreateOrUpdateManagedNamespace(ctx, mns, common.ManagerDeployedNamespace)
 		if err != nil {
 			log.Error(err, "Unable to create managed namespace")
 			desc := fmt.Sprintf("Unable to create managed namespace due to error %s", err.Error())
-			r.Recorder.Event(app, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-			app.Status = managerv1alpha1.ApplicationStatus{RetryCount: app.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-			return commonClient.UpdateStatus(ctx, app, managerv1alpha1.Error, errRequeueTime)
+			return r.reconcileFailed(ctx, app, desc, err)
 		}
 
 		log.Info("Successfully created managed namespace")
 
+		if progressive && !upToDate && i > 0 && app.Annotations[common.PromoteAnnotation] == env.Name {
+			promoted = true
+		}
+	}
+	if progressive && rollout == nil {
+		rollout = &managerv1alpha1.RolloutStatus{Phase: managerv1alpha1.RolloutCompleted}
 	}
+	app.Status.Rollout = rollout
 
-	log.Info("Successfully created application", "appName", app.Spec.AppName)
-	r.Recorder.Event(app, v1.EventTypeNormal, string(managerv1alpha1.Ready), "Successfully created/updated application")
-	app.Status = managerv1alpha1.ApplicationStatus{RetryCount: 0, ErrorDescription: "", State: managerv1alpha1.Ready}
-	commonClient.UpdateStatus(ctx, app, managerv1alpha1.Ready)
+	if err := r.PruneEnvironments(ctx, app, desired); err != nil {
+		log.Error(err, "Unable to clean up the removed environments")
+		desc := fmt.Sprintf("Unable to clean up the removed environments due to error %s", err.Error())
+		return r.reconcileFailed(ctx, app, desc, err)
+	}
 
-	return ctrl.Result{}, nil
+	if err := r.AggregateEnvironments(ctx, app); err != nil {
+		log.Error(err, "Unable to retrieve the state of the managed namespaces")
+		desc := fmt.Sprintf("Unable to retrieve the state of the managed namespaces due to error %s", err.Error())
+		return r.reconcileFailed(ctx, app, desc, err)
+	}
+
+	//Application is ready only when the managed namespaces of all the environments are ready
+	//Managed namespace state changes trigger the reconcile so there is no need to requeue unless it is soaking
+	state, desc := app.Status.EnvironmentSummary()
+	if state == managerv1alpha1.Pending && rollout != nil && rollout.Phase != managerv1alpha1.RolloutCompleted {
+		desc = rollout.Message
+	}
+	if state != app.Status.State {
+		switch state {
+		case managerv1alpha1.Ready:
+			log.Info("Successfully created application", "appName", app.Spec.AppName)
+			r.Recorder.Event(app, v1.EventTypeNormal, string(managerv1alpha1.Ready), "Successfully created/updated application")
+		case managerv1alpha1.Warning, managerv1alpha1.Error:
+			log.Info("Managed namespaces of the application failed", "appName", app.Spec.AppName, "reason", desc)
+			r.Recorder.Event(app, v1.EventTypeWarning, string(state), desc)
+		default:
+			log.Info("Waiting for the managed namespaces of the application", "appName", app.Spec.AppName, "reason", desc)
+		}
+	}
+	app.Status.RetryCount = 0
+	app.Status.FailedGeneration = 0
+	app.Status.ErrorDescription = desc
+	app.Status.State = state
+	var requeueTime []float64
+	if soakRemaining > 0 {
+		requeueTime = append(requeueTime, float64(soakRemaining/time.Millisecond))
+	}
+	result, err := commonClient.UpdateStatus(ctx, app, state, requeueTime...)
+
+	//Promotion is consumed so that the next spec change waits for the promotion again
+	//Only the annotation is patched so that the spec and the status are left as stored
+	if promoted {
+		patch := client.MergeFrom(app.DeepCopy())
+		delete(app.Annotations, common.PromoteAnnotation)
+		if err := r.Patch(ctx, app, patch); err != nil {
+			log.Error(err, "Unable to remove the promote annotation")
+		}
+	}
+	return result, err
+}
+
+//environmentNamespaces returns the namespace spec of each environment with the (common) params propagated
+//Specs are copied so that the merged params are not written back to the application spec
+func environmentNamespaces(app *managerv1alpha1.Application, environments []managerv1alpha1.EnvironmentStatus) []*namespace.Namespace {
+	namespaces := make([]*namespace.Namespace, len(app.Spec.Environments))
+	for i, env := range app.Spec.Environments {
+		ns := env.Namespace.DeepCopy()
+		if ns.Params == nil {
+			ns.Params = make(map[string]string)
+		}
+		for k, v := range app.Spec.AppParams {
+			if _, ok := ns.Params[k]; !ok {
+				ns.Params[k] = v
+			}
+		}
+		//Templates name the namespace after the name param
+		if _, ok := ns.Params["name"]; !ok {
+			ns.Params["name"] = environments[i].NamespaceName
+		}
+		namespaces[i] = ns
+	}
+	return namespaces
+}
+
+//rolloutGate returns the reason the next environment can't be updated yet, along with the remaining soak time
+//Previous environment must be ready for the soak time and the next environment must be promoted if required
+//It returns nil if the next environment can be updated
+func (r *ApplicationReconciler) rolloutGate(app *managerv1alpha1.Application, prevStatus *managerv1alpha1.EnvironmentStatus, prev *managerv1alpha1.ManagedNamespace, nextEnv string) (*managerv1alpha1.RolloutStatus, time.Duration) {
+	prevEnv := prevStatus.Name
+	status := &managerv1alpha1.RolloutStatus{Phase: managerv1alpha1.RolloutProgressing, Environment: nextEnv}
+	//Managed namespace of the previous environment is not updated to the latest spec yet or not reconciled since
+	if prev == nil || prev.Status.ObservedGeneration != prev.Generation {
+		status.Message = fmt.Sprintf("waiting for environment %s to be ready", prevEnv)
+		return status, 0
+	}
+	switch prev.Status.State {
+	case managerv1alpha1.Ready:
+	case managerv1alpha1.Warning, managerv1alpha1.Error, managerv1alpha1.Failed:
+		status.Phase = managerv1alpha1.RolloutHalted
+		status.Message = fmt.Sprintf("rollout halted since environment %s is in %s state", prevEnv, prev.Status.State)
+		return status, 0
+	default:
+		status.Message = fmt.Sprintf("waiting for environment %s to be ready", prevEnv)
+		return status, 0
+	}
+
+	//Ready condition may not transition when the spec changes. Soak time starts when the application sees it ready
+	soakTime := time.Duration(app.Spec.Rollout.SoakTimeSeconds) * time.Second
+	remaining := soakTime
+	if prevStatus.ReadySince != nil {
+		remaining = soakTime - time.Since(prevStatus.ReadySince.Time)
+	}
+	if remaining > 0 {
+		status.Phase = managerv1alpha1.RolloutSoaking
+		status.Message = fmt.Sprintf("environment %s is ready. soaking for %s", prevEnv, remaining.Round(time.Second))
+		return status, remaining
+	}
+
+	if app.Spec.Rollout.ManualPromotion && app.Annotations[common.PromoteAnnotation] != nextEnv {
+		status.Phase = managerv1alpha1.RolloutAwaitingPromotion
+		status.Message = fmt.Sprintf("set annotation %s=%s to promote", common.PromoteAnnotation, nextEnv)
+		return status, 0
+	}
+	return nil, 0
+}
+
+//sameNamespaceSpec returns true if the managed namespace already has the namespace spec of the environment
+func sameNamespaceSpec(current *namespace.Namespace, desired *namespace.Namespace) bool {
+	currentJSON, err := json.Marshal(current)
+	if err != nil {
+		return false
+	}
+	desiredJSON, err := json.Marshal(desired)
+	if err != nil {
+		return false
+	}
+	return bytes.Equal(currentJSON, desiredJSON)
+}
+
+//AggregateEnvironments records the state of the managed namespace of each environment in the application status
+func (r *ApplicationReconciler) AggregateEnvironments(ctx context.Context, app *managerv1alpha1.Application) error {
+	log := log.Logger(ctx, "controllers", "application_controller", "AggregateEnvironments")
+
+	var mnsList managerv1alpha1.ManagedNamespaceList
+	if err := r.List(ctx, &mnsList, client.InNamespace(common.ManagerDeployedNamespace)); err != nil {
+		log.Error(err, "Unable to list the managed namespaces")
+		return err
+	}
+	owned := make(map[string]*managerv1alpha1.ManagedNamespace)
+	for i := range mnsList.Items {
+		if metav1.IsControlledBy(&mnsList.Items[i], app) {
+			owned[mnsList.Items[i].Name] = &mnsList.Items[i]
+		}
+	}
+
+	ready := 0
+	for i, env := range app.Spec.Environments {
+		envStatus := &app.Status.Environments[i]
+		envStatus.ClusterName = env.Namespace.ClusterName
+		mns, ok := owned[envStatus.NamespaceName]
+		switch {
+		case !ok:
+			envStatus.State = managerv1alpha1.Pending
+			envStatus.ErrorDescription = "managed namespace is not created yet"
+		case mns.Status.State == "" || mns.Status.ObservedGeneration != mns.Generation:
+			//Cache may not have the latest spec applied above yet. Update event of the managed namespace brings it here again
+			envStatus.State = managerv1alpha1.Pending
+			envStatus.ErrorDescription = "managed namespace is being reconciled"
+		default:
+			envStatus.State = mns.Status.State
+			envStatus.ErrorDescription = mns.Status.ErrorDescription
+		}
+		if ok && mns.Status.ClusterName != "" {
+			envStatus.ClusterName = mns.Status.ClusterName
+		}
+		if envStatus.State == managerv1alpha1.Ready && !envStatus.Outdated {
+			ready++
+		}
+		if envStatus.State != managerv1alpha1.Ready || envStatus.Outdated {
+			envStatus.ReadySince = nil
+		} else if envStatus.ReadySince == nil {
+			now := metav1.Now()
+			envStatus.ReadySince = &now
+		}
+	}
+	app.Status.ReadyEnvironments = fmt.Sprintf("%d/%d", ready, len(app.Spec.Environments))
+	return nil
+}
+
+//PruneEnvironments cleans up the managed namespaces owned by the application whose environment is removed
+//Managed namespaces are deleted unless the prune policy is Retain in which case the ownership is released
+//so that they are not garbage collected along with the application
+//Progressive rollout prunes the managed namespace replaced by the environment only after the rollout updates the
+//environment, and nothing is pruned while the rollout is halted or awaiting the promotion
+func (r *ApplicationReconciler) PruneEnvironments(ctx context.Context, app *managerv1alpha1.Application, desired map[string]bool) error {
+	log := log.Logger(ctx, "controllers", "application_controller", "PruneEnvironments")
+
+	if rollout := app.Status.Rollout; rollout != nil && (rollout.Phase == managerv1alpha1.RolloutHalted || rollout.Phase == managerv1alpha1.RolloutAwaitingPromotion) {
+		log.Info("Rollout is not progressing. Skipping the clean up of the removed environments", "phase", rollout.Phase)
+		return nil
+	}
+	outdated := make(map[string]bool)
+	for _, env := range app.Status.Environments {
+		if env.Outdated {
+			outdated[env.Name] = true
+		}
+	}
+
+	var mnsList managerv1alpha1.ManagedNamespaceList
+	if err := r.List(ctx, &mnsList, client.InNamespace(common.ManagerDeployedNamespace)); err != nil {
+		log.Error(err, "Unable to list the managed namespaces")
+		return err
+	}
+	for i := range mnsList.Items {
+		mns := &mnsList.Items[i]
+		if desired[mns.Name] || !metav1.IsControlledBy(mns, app) || !mns.ObjectMeta.DeletionTimestamp.IsZero() {
+			continue
+		}
+		//Managed namespaces created before the labels were introduced are named after the environment
+		envName := mns.Labels[common.EnvironmentLabel]
+		if envName == "" {
+			envName = strings.TrimPrefix(mns.Name, app.Spec.AppName+"-")
+		}
+		log := log.WithValues("environment", envName, "namespaceName", mns.Name)
+		if outdated[envName] {
+			log.Info("Environment is waiting for the rollout. Keeping the managed namespace it replaces")
+			continue
+		}
+
+		removal := managerv1alpha1.EnvironmentRemoval{Name: envName, NamespaceName: mns.Name, PrunePolicy: managerv1alpha1.PrunePolicyPrune}
+		if app.Spec.PrunePolicy == managerv1alpha1.PrunePolicyRetain {
+			removal.PrunePolicy = managerv1alpha1.PrunePolicyRetain
+			releaseManagedNamespace(mns, app)
+			if err := r.K8sSelfClient.UpdateManagedNamespace(ctx, mns); err != nil {
+				return err
+			}
+			log.Info("Environment removed. Managed namespace is retained")
+			r.Recorder.Event(app, v1.EventTypeNormal, "EnvironmentRemoved", fmt.Sprintf("environment %s is removed. managed namespace %s is retained", envName, mns.Name))
+		} else {
+			if err := r.K8sSelfClient.DeleteManagedNamespace(ctx, mns.Name, mns.Namespace); err != nil && !apierrs.IsNotFound(err) {
+				return err
+			}
+			log.Info("Environment removed. Managed namespace is deleted")
+			r.Recorder.Event(app, v1.EventTypeNormal, "EnvironmentRemoved", fmt.Sprintf("environment %s is removed. managed namespace %s is deleted", envName, mns.Name))
+		}
+
+		removal.RemovalTime = metav1.Now()
+		app.Status.RemovedEnvironments = append(app.Status.RemovedEnvironments, removal)
+		if n := len(app.Status.RemovedEnvironments); n > maxRemovedEnvironments {
+			app.Status.RemovedEnvironments = app.Status.RemovedEnvironments[n-maxRemovedEnvironments:]
+		}
+	}
+	return nil
+}
+
+//releaseManagedNamespace removes the application owner reference and labels from the managed namespace
+func releaseManagedNamespace(mns *managerv1alpha1.ManagedNamespace, app *managerv1alpha1.Application) {
+	var owners []metav1.OwnerReference
+	for _, owner := range mns.ObjectMeta.OwnerReferences {
+		if owner.UID != app.UID {
+			owners = append(owners, owner)
+		}
+	}
+	mns.ObjectMeta.OwnerReferences = owners
+	delete(mns.ObjectMeta.Labels, common.ApplicationLabel)
+	delete(mns.ObjectMeta.Labels, common.EnvironmentLabel)
+}
+
+//reconcileFailed records the error in the status. Transient errors are retried with backoff
+//and the permanent errors are not retried until the spec changes
+func (r *ApplicationReconciler) reconcileFailed(ctx context.Context, app *managerv1alpha1.Application, desc string, err error) (ctrl.Result, error) {
+	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient}
+	if retry.IsPermanent(err) {
+		app.Status.State = managerv1alpha1.Failed
+		app.Status.FailedGeneration = app.Generation
+	} else {
+		app.Status.State = managerv1alpha1.Error
+		app.Status.RetryCount = app.Status.RetryCount + 1
+		controllercommon.CountRetry(app)
+	}
+	app.Status.ErrorDescription = desc
+	r.Recorder.Event(app, v1.EventTypeWarning, string(app.Status.State), desc)
+	return commonClient.UpdateStatus(ctx, app, app.Status.State, controllercommon.RequeueAfter(app.Status.RetryCount))
 }
 
 func (r *ApplicationReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	c, err := ctrl.NewControllerManagedBy(mgr).
 		For(&managerv1alpha1.Application{}).
-		WithEventFilter(common2.StatusUpdatePredicate{}).
-		Complete(r)
+		WithEventFilter(common2.GenerationChangedPredicate{}).
+		Build(r)
+	if err != nil {
+		return err
+	}
+	//Event filter applies to all the watches of the builder. State of the owned managed namespaces is in the status
+	//and it needs its own filter
+	return c.Watch(&source.Kind{Type: &managerv1alpha1.ManagedNamespace{}},
+		&handler.EnqueueRequestForOwner{OwnerType: &managerv1alpha1.Application{}, IsController: true},
+		common2.ManagedNamespaceStatePredicate{})
 }
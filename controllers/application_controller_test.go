@@ -0,0 +1,507 @@
+package controllers
+
+import (
+	"context"
+	"time"
+
+	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/grpc/proto/application"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/k8s"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func testApplication(prunePolicy string) *managerv1alpha1.Application {
+	return &managerv1alpha1.Application{
+		TypeMeta:   metav1.TypeMeta{APIVersion: managerv1alpha1.GroupVersion.String(), Kind: "Application"},
+		ObjectMeta: metav1.ObjectMeta{Name: "team-app", UID: "app-uid"},
+		Spec: managerv1alpha1.ApplicationSpec{
+			Application: application.Application{AppName: "team-app", PrunePolicy: prunePolicy},
+		},
+	}
+}
+
+//environmentNamespace returns the managed namespace of the environment controlled by the application
+func environmentNamespace(app *managerv1alpha1.Application, env string) *managerv1alpha1.ManagedNamespace {
+	controller := true
+	return &managerv1alpha1.ManagedNamespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      app.Spec.AppName + "-" + env,
+			Namespace: common.ManagerDeployedNamespace,
+			Labels:    map[string]string{common.ApplicationLabel: app.Name, common.EnvironmentLabel: env, "team": "platform"},
+			OwnerReferences: []metav1.OwnerReference{{
+				APIVersion: app.APIVersion,
+				Kind:       app.Kind,
+				Name:       app.Name,
+				UID:        app.UID,
+				Controller: &controller,
+			}},
+		},
+	}
+}
+
+var _ = Describe("ApplicationController", func() {
+	BeforeEach(func() {
+		Expect(managerv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+	})
+
+	var (
+		r  *ApplicationReconciler
+		cl client.Client
+	)
+
+	setup := func(objs ...*managerv1alpha1.ManagedNamespace) {
+		var runtimeObjs []runtime.Object
+		for _, obj := range objs {
+			runtimeObjs = append(runtimeObjs, obj)
+		}
+		cl = fakeclient.NewFakeClientWithScheme(scheme.Scheme, runtimeObjs...)
+		r = &ApplicationReconciler{
+			Client:        cl,
+			Recorder:      record.NewFakeRecorder(10),
+			K8sSelfClient: k8s.NewK8sSelfClient(fake.NewSimpleClientset(), cl),
+		}
+	}
+
+	get := func(name string) (*managerv1alpha1.ManagedNamespace, error) {
+		mns := &managerv1alpha1.ManagedNamespace{}
+		err := cl.Get(context.Background(), types.NamespacedName{Namespace: common.ManagerDeployedNamespace, Name: name}, mns)
+		return mns, err
+	}
+
+	Describe("PruneEnvironments", func() {
+		Context("environment is removed with Prune policy", func() {
+			It("should delete the managed namespace and record the removal", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				setup(environmentNamespace(app, "dev"), environmentNamespace(app, "qal"))
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-dev": true})).To(Succeed())
+
+				_, err := get("team-app-qal")
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+				_, err = get("team-app-dev")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(1))
+				Expect(app.Status.RemovedEnvironments[0].Name).To(Equal("qal"))
+				Expect(app.Status.RemovedEnvironments[0].NamespaceName).To(Equal("team-app-qal"))
+				Expect(app.Status.RemovedEnvironments[0].PrunePolicy).To(Equal(managerv1alpha1.PrunePolicyPrune))
+			})
+		})
+
+		Context("environment is removed with Retain policy", func() {
+			It("should release the managed namespace from the application", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyRetain)
+				setup(environmentNamespace(app, "dev"), environmentNamespace(app, "qal"))
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-dev": true})).To(Succeed())
+
+				mns, err := get("team-app-qal")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mns.OwnerReferences).To(BeEmpty())
+				Expect(mns.Labels).NotTo(HaveKey(common.ApplicationLabel))
+				Expect(mns.Labels).NotTo(HaveKey(common.EnvironmentLabel))
+				Expect(mns.Labels).To(HaveKeyWithValue("team", "platform"))
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(1))
+				Expect(app.Status.RemovedEnvironments[0].PrunePolicy).To(Equal(managerv1alpha1.PrunePolicyRetain))
+			})
+		})
+
+		Context("managed namespace is not controlled by the application", func() {
+			It("should be left as is", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				other := environmentNamespace(testApplication(""), "qal")
+				other.Name = "other-app-qal"
+				other.OwnerReferences[0].UID = "other-uid"
+				setup(environmentNamespace(app, "dev"), other)
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-dev": true})).To(Succeed())
+
+				_, err := get("other-app-qal")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(app.Status.RemovedEnvironments).To(BeEmpty())
+			})
+		})
+
+		Context("managed namespace created before the environment label", func() {
+			It("should derive the environment from the name", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				legacy := environmentNamespace(app, "qal")
+				legacy.Labels = nil
+				setup(legacy)
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{})).To(Succeed())
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(1))
+				Expect(app.Status.RemovedEnvironments[0].Name).To(Equal("qal"))
+			})
+		})
+
+		Context("progressive rollout is not progressing", func() {
+			It("should not prune the removed environments", func() {
+				for _, phase := range []managerv1alpha1.RolloutPhase{managerv1alpha1.RolloutHalted, managerv1alpha1.RolloutAwaitingPromotion} {
+					app := testApplication(managerv1alpha1.PrunePolicyPrune)
+					app.Status.Rollout = &managerv1alpha1.RolloutStatus{Phase: phase, Environment: "prd"}
+					setup(environmentNamespace(app, "dev"), environmentNamespace(app, "qal"))
+
+					Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-dev": true})).To(Succeed(), string(phase))
+
+					_, err := get("team-app-qal")
+					Expect(err).NotTo(HaveOccurred(), string(phase))
+					Expect(app.Status.RemovedEnvironments).To(BeEmpty(), string(phase))
+				}
+			})
+		})
+
+		Context("renamed environment is waiting for the rollout", func() {
+			It("should keep the managed namespace it replaces", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				app.Status.Rollout = &managerv1alpha1.RolloutStatus{Phase: managerv1alpha1.RolloutSoaking, Environment: "qal"}
+				app.Status.Environments = []managerv1alpha1.EnvironmentStatus{
+					{Name: "dev", NamespaceName: "team-app-dev"},
+					{Name: "qal", NamespaceName: "team-app-qal-v2", Outdated: true},
+				}
+				setup(environmentNamespace(app, "dev"), environmentNamespace(app, "qal"), environmentNamespace(app, "stg"))
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-dev": true, "team-app-qal-v2": true})).To(Succeed())
+
+				_, err := get("team-app-qal")
+				Expect(err).NotTo(HaveOccurred())
+				_, err = get("team-app-stg")
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(1))
+				Expect(app.Status.RemovedEnvironments[0].Name).To(Equal("stg"))
+			})
+		})
+
+		Context("renamed environment is rolled out", func() {
+			It("should prune the managed namespace it replaces", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				app.Status.Rollout = &managerv1alpha1.RolloutStatus{Phase: managerv1alpha1.RolloutCompleted}
+				app.Status.Environments = []managerv1alpha1.EnvironmentStatus{{Name: "qal", NamespaceName: "team-app-qal-v2"}}
+				setup(environmentNamespace(app, "qal"))
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{"team-app-qal-v2": true})).To(Succeed())
+
+				_, err := get("team-app-qal")
+				Expect(apierrs.IsNotFound(err)).To(BeTrue())
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(1))
+				Expect(app.Status.RemovedEnvironments[0].NamespaceName).To(Equal("team-app-qal"))
+			})
+		})
+
+		Context("more removals than the history keeps", func() {
+			It("should keep only the latest removals", func() {
+				app := testApplication(managerv1alpha1.PrunePolicyPrune)
+				for i := 0; i < maxRemovedEnvironments; i++ {
+					app.Status.RemovedEnvironments = append(app.Status.RemovedEnvironments, managerv1alpha1.EnvironmentRemoval{Name: "old"})
+				}
+				setup(environmentNamespace(app, "qal"))
+
+				Expect(r.PruneEnvironments(context.Background(), app, map[string]bool{})).To(Succeed())
+				Expect(app.Status.RemovedEnvironments).To(HaveLen(maxRemovedEnvironments))
+				Expect(app.Status.RemovedEnvironments[maxRemovedEnvironments-1].Name).To(Equal("qal"))
+			})
+		})
+	})
+
+	Describe("AggregateEnvironments", func() {
+		//environmentApplication returns the application with the environments and their recorded namespace names
+		environmentApplication := func(envs ...string) *managerv1alpha1.Application {
+			app := testApplication("")
+			for _, env := range envs {
+				app.Spec.Environments = append(app.Spec.Environments, &application.Environment{Name: env, Namespace: &namespace.Namespace{ClusterName: "cluster-1"}})
+				app.Status.Environments = append(app.Status.Environments, managerv1alpha1.EnvironmentStatus{Name: env, NamespaceName: app.Spec.AppName + "-" + env})
+			}
+			return app
+		}
+
+		It("should record the state of the managed namespace", func() {
+			for _, entry := range []struct {
+				name  string
+				mns   func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace
+				state managerv1alpha1.State
+				desc  string
+				ready string
+			}{
+				{
+					name:  "not created",
+					state: managerv1alpha1.Pending,
+					desc:  "managed namespace is not created yet",
+					ready: "0/1",
+				},
+				{
+					name: "not controlled by the application",
+					mns: func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace {
+						mns := environmentNamespace(app, "dev")
+						mns.OwnerReferences[0].UID = "other-uid"
+						mns.Status.State = managerv1alpha1.Ready
+						return mns
+					},
+					state: managerv1alpha1.Pending,
+					desc:  "managed namespace is not created yet",
+					ready: "0/1",
+				},
+				{
+					name: "not reconciled yet",
+					mns: func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace {
+						return environmentNamespace(app, "dev")
+					},
+					state: managerv1alpha1.Pending,
+					desc:  "managed namespace is being reconciled",
+					ready: "0/1",
+				},
+				{
+					name: "latest spec not reconciled yet",
+					mns: func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace {
+						mns := environmentNamespace(app, "dev")
+						mns.Generation = 2
+						mns.Status.ObservedGeneration = 1
+						mns.Status.State = managerv1alpha1.Ready
+						return mns
+					},
+					state: managerv1alpha1.Pending,
+					desc:  "managed namespace is being reconciled",
+					ready: "0/1",
+				},
+				{
+					name: "failed",
+					mns: func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace {
+						mns := environmentNamespace(app, "dev")
+						mns.Status.State = managerv1alpha1.Error
+						mns.Status.ErrorDescription = "cluster is unreachable"
+						return mns
+					},
+					state: managerv1alpha1.Error,
+					desc:  "cluster is unreachable",
+					ready: "0/1",
+				},
+				{
+					name: "ready",
+					mns: func(app *managerv1alpha1.Application) *managerv1alpha1.ManagedNamespace {
+						mns := environmentNamespace(app, "dev")
+						mns.Status.State = managerv1alpha1.Ready
+						return mns
+					},
+					state: managerv1alpha1.Ready,
+					ready: "1/1",
+				},
+			} {
+				app := environmentApplication("dev")
+				if entry.mns != nil {
+					setup(entry.mns(app))
+				} else {
+					setup()
+				}
+
+				Expect(r.AggregateEnvironments(context.Background(), app)).To(Succeed(), entry.name)
+				envStatus := app.Status.Environments[0]
+				Expect(envStatus.State).To(Equal(entry.state), entry.name)
+				Expect(envStatus.ErrorDescription).To(Equal(entry.desc), entry.name)
+				Expect(envStatus.ClusterName).To(Equal("cluster-1"), entry.name)
+				Expect(envStatus.ReadySince != nil).To(Equal(entry.state == managerv1alpha1.Ready), entry.name)
+				Expect(app.Status.ReadyEnvironments).To(Equal(entry.ready), entry.name)
+			}
+		})
+
+		It("should record the cluster chosen by the placement", func() {
+			app := environmentApplication("dev")
+			app.Spec.Environments[0].Namespace.ClusterName = ""
+			mns := environmentNamespace(app, "dev")
+			mns.Status.ClusterName = "cluster-2"
+			setup(mns)
+
+			Expect(r.AggregateEnvironments(context.Background(), app)).To(Succeed())
+			Expect(app.Status.Environments[0].ClusterName).To(Equal("cluster-2"))
+		})
+
+		It("should keep the time the environment became ready", func() {
+			app := environmentApplication("dev")
+			readySince := metav1.NewTime(time.Now().Add(-time.Hour))
+			app.Status.Environments[0].ReadySince = &readySince
+			mns := environmentNamespace(app, "dev")
+			mns.Status.State = managerv1alpha1.Ready
+			setup(mns)
+
+			Expect(r.AggregateEnvironments(context.Background(), app)).To(Succeed())
+			Expect(app.Status.Environments[0].ReadySince.Time).To(Equal(readySince.Time))
+		})
+
+		It("should not count the environment waiting for the rollout as ready", func() {
+			app := environmentApplication("dev", "qal")
+			app.Status.Environments[1].Outdated = true
+			readySince := metav1.Now()
+			app.Status.Environments[1].ReadySince = &readySince
+			dev := environmentNamespace(app, "dev")
+			dev.Status.State = managerv1alpha1.Ready
+			qal := environmentNamespace(app, "qal")
+			qal.Status.State = managerv1alpha1.Ready
+			setup(dev, qal)
+
+			Expect(r.AggregateEnvironments(context.Background(), app)).To(Succeed())
+			Expect(app.Status.Environments[1].State).To(Equal(managerv1alpha1.Ready))
+			Expect(app.Status.Environments[1].ReadySince).To(BeNil())
+			Expect(app.Status.ReadyEnvironments).To(Equal("1/2"))
+		})
+	})
+
+	Describe("rolloutGate", func() {
+		It("should hold the next environment until the previous one is ready, soaked and promoted", func() {
+			readyFor := func(d time.Duration) *metav1.Time {
+				t := metav1.NewTime(time.Now().Add(-d))
+				return &t
+			}
+			for _, entry := range []struct {
+				name         string
+				rollout      application.Rollout
+				promote      string
+				prev         *managerv1alpha1.ManagedNamespace
+				readySince   *metav1.Time
+				phase        managerv1alpha1.RolloutPhase
+				message      string
+				minRemaining time.Duration
+				maxRemaining time.Duration
+			}{
+				{
+					name:    "previous environment is not updated yet",
+					phase:   managerv1alpha1.RolloutProgressing,
+					message: "waiting for environment dev to be ready",
+				},
+				{
+					name:    "previous environment is not reconciled since the update",
+					prev:    &managerv1alpha1.ManagedNamespace{ObjectMeta: metav1.ObjectMeta{Generation: 2}, Status: managerv1alpha1.ManagedNamespaceStatus{ObservedGeneration: 1, State: managerv1alpha1.Ready}},
+					phase:   managerv1alpha1.RolloutProgressing,
+					message: "waiting for environment dev to be ready",
+				},
+				{
+					name:    "previous environment is pending",
+					prev:    &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Pending}},
+					phase:   managerv1alpha1.RolloutProgressing,
+					message: "waiting for environment dev to be ready",
+				},
+				{
+					name:    "previous environment failed",
+					prev:    &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Error}},
+					phase:   managerv1alpha1.RolloutHalted,
+					message: "rollout halted since environment dev is in Error state",
+				},
+				{
+					name:         "previous environment just became ready",
+					rollout:      application.Rollout{SoakTimeSeconds: 60},
+					prev:         &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Ready}},
+					phase:        managerv1alpha1.RolloutSoaking,
+					message:      "environment dev is ready. soaking for 1m0s",
+					minRemaining: time.Minute,
+					maxRemaining: time.Minute,
+				},
+				{
+					name:         "previous environment is soaking",
+					rollout:      application.Rollout{SoakTimeSeconds: 60},
+					prev:         &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Ready}},
+					readySince:   readyFor(20 * time.Second),
+					phase:        managerv1alpha1.RolloutSoaking,
+					minRemaining: 39 * time.Second,
+					maxRemaining: 40 * time.Second,
+				},
+				{
+					name:       "previous environment soaked",
+					rollout:    application.Rollout{SoakTimeSeconds: 60},
+					prev:       &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Ready}},
+					readySince: readyFor(time.Hour),
+				},
+				{
+					name:    "next environment is not promoted",
+					rollout: application.Rollout{ManualPromotion: true},
+					prev:    &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Ready}},
+					promote: "prd",
+					phase:   managerv1alpha1.RolloutAwaitingPromotion,
+					message: "set annotation manager.keikoproj.io/promote=qal to promote",
+				},
+				{
+					name:    "next environment is promoted",
+					rollout: application.Rollout{ManualPromotion: true},
+					prev:    &managerv1alpha1.ManagedNamespace{Status: managerv1alpha1.ManagedNamespaceStatus{State: managerv1alpha1.Ready}},
+					promote: "qal",
+				},
+			} {
+				app := testApplication("")
+				entry.rollout.Strategy = managerv1alpha1.RolloutProgressive
+				app.Spec.Rollout = &entry.rollout
+				if entry.promote != "" {
+					app.Annotations = map[string]string{common.PromoteAnnotation: entry.promote}
+				}
+				prevStatus := &managerv1alpha1.EnvironmentStatus{Name: "dev", ReadySince: entry.readySince}
+
+				status, remaining := (&ApplicationReconciler{}).rolloutGate(app, prevStatus, entry.prev, "qal")
+				if entry.phase == "" {
+					Expect(status).To(BeNil(), entry.name)
+				} else {
+					Expect(status).NotTo(BeNil(), entry.name)
+					Expect(status.Phase).To(Equal(entry.phase), entry.name)
+					Expect(status.Environment).To(Equal("qal"), entry.name)
+					if entry.message != "" {
+						Expect(status.Message).To(Equal(entry.message), entry.name)
+					}
+				}
+				Expect(remaining).To(BeNumerically(">=", entry.minRemaining), entry.name)
+				Expect(remaining).To(BeNumerically("<=", entry.maxRemaining), entry.name)
+			}
+		})
+	})
+
+	Describe("sameNamespaceSpec", func() {
+		It("should compare the namespace spec of the environment", func() {
+			current := func() *namespace.Namespace {
+				return &namespace.Namespace{ClusterName: "cluster-1", TemplateName: "default", Params: map[string]string{"name": "team-app-dev", "team": "platform"}}
+			}
+			for _, entry := range []struct {
+				name    string
+				desired func(ns *namespace.Namespace)
+				same    bool
+			}{
+				{name: "unchanged", desired: func(ns *namespace.Namespace) {}, same: true},
+				{name: "params in a different order", desired: func(ns *namespace.Namespace) {
+					ns.Params = map[string]string{"team": "platform", "name": "team-app-dev"}
+				}, same: true},
+				{name: "param changed", desired: func(ns *namespace.Namespace) { ns.Params["team"] = "infra" }},
+				{name: "param added", desired: func(ns *namespace.Namespace) { ns.Params["env"] = "dev" }},
+				{name: "template changed", desired: func(ns *namespace.Namespace) { ns.TemplateName = "restricted" }},
+				{name: "cluster changed", desired: func(ns *namespace.Namespace) { ns.ClusterName = "cluster-2" }},
+			} {
+				desired := current()
+				entry.desired(desired)
+				Expect(sameNamespaceSpec(current(), desired)).To(Equal(entry.same), entry.name)
+			}
+		})
+	})
+
+	Describe("environmentNamespaces", func() {
+		It("should propagate the params without changing the application spec", func() {
+			app := &managerv1alpha1.Application{Spec: managerv1alpha1.ApplicationSpec{Application: application.Application{
+				AppParams: map[string]string{"team": "platform", "owner": "app"},
+				Environments: []*application.Environment{
+					{Name: "dev", Namespace: &namespace.Namespace{ClusterName: "cluster-1", Params: map[string]string{"owner": "dev"}}},
+					{Name: "prod", Namespace: &namespace.Namespace{ClusterName: "cluster-2", Params: map[string]string{"name": "prod"}}},
+				},
+			}}}
+			original := app.DeepCopy()
+			environments := []managerv1alpha1.EnvironmentStatus{{Name: "dev", NamespaceName: "team-app-dev"}, {Name: "prod", NamespaceName: "team-app-prod"}}
+
+			namespaces := environmentNamespaces(app, environments)
+			Expect(namespaces).To(HaveLen(2))
+			Expect(namespaces[0].Params).To(Equal(map[string]string{"name": "team-app-dev", "team": "platform", "owner": "dev"}))
+			Expect(namespaces[1].Params).To(Equal(map[string]string{"name": "prod", "team": "platform", "owner": "app"}))
+			Expect(namespaces[0].ClusterName).To(Equal("cluster-1"))
+			Expect(app).To(Equal(original))
+		})
+	})
+})
@@ -22,16 +22,22 @@ import (
 	"github.com/keikoproj/manager/internal/config"
 	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/audit"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/rbac"
+	"github.com/keikoproj/manager/pkg/retry"
+	"github.com/keikoproj/manager/pkg/template"
+	"github.com/keikoproj/manager/pkg/tracing"
 	"github.com/pborman/uuid"
 	"k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/client-go/discovery"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/source"
+	"strings"
 	"time"
 
 	"github.com/go-logr/logr"
@@ -39,16 +45,25 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	authv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
 	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
 )
 
 const (
 	clusterFinalizerName = "cluster.finalizers.manager.keikoproj.io"
 	requestId            = "request_id"
+	//auditPrincipal is the principal of the changes made by the controllers in the audit trail
+	auditPrincipal = "manager-controller"
 	//2 minutes
 	maxWaitTime = 120000
 	//30 seconds
 	errRequeueTime = 300000
+	//Number of token rotation attempts to be kept in the status
+	maxTokenRotationHistory = 10
+	//minRequeueAfter keeps the due rotation requeued since non positive RequeueAfter doesn't requeue the cluster
+	minRequeueAfter = time.Second
 )
 
 // ClusterReconciler reconciles a Cluster object
@@ -57,13 +72,15 @@ type ClusterReconciler struct {
 	Log           logr.Logger
 	Scheme        *runtime.Scheme
 	K8sSelfClient *k8s.Client
+	ClientPool    *k8s.ClientPool
 	Recorder      record.EventRecorder
 }
 
-// +kubebuilder:rbac:groups=core,resources=secrets,verbs=delete
+// +kubebuilder:rbac:groups=core,resources=secrets,verbs=get;list;watch;update;patch;delete
 // +kubebuilder:rbac:groups=core,resources=events,verbs=get;list;watch;create
 // +kubebuilder:rbac:groups=manager.keikoproj.io,resources=clusters,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=manager.keikoproj.io,resources=clusters/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=manager.keikoproj.io,resources=namespacetemplate,verbs=get;list;watch
 
 func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 
@@ -78,15 +95,18 @@ func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 	}()
 
 	ctx := context.WithValue(context.Background(), requestId, uuid.New())
+	ctx = audit.NewContext(ctx, &audit.Request{Principal: auditPrincipal, Operation: "Cluster/Reconcile"})
 	log := log.Logger(ctx, "controllers", "cluster_controller", "Reconcile")
 	log = log.WithValues("cluster", req.NamespacedName)
 	log.Info("Start of the request")
-	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient}
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
 	//Get the resource
 	var cluster managerv1alpha1.Cluster
 	if err := r.Get(ctx, req.NamespacedName, &cluster); err != nil {
 		return ctrl.Result{}, ignoreNotFound(err)
 	}
+	ctx, span := tracing.StartReconcile(ctx, "Cluster/Reconcile", "Cluster", &cluster)
+	defer span.End()
 
 	// Retrieve k8s secret
 	// Get the "best" Bearer token
@@ -98,58 +118,231 @@ func (r *ClusterReconciler) Reconcile(req ctrl.Request) (ctrl.Result, error) {
 		state = managerv1alpha1.Error
 	}
 
+	// Isit being deleted?
+	if !cluster.ObjectMeta.DeletionTimestamp.IsZero() {
+		//oh oh.. This is delete use case
+		return r.HandleDelete(ctx, req, &cluster)
+	}
+
+	if cluster.IsFailed() {
+		log.Info("Reconcile failed permanently for the current spec. Waiting for the spec change", "error", cluster.Status.ErrorDescription)
+		return ctrl.Result{}, nil
+	}
+
+	//Good. This is not Delete use case
+	//Lets check if this is very first time use case
+	if !utils.ContainsString(cluster.ObjectMeta.Finalizers, clusterFinalizerName) {
+		log.Info("New cluster resource. Adding the finalizer", "finalizer", clusterFinalizerName)
+		cluster.ObjectMeta.Finalizers = append(cluster.ObjectMeta.Finalizers, clusterFinalizerName)
+		commonClient.UpdateMeta(ctx, &cluster)
+	}
+
+	//Manager can not reach the clusters in agent mode
+	if cluster.IsAgentMode() {
+		return r.HandleAgentCluster(ctx, req, &cluster)
+	}
+
 	cfg, err := commonClient.ClusterConfig(ctx, &cluster)
 	if err != nil {
 		log.Error(err, "unable to prepare the rest config for the target cluster", "cluster", cluster.Spec.Name)
 		desc := fmt.Sprintf("unable to prepare the rest config for the target cluster due to error %s", err.Error())
-		r.Recorder.Event(&cluster, v1.EventTypeWarning, string(state), desc)
-		cluster.Status = managerv1alpha1.ClusterStatus{RetryCount: cluster.Status.RetryCount + 1, ErrorDescription: desc, State: state, NamespaceCount: cluster.Status.NamespaceCount}
-		return commonClient.UpdateStatus(ctx, &cluster, state, errRequeueTime)
+		return r.reconcileFailed(ctx, &cluster, state, desc, err)
 	}
+	return r.HandleReconcile(ctx, req, &cluster, cfg)
+}
 
-	// Isit being deleted?
-	if cluster.ObjectMeta.DeletionTimestamp.IsZero() {
-		//Good. This is not Delete use case
-		//Lets check if this is very first time use case
-		if !utils.ContainsString(cluster.ObjectMeta.Finalizers, clusterFinalizerName) {
-			log.Info("New cluster resource. Adding the finalizer", "finalizer", clusterFinalizerName)
-			cluster.ObjectMeta.Finalizers = append(cluster.ObjectMeta.Finalizers, clusterFinalizerName)
-			commonClient.UpdateMeta(ctx, &cluster)
+//HandleDelete applies the deletion policy on the managed namespaces and removes everything created during the registration
+func (r *ClusterReconciler) HandleDelete(ctx context.Context, req ctrl.Request, cluster *managerv1alpha1.Cluster) (ctrl.Result, error) {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "HandleDelete")
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
+	log.Info("Cluster delete request", "deletionPolicy", cluster.Spec.DeletionPolicy)
+
+	if !utils.ContainsString(cluster.ObjectMeta.Finalizers, clusterFinalizerName) {
+		return ctrl.Result{}, nil
+	}
+
+	var mnsList managerv1alpha1.ManagedNamespaceList
+	if err := r.List(ctx, &mnsList, client.InNamespace(req.Namespace), client.MatchingFields{ownerKey: req.Name}); err != nil {
+		log.Error(err, "unable to list mns for this cluster")
+		return r.deletionFailed(ctx, cluster, err)
+	}
 
+	if len(mnsList.Items) > 0 {
+		switch cluster.Spec.DeletionPolicy {
+		case managerv1alpha1.DeletionPolicyCascade, managerv1alpha1.DeletionPolicyOrphan:
+			if cluster.IsAgentMode() && cluster.Spec.DeletionPolicy == managerv1alpha1.DeletionPolicyCascade {
+				//Manager can not reach the cluster to delete the namespaces
+				r.Recorder.Event(cluster, v1.EventTypeWarning, "CascadeNotSupported", "namespaces are left as is in the agent mode cluster")
+			}
+			if err := r.removeManagedNamespaces(ctx, cluster, mnsList.Items); err != nil {
+				return r.deletionFailed(ctx, cluster, err)
+			}
+		default:
+			desc := fmt.Sprintf("cluster deletion is blocked by %d managed namespaces", len(mnsList.Items))
+			log.Info(desc)
+			r.Recorder.Event(cluster, v1.EventTypeWarning, "DeletionBlocked", desc)
+			cluster.Status.ErrorDescription = desc
+			cluster.Status.State = managerv1alpha1.Warning
+			return commonClient.UpdateStatus(ctx, cluster, managerv1alpha1.Warning, errRequeueTime)
 		}
-		return r.HandleReconcile(ctx, req, &cluster, cfg)
+	}
 
+	if cluster.IsAgentMode() {
+		//Agent and its RBAC must be removed from the cluster by the user
+		log.Info("Skipping the clean up in the agent mode cluster")
+	} else if err := r.removeRBACInManagedCluster(ctx, cluster); err != nil {
+		log.Error(err, "Unable to delete the cluster")
+		return r.deletionFailed(ctx, cluster, err)
+	}
+
+	//Bearer token secret goes last since it is needed for the clean up in the managed cluster
+	if err := r.K8sSelfClient.DeleteK8sSecret(ctx, cluster.Spec.Config.BearerTokenSecret, cluster.ObjectMeta.Namespace); err != nil {
+		return r.deletionFailed(ctx, cluster, err)
+	}
+
+	// Ok. Lets delete the finalizer so controller can delete the custom object
+	log.Info("Removing finalizer from Cluster")
+	cluster.ObjectMeta.Finalizers = utils.RemoveString(cluster.ObjectMeta.Finalizers, clusterFinalizerName)
+	commonClient.UpdateMeta(ctx, cluster)
+	r.ClientPool.Invalidate(common2.ClientPoolKey(cluster))
+	log.Info("Successfully deleted cluster")
+	r.Recorder.Event(cluster, v1.EventTypeNormal, "Deleted", "Successfully deleted cluster")
+	return ctrl.Result{}, nil
+}
+
+//deletionFailed records the error in the status. Deletion is always retried with backoff since the spec can not change anymore
+func (r *ClusterReconciler) deletionFailed(ctx context.Context, cluster *managerv1alpha1.Cluster, err error) (ctrl.Result, error) {
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
+	desc := fmt.Sprintf("unable to delete the cluster due to error %s", err.Error())
+	cluster.Status.RetryCount = cluster.Status.RetryCount + 1
+	common2.CountRetry(cluster)
+	cluster.Status.ErrorDescription = desc
+	cluster.Status.State = managerv1alpha1.Error
+	r.Recorder.Event(cluster, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
+	return commonClient.UpdateStatus(ctx, cluster, managerv1alpha1.Error, common2.RequeueAfter(cluster.Status.RetryCount))
+}
+
+//reconcileFailed records the error in the status. Transient errors are retried with backoff
+//and the permanent errors are not retried until the spec changes
+func (r *ClusterReconciler) reconcileFailed(ctx context.Context, cluster *managerv1alpha1.Cluster, state managerv1alpha1.State, desc string, err error) (ctrl.Result, error) {
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
+	if retry.IsPermanent(err) {
+		state = managerv1alpha1.Failed
+		cluster.Status.FailedGeneration = cluster.Generation
 	} else {
-		//oh oh.. This is delete use case
-		//Lets make sure to clean up the iam role
-		if cluster.Status.RetryCount != 0 {
-			cluster.Status.RetryCount = cluster.Status.RetryCount + 1
+		cluster.Status.RetryCount = cluster.Status.RetryCount + 1
+		common2.CountRetry(cluster)
+	}
+	r.Recorder.Event(cluster, v1.EventTypeWarning, string(state), desc)
+	cluster.Status.ErrorDescription = desc
+	cluster.Status.State = state
+	return commonClient.UpdateStatus(ctx, cluster, state, common2.RequeueAfter(cluster.Status.RetryCount))
+}
+
+//removeManagedNamespaces deletes the managed namespaces of the cluster and
+//deletes the namespaces in the managed cluster too if deletion policy is Cascade
+//Managed namespaces spanning other clusters are left to the managed namespace controller
+//and the ones placed by the cluster selector are evicted so that they are placed again
+func (r *ClusterReconciler) removeManagedNamespaces(ctx context.Context, cluster *managerv1alpha1.Cluster, mnsList []managerv1alpha1.ManagedNamespace) error {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "removeManagedNamespaces")
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
+
+	var managedClient *k8s.Client
+	if cluster.Spec.DeletionPolicy == managerv1alpha1.DeletionPolicyCascade && !cluster.IsAgentMode() {
+		var err error
+		managedClient, err = commonClient.ManagedClusterK8sClient(ctx, cluster)
+		if err != nil {
+			log.Error(err, "unable to get the client for the target cluster")
+			return err
+		}
+	}
+
+	for i := range mnsList {
+		mns := &mnsList[i]
+		if managedClient != nil {
+			name := r.namespaceName(ctx, cluster, mns)
+			if name == "" {
+				log.Info("Unable to find the namespace name. skipping", "mns", mns.Name)
+			} else if err := managedClient.DeleteNamespace(ctx, name); err != nil {
+				return err
+			}
+		}
+		if len(mns.TargetClusterNames()) > 1 {
+			continue
+		}
+		if mns.Spec.ClusterSelector != nil && mns.Spec.ClusterName == "" && len(mns.Spec.ClusterNames) == 0 {
+			if err := r.evictManagedNamespace(ctx, cluster, mns); err != nil {
+				log.Error(err, "unable to evict the managed namespace", "mns", mns.Name)
+				return err
+			}
+			log.Info("Managed namespace evicted", "mns", mns.Name)
+			continue
 		}
-		log.Info("Cluster delete request")
-		if err := removeRBACInManagedCluster(ctx, cfg); err != nil {
-			log.Error(err, "Unable to delete the cluster")
-			desc := fmt.Sprintf("unable to delete the cluster due to error %s", err.Error())
-			cluster.Status = managerv1alpha1.ClusterStatus{RetryCount: cluster.Status.RetryCount + 1, ErrorDescription: desc, State: state, NamespaceCount: cluster.Status.NamespaceCount}
-			commonClient.UpdateStatus(ctx, &cluster, managerv1alpha1.Error)
-			r.Recorder.Event(&cluster, v1.EventTypeWarning, string(managerv1alpha1.Error), "unable to delete the cluster due to "+err.Error())
-			return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
+		if err := r.Delete(ctx, mns); err != nil && !apierrs.IsNotFound(err) {
+			log.Error(err, "unable to delete the managed namespace", "mns", mns.Name)
+			return err
 		}
+		log.Info("Managed namespace deleted", "mns", mns.Name)
+	}
+	return nil
+}
 
-		// Ok. Lets delete the finalizer so controller can delete the custom object
-		log.Info("Removing finalizer from Cluster")
-		cluster.ObjectMeta.Finalizers = utils.RemoveString(cluster.ObjectMeta.Finalizers, clusterFinalizerName)
-		commonClient.UpdateMeta(ctx, &cluster)
-		log.Info("Successfully deleted cluster")
-		r.Recorder.Event(&cluster, v1.EventTypeNormal, "Deleted", "Successfully deleted cluster")
+//evictManagedNamespace clears the placement of the managed namespace on the cluster being deleted
+//Status updates don't trigger the reconcile so the resync annotation is set for the placement to run again
+func (r *ClusterReconciler) evictManagedNamespace(ctx context.Context, cluster *managerv1alpha1.Cluster, mns *managerv1alpha1.ManagedNamespace) error {
+	mns.Status.ClusterName = ""
+	var statuses []managerv1alpha1.ClusterNamespaceStatus
+	for _, cs := range mns.Status.Clusters {
+		if cs.ClusterName != cluster.Name {
+			statuses = append(statuses, cs)
+		}
 	}
-	return ctrl.Result{}, nil
+	mns.Status.Clusters = statuses
+	if err := r.Status().Update(ctx, mns); err != nil {
+		return ignoreNotFound(err)
+	}
+
+	patch := client.MergeFrom(mns.DeepCopy())
+	if mns.Annotations == nil {
+		mns.Annotations = make(map[string]string)
+	}
+	mns.Annotations[common.ResyncAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	if err := r.Patch(ctx, mns, patch); err != nil {
+		return ignoreNotFound(err)
+	}
+	r.Recorder.Event(mns, v1.EventTypeNormal, "Evicted", fmt.Sprintf("cluster %s is deleted. namespace will be placed again", cluster.Name))
+	return nil
+}
+
+//namespaceName returns the name of the namespace created in the cluster for the managed namespace
+func (r *ClusterReconciler) namespaceName(ctx context.Context, cluster *managerv1alpha1.Cluster, mns *managerv1alpha1.ManagedNamespace) string {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "namespaceName")
+
+	if cs := mns.ClusterStatus(cluster.Name); cs != nil && cs.Namespace != "" {
+		return cs.Namespace
+	}
+	if mns.Spec.TemplateName != "" {
+		var nsTemplate managerv1alpha1.NamespaceTemplate
+		if err := r.Get(ctx, types.NamespacedName{Name: mns.Spec.TemplateName}, &nsTemplate); err != nil {
+			log.Error(err, "unable to get the namespace template", "template", mns.Spec.TemplateName)
+			return ""
+		}
+		if err := template.ProcessTemplate(ctx, &nsTemplate, mns); err != nil {
+			log.Error(err, "unable to process namespace template", "template", mns.Spec.TemplateName)
+			return ""
+		}
+	}
+	if mns.Spec.NsResources == nil || mns.Spec.NsResources.Namespace == nil {
+		return ""
+	}
+	return mns.Spec.NsResources.Namespace.Name
 }
 
 func (r *ClusterReconciler) HandleReconcile(ctx context.Context, req ctrl.Request, cluster *managerv1alpha1.Cluster, cfg *rest.Config) (ctrl.Result, error) {
 	log := log.Logger(ctx, "controllers", "cluster_controller", "HandleReconcile")
 	log.WithValues("cluster_name", cluster.Spec.Name)
 	log.Info("state of the custom resource ", "state", cluster.Status.State)
-	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder}
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
 	state := managerv1alpha1.Warning
 
 	if cluster.Status.RetryCount > 3 {
@@ -159,32 +352,185 @@ func (r *ClusterReconciler) HandleReconcile(ctx context.Context, req ctrl.Reques
 	// Update the status
 	// Requeue it based on config map variable
 	// Ge the Managed cluster client
-	resp, err := GetServerVersion(cfg)
+	managedClient, err := commonClient.ManagedClusterK8sClient(ctx, cluster)
 	if err != nil {
-		log.Error(err, "unable to get the server version", "cluster", cluster.Spec.Name)
-		desc := fmt.Sprintf("Unable to get the server version due to error %s", err.Error())
-		r.Recorder.Event(cluster, v1.EventTypeWarning, string(state), desc)
-		cluster.Status = managerv1alpha1.ClusterStatus{RetryCount: cluster.Status.RetryCount + 1, ErrorDescription: desc, State: state, NamespaceCount: cluster.Status.NamespaceCount}
-		return commonClient.UpdateStatus(ctx, cluster, state, errRequeueTime)
+		log.Error(err, "unable to get the client for the target cluster", "cluster", cluster.Spec.Name)
+		desc := fmt.Sprintf("Unable to get the client for the target cluster due to error %s", err.Error())
+		return r.reconcileFailed(ctx, cluster, state, desc, err)
 	}
 
-	var mnsList managerv1alpha1.ManagedNamespaceList
-	if err := r.List(ctx, &mnsList, client.InNamespace(req.Namespace), client.MatchingFields{ownerKey: req.Name}); err != nil {
+	var templateList managerv1alpha1.NamespaceTemplateList
+	if err := r.List(ctx, &templateList); err != nil {
+		log.Error(err, "unable to list the namespace templates")
+		desc := fmt.Sprintf("Unable to list the namespace templates due to error %s", err.Error())
+		return r.reconcileFailed(ctx, cluster, state, desc, err)
+	}
+	if _, err := rbac.ClusterRoleRules(ctx, templateList.Items, managedClient.ResourceFor); err != nil {
+		//Kinds which are not available in the cluster can not be granted anyway
+		r.Recorder.Event(cluster, v1.EventTypeWarning, "InvalidTemplate", err.Error())
+	}
+	//Missing permissions are reported in RBACHealthy condition. Manager never grants the permissions to itself
+	required, requiredBy := requiredPermissions(ctx, templateList.Items, managedClient.ResourceFor)
+
+	if err := r.ProbeCluster(ctx, cluster, managedClient, required, requiredBy); err != nil {
+		log.Error(err, "unable to probe the target cluster", "cluster", cluster.Spec.Name)
+		desc := fmt.Sprintf("Unable to probe the target cluster due to error %s", err.Error())
+		return r.reconcileFailed(ctx, cluster, state, desc, err)
+	}
+
+	if err := r.CountNamespaces(ctx, req, cluster); err != nil {
 		log.Error(err, "unable to list mns for this cluster")
 		desc := fmt.Sprintf("Unable to list the mns for this cluster due to error %s", err.Error())
-		r.Recorder.Event(cluster, v1.EventTypeWarning, string(state), desc)
-		cluster.Status = managerv1alpha1.ClusterStatus{RetryCount: cluster.Status.RetryCount + 1, ErrorDescription: desc, State: state, NamespaceCount: cluster.Status.NamespaceCount}
-		return commonClient.UpdateStatus(ctx, cluster, state, errRequeueTime)
+		return r.reconcileFailed(ctx, cluster, state, desc, err)
 	}
-	log.Info("total count ", "count", len(mnsList.Items))
+	log.Info("total count ", "count", cluster.Status.NamespaceCount)
 	r.Recorder.Event(cluster, v1.EventTypeNormal, string(managerv1alpha1.Ready), "Successfully validated the target cluster")
-	// Lets add the number of namespaces part of this cluster
 
-	cluster.Status = managerv1alpha1.ClusterStatus{RetryCount: 0, ErrorDescription: "", State: managerv1alpha1.Ready, NamespaceCount: len(mnsList.Items)}
+	// Rotate the bearer token if it is due
+	r.HandleTokenRotation(ctx, cluster, managedClient, cfg)
+
+	cluster.Status.RetryCount = 0
+	cluster.Status.FailedGeneration = 0
+	cluster.Status.ErrorDescription = ""
+	cluster.Status.State = managerv1alpha1.Ready
+
+	commonClient.UpdateStatus(ctx, cluster, managerv1alpha1.Ready)
+	log.Info("SUCCESSFUL", "version", cluster.Status.KubernetesVersion)
+
+	return ctrl.Result{RequeueAfter: nextProbeAfter(cluster)}, nil
+}
+
+//nextProbeAfter returns the time after which the cluster is validated again. It is the earlier of the validation
+//frequency and the next token rotation
+func nextProbeAfter(cluster *managerv1alpha1.Cluster) time.Duration {
+	requeueAfter := time.Duration(config.Props.ClusterValidationFrequency()) * time.Second
+	if rotation := cluster.Status.TokenRotation; rotation != nil && rotation.NextRotationTime != nil {
+		if next := time.Until(rotation.NextRotationTime.Time); next < requeueAfter {
+			requeueAfter = next
+		}
+	}
+	if requeueAfter < minRequeueAfter {
+		requeueAfter = minRequeueAfter
+	}
+	return requeueAfter
+}
+
+//CountNamespaces records the number of managed namespaces part of this cluster per state
+func (r *ClusterReconciler) CountNamespaces(ctx context.Context, req ctrl.Request, cluster *managerv1alpha1.Cluster) error {
+	var mnsList managerv1alpha1.ManagedNamespaceList
+	if err := r.List(ctx, &mnsList, client.InNamespace(req.Namespace), client.MatchingFields{ownerKey: req.Name}); err != nil {
+		return err
+	}
+	cluster.Status.NamespaceCount = len(mnsList.Items)
+	cluster.Status.NamespaceStateCounts = make(map[managerv1alpha1.State]int)
+	for _, mns := range mnsList.Items {
+		state := mns.Status.State
+		if cs := mns.ClusterStatus(req.Name); cs != nil {
+			state = cs.State
+		}
+		if state != "" {
+			cluster.Status.NamespaceStateCounts[state]++
+		}
+	}
+	return nil
+}
+
+//HandleAgentCluster derives the health of the agent mode cluster from the agent heartbeats.
+//Agent updates the version, inventory and last probe time whenever it syncs with the manager
+func (r *ClusterReconciler) HandleAgentCluster(ctx context.Context, req ctrl.Request, cluster *managerv1alpha1.Cluster) (ctrl.Result, error) {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "HandleAgentCluster")
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
 
+	if err := r.CountNamespaces(ctx, req, cluster); err != nil {
+		log.Error(err, "unable to list mns for this cluster")
+		return ctrl.Result{}, err
+	}
+
+	timeout := time.Duration(config.Props.AgentHeartbeatTimeout()) * time.Second
+	if cluster.Status.LastProbeTime == nil || time.Since(cluster.Status.LastProbeTime.Time) > timeout {
+		desc := fmt.Sprintf("agent didn't sync with the manager in the last %s", timeout)
+		log.Info(desc)
+		r.Recorder.Event(cluster, v1.EventTypeWarning, "AgentNotConnected", desc)
+		setClusterCondition(cluster, managerv1alpha1.Reachable, v1.ConditionFalse, "AgentNotConnected", desc)
+		cluster.Status.ErrorDescription = desc
+		cluster.Status.State = managerv1alpha1.Warning
+		commonClient.UpdateStatus(ctx, cluster, managerv1alpha1.Warning, errRequeueTime)
+		return ctrl.Result{RequeueAfter: timeout}, nil
+	}
+
+	cluster.Status.RetryCount = 0
+	cluster.Status.FailedGeneration = 0
+	cluster.Status.ErrorDescription = ""
+	cluster.Status.State = managerv1alpha1.Ready
 	commonClient.UpdateStatus(ctx, cluster, managerv1alpha1.Ready)
-	log.Info("SUCCESSFUL", "version", resp)
-	return ctrl.Result{RequeueAfter: time.Duration(config.Props.ClusterValidationFrequency()) * time.Second}, nil
+	return ctrl.Result{RequeueAfter: timeout - time.Since(cluster.Status.LastProbeTime.Time)}, nil
+}
+
+//HandleTokenRotation rotates the managed cluster bearer token if it is due and records the result in the status
+func (r *ClusterReconciler) HandleTokenRotation(ctx context.Context, cluster *managerv1alpha1.Cluster, managedClient *k8s.Client, cfg *rest.Config) {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "HandleTokenRotation")
+
+	frequency := time.Duration(config.Props.TokenRotationFrequency()) * time.Second
+	if frequency <= 0 {
+		log.V(1).Info("Token rotation is disabled")
+		return
+	}
+	if cluster.Status.TokenRotation == nil {
+		cluster.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{}
+	}
+	rotation := cluster.Status.TokenRotation
+	now := metav1.Now()
+
+	// Very first time. Lets just schedule the rotation
+	if rotation.NextRotationTime == nil {
+		next := metav1.NewTime(now.Add(frequency))
+		rotation.NextRotationTime = &next
+		log.Info("Scheduled the token rotation", "nextRotationTime", next)
+		return
+	}
+	if now.Before(rotation.NextRotationTime) {
+		return
+	}
+
+	log.Info("Token rotation is due")
+	record := managerv1alpha1.TokenRotationRecord{Time: now}
+	secretName, err := utils.RotateToken(ctx, r.K8sSelfClient, managedClient, cluster, cfg)
+	if secretName == "" {
+		// Rotation failed and old token still in use. It is retried with backoff on the consecutive failures
+		log.Error(err, "unable to rotate the bearer token")
+		desc := fmt.Sprintf("unable to rotate the bearer token due to error %s", err.Error())
+		r.Recorder.Event(cluster, v1.EventTypeWarning, "TokenRotationFailed", desc)
+		record.Result = managerv1alpha1.TokenRotationFailed
+		record.Message = desc
+		failures := 1
+		for _, prev := range rotation.History {
+			if prev.Result != managerv1alpha1.TokenRotationFailed {
+				break
+			}
+			failures++
+		}
+		next := metav1.NewTime(now.Add(retry.Backoff(failures, frequency)))
+		rotation.NextRotationTime = &next
+	} else {
+		record.Result = managerv1alpha1.TokenRotationSucceeded
+		record.TokenSecret = secretName
+		if err != nil {
+			desc := fmt.Sprintf("rotated the bearer token but unable to revoke the old token due to error %s", err.Error())
+			r.Recorder.Event(cluster, v1.EventTypeWarning, "TokenRotationFailed", desc)
+			record.Message = desc
+		} else {
+			r.Recorder.Event(cluster, v1.EventTypeNormal, "TokenRotated", "Successfully rotated the bearer token")
+		}
+		next := metav1.NewTime(now.Add(frequency))
+		rotation.CurrentTokenSecret = secretName
+		rotation.LastRotationTime = &now
+		rotation.NextRotationTime = &next
+	}
+
+	rotation.History = append([]managerv1alpha1.TokenRotationRecord{record}, rotation.History...)
+	if len(rotation.History) > maxTokenRotationHistory {
+		rotation.History = rotation.History[:maxTokenRotationHistory]
+	}
 }
 
 var (
@@ -195,9 +541,26 @@ var (
 //EnqueueClusterOfMns will returns the cluster object for a given mns resource to be enqueued
 func (r *ClusterReconciler) EnqueueClusterOfMns(obj handler.MapObject) []ctrl.Request {
 
-	res := make([]ctrl.Request, 1)
-	res[0].Namespace = obj.Meta.GetNamespace()
-	res[0].Name = obj.Object.(*managerv1alpha1.ManagedNamespace).Spec.ClusterName
+	var res []ctrl.Request
+	for _, clusterName := range obj.Object.(*managerv1alpha1.ManagedNamespace).TargetClusterNames() {
+		res = append(res, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: obj.Meta.GetNamespace(), Name: clusterName}})
+	}
+	return res
+}
+
+//EnqueueAllClusters returns all the clusters to be enqueued. Used when namespace templates change
+func (r *ClusterReconciler) EnqueueAllClusters(obj handler.MapObject) []ctrl.Request {
+	log := log.Logger(context.Background(), "controllers", "cluster_controller", "EnqueueAllClusters")
+
+	var clusterList managerv1alpha1.ClusterList
+	if err := r.List(context.Background(), &clusterList); err != nil {
+		log.Error(err, "unable to list the clusters")
+		return nil
+	}
+	var res []ctrl.Request
+	for _, cluster := range clusterList.Items {
+		res = append(res, ctrl.Request{NamespacedName: types.NamespacedName{Namespace: cluster.Namespace, Name: cluster.Name}})
+	}
 	return res
 }
 
@@ -206,23 +569,22 @@ func (r *ClusterReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	if err := mgr.GetFieldIndexer().IndexField(&managerv1alpha1.ManagedNamespace{}, ownerKey, func(rawObj runtime.Object) []string {
 		//Okay. Lets get the mns obj and extract the owner since we are setting owner reference in mns
 		mns := rawObj.(*managerv1alpha1.ManagedNamespace)
-		clusterName := mns.Spec.ClusterName
-		if clusterName == "" {
-			return nil
-		}
-		return []string{clusterName}
+		return mns.TargetClusterNames()
 	}); err != nil {
 		return err
 	}
 
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&managerv1alpha1.Cluster{}).
-		Owns(&managerv1alpha1.ManagedNamespace{}).
 		Watches(&source.Kind{Type: &managerv1alpha1.ManagedNamespace{}},
 			&handler.EnqueueRequestsFromMapFunc{
 				ToRequests: handler.ToRequestsFunc(r.EnqueueClusterOfMns),
 			}).
-		WithEventFilter(common2.StatusUpdatePredicate{}).
+		Watches(&source.Kind{Type: &managerv1alpha1.NamespaceTemplate{}},
+			&handler.EnqueueRequestsFromMapFunc{
+				ToRequests: handler.ToRequestsFunc(r.EnqueueAllClusters),
+			}).
+		WithEventFilter(common2.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
@@ -238,43 +600,165 @@ func ignoreNotFound(err error) error {
 	return err
 }
 
-func GetServerVersion(config *rest.Config) (string, error) {
-	client, err := discovery.NewDiscoveryClientForConfig(config)
+//ProbeCluster validates the connectivity, credentials and permissions in the managed cluster
+//and records the conditions and inventory in the status
+func (r *ClusterReconciler) ProbeCluster(ctx context.Context, cluster *managerv1alpha1.Cluster, managedClient *k8s.Client, required []authv1.ResourceAttributes, requiredBy map[authv1.ResourceAttributes][]string) error {
+	log := log.Logger(ctx, "controllers", "cluster_controller", "ProbeCluster")
+
+	version, latency, err := managedClient.ServerVersion(ctx)
 	if err != nil {
-		return "", err
+		if apierrs.IsUnauthorized(err) {
+			setClusterCondition(cluster, managerv1alpha1.Reachable, v1.ConditionTrue, "APIServerReachable", "")
+			setClusterCondition(cluster, managerv1alpha1.Authenticated, v1.ConditionFalse, "Unauthorized", err.Error())
+		} else {
+			setClusterCondition(cluster, managerv1alpha1.Reachable, v1.ConditionFalse, "APIServerUnreachable", err.Error())
+			setClusterCondition(cluster, managerv1alpha1.Authenticated, v1.ConditionUnknown, "APIServerUnreachable", "")
+		}
+		return err
 	}
+	cluster.Status.KubernetesVersion = version
+	cluster.Status.APILatency = &metav1.Duration{Duration: latency}
+	setClusterCondition(cluster, managerv1alpha1.Reachable, v1.ConditionTrue, "APIServerReachable", "")
 
-	v, err := client.ServerVersion()
+	//Version endpoint is usually open to anonymous users. Access review confirms the credentials
+	denied, err := managedClient.DeniedPermissions(ctx, required)
 	if err != nil {
-		return "", err
+		if apierrs.IsUnauthorized(err) {
+			setClusterCondition(cluster, managerv1alpha1.Authenticated, v1.ConditionFalse, "Unauthorized", err.Error())
+		}
+		return err
 	}
-	return fmt.Sprintf("%s.%s", v.Major, v.Minor), nil
+	setClusterCondition(cluster, managerv1alpha1.Authenticated, v1.ConditionTrue, "TokenAccepted", "")
+
+	if len(denied) > 0 {
+		desc := fmt.Sprintf("manager is not allowed to %s. Run \"manager cluster sync-rbac\" with cluster admin access to grant them", formatPermissions(denied, requiredBy))
+		r.Recorder.Event(cluster, v1.EventTypeWarning, "InsufficientPermissions", desc)
+		setClusterCondition(cluster, managerv1alpha1.RBACHealthy, v1.ConditionFalse, "InsufficientPermissions", desc)
+	} else {
+		setClusterCondition(cluster, managerv1alpha1.RBACHealthy, v1.ConditionTrue, "PermissionsGranted", "")
+	}
+
+	inventory, err := managedClient.NodeInventory(ctx)
+	if err != nil {
+		//Inventory is informational. Lets not fail the probe because of it
+		log.Error(err, "unable to retrieve the node inventory")
+	} else {
+		cluster.Status.NodeCount = inventory.NodeCount
+		cluster.Status.AllocatableCPU = &inventory.AllocatableCPU
+		cluster.Status.AllocatableMemory = &inventory.AllocatableMemory
+	}
+
+	now := metav1.Now()
+	cluster.Status.LastProbeTime = &now
+	return nil
+}
+
+func setClusterCondition(cluster *managerv1alpha1.Cluster, conditionType managerv1alpha1.ConditionType, status v1.ConditionStatus, reason string, message string) {
+	managerv1alpha1.SetCondition(&cluster.Status.Conditions, managerv1alpha1.Condition{
+		Type:    conditionType,
+		Status:  status,
+		Reason:  reason,
+		Message: message,
+	})
+}
+
+//formatPermissions returns human readable list of permissions along with the templates which need them.
+//ex: create namespaces, update foos.example.com (template sample)
+func formatPermissions(permissions []authv1.ResourceAttributes, requiredBy map[authv1.ResourceAttributes][]string) string {
+	var list []string
+	for _, p := range permissions {
+		resource := p.Resource
+		if p.Group != "" {
+			resource = fmt.Sprintf("%s.%s", p.Resource, p.Group)
+		}
+		entry := fmt.Sprintf("%s %s", p.Verb, resource)
+		if templates := requiredBy[p]; len(templates) > 0 {
+			entry = fmt.Sprintf("%s (template %s)", entry, strings.Join(templates, ", "))
+		}
+		list = append(list, entry)
+	}
+	return strings.Join(list, ", ")
+}
+
+//requiredPermissions returns the permissions manager needs in the managed cluster along with the templates which need them
+func requiredPermissions(ctx context.Context, templates []managerv1alpha1.NamespaceTemplate, mapper rbac.ResourceMapper) ([]authv1.ResourceAttributes, map[authv1.ResourceAttributes][]string) {
+	required := rbac.Permissions(rbac.BaseRules())
+	requiredBy := make(map[authv1.ResourceAttributes][]string)
+	for _, p := range required {
+		requiredBy[p] = nil
+	}
+	for i := range templates {
+		rules, err := rbac.TemplateRules(templates[i].Spec.NsResources, mapper)
+		if err != nil {
+			continue
+		}
+		for _, p := range rbac.Permissions(rules) {
+			if _, ok := requiredBy[p]; !ok {
+				required = append(required, p)
+			}
+			requiredBy[p] = append(requiredBy[p], templates[i].Name)
+		}
+	}
+	return required, requiredBy
 }
 
-func removeRBACInManagedCluster(ctx context.Context, conf *rest.Config) error {
+func (r *ClusterReconciler) removeRBACInManagedCluster(ctx context.Context, cluster *managerv1alpha1.Cluster) error {
 	log := log.Logger(ctx, "controllers", "cluster_controller", "removeRBACInManagedCluster")
 
-	client := k8s.NewK8sManagedClusterClientDoOrDie(conf)
-
-	// TO BE DISCUSSED: When you want to unregister any cluster, should we delete the service account as well??
-	////Delete Cluster RoleBinding
-	//err = client.DeleteClusterRoleBinding(ctx, common.ManagerClusterRoleBinding)
-	//if err != nil {
-	//	log.Error(err, "unable to delete cluster role binding in the target cluster")
-	//	return err
-	//}
-	//
-	////Delete Cluster Role
-	//err = client.DeleteClusterRole(ctx, common.ManagerClusterRole)
-	//if err != nil {
-	//	log.Error(err, "unable to delete cluster role in the target cluster")
-	//	return err
-	//}
-
-	//Delete Service Account
-	err := client.DeleteServiceAccount(ctx, common.ManagerServiceAccountName, common.SystemNameSpace)
+	commonClient := &common2.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
+	client, err := commonClient.ManagedClusterK8sClient(ctx, cluster)
+	if err != nil {
+		log.Error(err, "unable to get the client for the target cluster")
+		return err
+	}
+
+	//Manager loses the access as soon as any one of the RBAC resources is deleted.
+	//So everything created during the registration is made dependent of the cluster role
+	//and garbage collector takes care of the rest once the cluster role is deleted
+	clusterRole, err := client.GetClusterRole(ctx, common.ManagerClusterRole)
+	if err != nil {
+		if apierrs.IsNotFound(err) {
+			log.Info("Manager cluster role doesn't exist anymore")
+			return nil
+		}
+		return err
+	}
+	owner := metav1.OwnerReference{
+		APIVersion: rbacv1.SchemeGroupVersion.String(),
+		Kind:       common.ClusterRoleKind,
+		Name:       clusterRole.Name,
+		UID:        clusterRole.UID,
+	}
+
+	dependents := []struct {
+		obj runtime.Object
+		key types.NamespacedName
+	}{
+		{&rbacv1.ClusterRoleBinding{}, types.NamespacedName{Name: common.ManagerClusterRoleBinding}},
+		{&rbacv1.Role{}, types.NamespacedName{Namespace: common.SystemNameSpace, Name: common.ManagerTokenRole}},
+		{&rbacv1.RoleBinding{}, types.NamespacedName{Namespace: common.SystemNameSpace, Name: common.ManagerTokenRoleBinding}},
+		{&v1.ServiceAccount{}, types.NamespacedName{Namespace: common.SystemNameSpace, Name: common.ManagerServiceAccountName}},
+	}
+
+	//Tokens minted during the registration and the rotation
+	for _, name := range []string{common.ManagerTokenSecretA, common.ManagerTokenSecretB} {
+		dependents = append(dependents, struct {
+			obj runtime.Object
+			key types.NamespacedName
+		}{&v1.Secret{}, types.NamespacedName{Namespace: common.SystemNameSpace, Name: name}})
+	}
+
+	for _, dependent := range dependents {
+		if err := client.AddOwnerReference(ctx, dependent.obj, dependent.key, owner); err != nil {
+			log.Error(err, "unable to add the owner reference in the target cluster", "name", dependent.key.Name)
+			return err
+		}
+	}
+
+	//Delete Cluster Role
+	err = client.DeleteClusterRole(ctx, common.ManagerClusterRole)
 	if err != nil {
-		log.Error(err, "unable to delete service account in the target cluster")
+		log.Error(err, "unable to delete cluster role in the target cluster")
 		return err
 	}
 
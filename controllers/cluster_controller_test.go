@@ -0,0 +1,367 @@
+package controllers
+
+import (
+	"context"
+	"errors"
+	"time"
+
+	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/grpc/proto/cluster"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	authv1 "k8s.io/api/authorization/v1"
+	v1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/discovery"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+//unreachableClientset fails the server version lookup since the fake discovery can't return errors
+type unreachableClientset struct {
+	*fake.Clientset
+	err error
+}
+
+func (c unreachableClientset) Discovery() discovery.DiscoveryInterface {
+	return failingDiscovery{DiscoveryInterface: c.Clientset.Discovery(), err: c.err}
+}
+
+type failingDiscovery struct {
+	discovery.DiscoveryInterface
+	err error
+}
+
+func (d failingDiscovery) ServerVersion() (*version.Info, error) {
+	return nil, d.err
+}
+
+func node(name string, cpu string, memory string) *v1.Node {
+	return &v1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Status: v1.NodeStatus{Allocatable: v1.ResourceList{
+			v1.ResourceCPU:    resource.MustParse(cpu),
+			v1.ResourceMemory: resource.MustParse(memory),
+		}},
+	}
+}
+
+var _ = Describe("ClusterController", func() {
+	Describe("HandleTokenRotation", func() {
+		const clusterSecretName = "cluster-secret"
+		var (
+			r           *ClusterReconciler
+			cr          *managerv1alpha1.Cluster
+			managedCS   *fake.Clientset
+			tokenClient func(cfg *rest.Config) (*k8s.Client, error)
+		)
+
+		BeforeEach(func() {
+			Expect(config.LoadProperties("", &v1.ConfigMap{Data: map[string]string{common.PropertyTokenRotationFrequency: "3600"}})).To(Succeed())
+			cr = &managerv1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace},
+				Spec: managerv1alpha1.ClusterSpec{
+					Cluster: cluster.Cluster{Name: "dev-cluster", Config: &cluster.Config{BearerTokenSecret: clusterSecretName}},
+				},
+			}
+			selfCS := fake.NewSimpleClientset(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterSecretName, Namespace: common.ManagerDeployedNamespace},
+				Data:       map[string][]byte{utils.TokenKey("dev-cluster"): []byte("old-token")},
+			})
+			managedCS = fake.NewSimpleClientset(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        common.ManagerTokenSecretA,
+					Namespace:   common.SystemNameSpace,
+					Annotations: map[string]string{v1.ServiceAccountNameKey: common.ManagerServiceAccountName},
+				},
+				Type: v1.SecretTypeServiceAccountToken,
+				Data: map[string][]byte{v1.ServiceAccountTokenKey: []byte("old-token")},
+			})
+			managedCS.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				secret := action.(k8stesting.CreateAction).GetObject().(*v1.Secret)
+				secret.Data = map[string][]byte{v1.ServiceAccountTokenKey: []byte("new-token")}
+				return false, nil, nil
+			})
+			tokenClient = utils.NewTokenClient
+			utils.NewTokenClient = func(cfg *rest.Config) (*k8s.Client, error) {
+				return k8s.NewK8sClient(managedCS), nil
+			}
+			r = &ClusterReconciler{K8sSelfClient: k8s.NewK8sClient(selfCS), Recorder: record.NewFakeRecorder(10)}
+		})
+
+		AfterEach(func() {
+			utils.NewTokenClient = tokenClient
+			Expect(config.LoadProperties("LOCAL")).To(Succeed())
+		})
+
+		rotate := func() {
+			r.HandleTokenRotation(context.Background(), cr, k8s.NewK8sClient(managedCS), &rest.Config{})
+		}
+
+		Context("rotation is disabled", func() {
+			It("should leave the status as is", func() {
+				Expect(config.LoadProperties("", &v1.ConfigMap{Data: map[string]string{common.PropertyTokenRotationFrequency: "0"}})).To(Succeed())
+				rotate()
+				Expect(cr.Status.TokenRotation).To(BeNil())
+			})
+		})
+
+		Context("first reconcile", func() {
+			It("should schedule the rotation", func() {
+				rotate()
+				Expect(cr.Status.TokenRotation.NextRotationTime).NotTo(BeNil())
+				Expect(cr.Status.TokenRotation.NextRotationTime.Time).To(BeTemporally("~", time.Now().Add(time.Hour), time.Minute))
+				Expect(cr.Status.TokenRotation.History).To(BeEmpty())
+			})
+		})
+
+		Context("rotation is not due", func() {
+			It("should not rotate the token", func() {
+				next := metav1.NewTime(time.Now().Add(time.Hour))
+				cr.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{NextRotationTime: &next}
+				rotate()
+				Expect(cr.Status.TokenRotation.History).To(BeEmpty())
+				Expect(cr.Status.TokenRotation.NextRotationTime).To(Equal(&next))
+			})
+		})
+
+		Context("rotation is due", func() {
+			It("should rotate the token and schedule the next rotation", func() {
+				due := metav1.NewTime(time.Now().Add(-time.Minute))
+				cr.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{NextRotationTime: &due}
+				rotate()
+				rotation := cr.Status.TokenRotation
+				Expect(rotation.History).To(HaveLen(1))
+				Expect(rotation.History[0].Result).To(Equal(managerv1alpha1.TokenRotationSucceeded))
+				Expect(rotation.CurrentTokenSecret).To(Equal(common.ManagerTokenSecretB))
+				Expect(rotation.LastRotationTime).NotTo(BeNil())
+				Expect(rotation.NextRotationTime.Time).To(BeTemporally(">", time.Now()))
+			})
+		})
+
+		Context("rotation fails", func() {
+			BeforeEach(func() {
+				managedCS.PrependReactor("get", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrs.NewForbidden(v1.Resource("secrets"), action.(k8stesting.GetAction).GetName(), errors.New("denied"))
+				})
+			})
+
+			It("should record the failure and retry with backoff", func() {
+				due := metav1.NewTime(time.Now().Add(-time.Minute))
+				cr.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{NextRotationTime: &due}
+				rotate()
+				rotation := cr.Status.TokenRotation
+				Expect(rotation.History).To(HaveLen(1))
+				Expect(rotation.History[0].Result).To(Equal(managerv1alpha1.TokenRotationFailed))
+				Expect(rotation.CurrentTokenSecret).To(BeEmpty())
+				Expect(rotation.NextRotationTime.Time).To(BeTemporally(">", time.Now()))
+				Expect(rotation.NextRotationTime.Time).To(BeTemporally("<=", time.Now().Add(retry.BaseBackoff)))
+				Expect(nextProbeAfter(cr)).To(BeNumerically(">=", minRequeueAfter))
+			})
+
+			It("should back off longer on the consecutive failures", func() {
+				due := metav1.NewTime(time.Now().Add(-time.Minute))
+				var history []managerv1alpha1.TokenRotationRecord
+				for i := 0; i < 4; i++ {
+					history = append(history, managerv1alpha1.TokenRotationRecord{Result: managerv1alpha1.TokenRotationFailed})
+				}
+				cr.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{NextRotationTime: &due, History: history}
+				rotate()
+				//Fifth failure waits for 16 times the base backoff with up to half of it as the jitter
+				Expect(cr.Status.TokenRotation.NextRotationTime.Time).To(BeTemporally(">=", time.Now().Add(8*retry.BaseBackoff-time.Second)))
+			})
+		})
+	})
+
+	Describe("nextProbeAfter", func() {
+		BeforeEach(func() {
+			Expect(config.LoadProperties("", &v1.ConfigMap{Data: map[string]string{common.PropertyClusterValidationFrequency: "600"}})).To(Succeed())
+		})
+
+		AfterEach(func() {
+			Expect(config.LoadProperties("LOCAL")).To(Succeed())
+		})
+
+		It("should requeue by the earlier of the validation and the token rotation", func() {
+			validation := 600 * time.Second
+			for _, entry := range []struct {
+				name     string
+				next     *metav1.Time
+				min, max time.Duration
+			}{
+				{name: "rotation is not scheduled", min: validation, max: validation},
+				{name: "rotation is due after the validation", next: &metav1.Time{Time: time.Now().Add(validation + time.Hour)}, min: validation, max: validation},
+				{name: "rotation is due before the validation", next: &metav1.Time{Time: time.Now().Add(validation / 2)}, min: validation/2 - time.Second, max: validation / 2},
+				{name: "rotation is overdue", next: &metav1.Time{Time: time.Now().Add(-time.Hour)}, min: minRequeueAfter, max: minRequeueAfter},
+			} {
+				cr := &managerv1alpha1.Cluster{}
+				if entry.next != nil {
+					cr.Status.TokenRotation = &managerv1alpha1.TokenRotationStatus{NextRotationTime: entry.next}
+				}
+				Expect(nextProbeAfter(cr)).To(BeNumerically(">=", entry.min), entry.name)
+				Expect(nextProbeAfter(cr)).To(BeNumerically("<=", entry.max), entry.name)
+			}
+		})
+	})
+
+	Describe("ProbeCluster", func() {
+		var (
+			r          *ClusterReconciler
+			cr         *managerv1alpha1.Cluster
+			managedCS  *fake.Clientset
+			required   []authv1.ResourceAttributes
+			requiredBy map[authv1.ResourceAttributes][]string
+		)
+
+		BeforeEach(func() {
+			r = &ClusterReconciler{Recorder: record.NewFakeRecorder(10)}
+			cr = &managerv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace}}
+			managedCS = fake.NewSimpleClientset(node("node-1", "2", "4Gi"), node("node-2", "2", "4Gi"))
+			managedCS.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{Major: "1", Minor: "17"}
+			//Manager is allowed to do everything except creating the foos
+			managedCS.PrependReactor("create", "selfsubjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authv1.SelfSubjectAccessReview)
+				review.Status.Allowed = review.Spec.ResourceAttributes.Resource != "foos"
+				return true, review, nil
+			})
+			required = []authv1.ResourceAttributes{{Verb: "create", Resource: "namespaces"}}
+			requiredBy = map[authv1.ResourceAttributes][]string{}
+		})
+
+		probe := func(cl kubernetes.Interface) error {
+			return r.ProbeCluster(context.Background(), cr, k8s.NewK8sClient(cl), required, requiredBy)
+		}
+
+		Context("reachable cluster with all the permissions", func() {
+			It("should record the version, inventory and the healthy conditions", func() {
+				Expect(probe(managedCS)).To(Succeed())
+				Expect(cr.Status.KubernetesVersion).To(Equal("1.17"))
+				Expect(cr.Status.APILatency).NotTo(BeNil())
+				Expect(cr.Status.NodeCount).To(Equal(2))
+				Expect(cr.Status.AllocatableCPU.String()).To(Equal("4"))
+				Expect(cr.Status.AllocatableMemory.String()).To(Equal("8Gi"))
+				Expect(cr.Status.LastProbeTime).NotTo(BeNil())
+				Expect(managerv1alpha1.IsConditionTrue(cr.Status.Conditions, managerv1alpha1.Reachable)).To(BeTrue())
+				Expect(managerv1alpha1.IsConditionTrue(cr.Status.Conditions, managerv1alpha1.Authenticated)).To(BeTrue())
+				Expect(managerv1alpha1.IsConditionTrue(cr.Status.Conditions, managerv1alpha1.RBACHealthy)).To(BeTrue())
+			})
+		})
+
+		Context("permission required by a template is denied", func() {
+			It("should mark the RBAC unhealthy naming the template", func() {
+				foos := authv1.ResourceAttributes{Verb: "create", Group: "example.com", Resource: "foos"}
+				required = append(required, foos)
+				requiredBy[foos] = []string{"sample"}
+				Expect(probe(managedCS)).To(Succeed())
+				rbacHealthy := managerv1alpha1.GetCondition(cr.Status.Conditions, managerv1alpha1.RBACHealthy)
+				Expect(rbacHealthy.Status).To(Equal(v1.ConditionFalse))
+				Expect(rbacHealthy.Message).To(Equal("manager is not allowed to create foos.example.com (template sample). Run \"manager cluster sync-rbac\" with cluster admin access to grant them"))
+			})
+		})
+
+		Context("node inventory is not available", func() {
+			It("should still succeed", func() {
+				managedCS.PrependReactor("list", "nodes", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("nodes is forbidden")
+				})
+				Expect(probe(managedCS)).To(Succeed())
+				Expect(cr.Status.NodeCount).To(BeZero())
+				Expect(cr.Status.LastProbeTime).NotTo(BeNil())
+			})
+		})
+
+		Context("unreachable cluster", func() {
+			It("should mark the cluster unreachable", func() {
+				Expect(probe(unreachableClientset{Clientset: managedCS, err: errors.New("connection refused")})).NotTo(Succeed())
+				reachable := managerv1alpha1.GetCondition(cr.Status.Conditions, managerv1alpha1.Reachable)
+				Expect(reachable.Status).To(Equal(v1.ConditionFalse))
+				Expect(reachable.Message).To(Equal("connection refused"))
+				Expect(managerv1alpha1.GetCondition(cr.Status.Conditions, managerv1alpha1.Authenticated).Status).To(Equal(v1.ConditionUnknown))
+				Expect(cr.Status.KubernetesVersion).To(BeEmpty())
+				Expect(cr.Status.LastProbeTime).To(BeNil())
+			})
+		})
+
+		Context("token is rejected", func() {
+			It("should mark the cluster reachable but not authenticated", func() {
+				Expect(probe(unreachableClientset{Clientset: managedCS, err: apierrs.NewUnauthorized("token expired")})).NotTo(Succeed())
+				Expect(managerv1alpha1.IsConditionTrue(cr.Status.Conditions, managerv1alpha1.Reachable)).To(BeTrue())
+				Expect(managerv1alpha1.GetCondition(cr.Status.Conditions, managerv1alpha1.Authenticated).Status).To(Equal(v1.ConditionFalse))
+			})
+		})
+	})
+
+	Describe("CountNamespaces", func() {
+		It("should count the managed namespaces per state in the cluster", func() {
+			Expect(managerv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+			mns := func(name string, state managerv1alpha1.State, clusters ...managerv1alpha1.ClusterNamespaceStatus) *managerv1alpha1.ManagedNamespace {
+				return &managerv1alpha1.ManagedNamespace{
+					ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.ManagerDeployedNamespace},
+					Status:     managerv1alpha1.ManagedNamespaceStatus{State: state, Clusters: clusters},
+				}
+			}
+			//Fake client doesn't support the field index. Only the managed namespaces of the cluster are added
+			r := &ClusterReconciler{Client: fakeclient.NewFakeClientWithScheme(scheme.Scheme,
+				mns("app-dev", managerv1alpha1.Ready),
+				mns("app-qal", managerv1alpha1.Ready),
+				//State in the cluster takes precedence over the overall state of the multi cluster namespace
+				mns("app-prd", managerv1alpha1.Warning,
+					managerv1alpha1.ClusterNamespaceStatus{ClusterName: "other-cluster", State: managerv1alpha1.Ready},
+					managerv1alpha1.ClusterNamespaceStatus{ClusterName: "dev-cluster", State: managerv1alpha1.Error}),
+				mns("app-new", ""),
+			)}
+			cr := &managerv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace}}
+			req := ctrl.Request{NamespacedName: types.NamespacedName{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace}}
+			Expect(r.CountNamespaces(context.Background(), req, cr)).To(Succeed())
+			Expect(cr.Status.NamespaceCount).To(Equal(4))
+			Expect(cr.Status.NamespaceStateCounts).To(Equal(map[managerv1alpha1.State]int{
+				managerv1alpha1.Ready: 2,
+				managerv1alpha1.Error: 1,
+			}))
+		})
+	})
+
+	Describe("removeManagedNamespaces", func() {
+		It("should delete the pinned managed namespaces and evict the ones placed by the selector", func() {
+			Expect(managerv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+			pinned := &managerv1alpha1.ManagedNamespace{ObjectMeta: metav1.ObjectMeta{Name: "app-dev", Namespace: common.ManagerDeployedNamespace}}
+			pinned.Spec.ClusterName = "dev-cluster"
+			placed := &managerv1alpha1.ManagedNamespace{ObjectMeta: metav1.ObjectMeta{Name: "app-qal", Namespace: common.ManagerDeployedNamespace}}
+			placed.Spec.ClusterSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"env": "qal"}}
+			placed.Status.ClusterName = "dev-cluster"
+			placed.Status.Clusters = []managerv1alpha1.ClusterNamespaceStatus{{ClusterName: "dev-cluster", State: managerv1alpha1.Ready}}
+			cl := fakeclient.NewFakeClientWithScheme(scheme.Scheme, pinned, placed)
+			r := &ClusterReconciler{Client: cl, Recorder: record.NewFakeRecorder(10)}
+			cr := &managerv1alpha1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace}}
+			cr.Spec.DeletionPolicy = managerv1alpha1.DeletionPolicyOrphan
+
+			Expect(r.removeManagedNamespaces(context.Background(), cr, []managerv1alpha1.ManagedNamespace{*pinned, *placed})).To(Succeed())
+
+			err := cl.Get(context.Background(), types.NamespacedName{Namespace: common.ManagerDeployedNamespace, Name: "app-dev"}, &managerv1alpha1.ManagedNamespace{})
+			Expect(apierrs.IsNotFound(err)).To(BeTrue())
+			evicted := &managerv1alpha1.ManagedNamespace{}
+			Expect(cl.Get(context.Background(), types.NamespacedName{Namespace: common.ManagerDeployedNamespace, Name: "app-qal"}, evicted)).To(Succeed())
+			Expect(evicted.Status.ClusterName).To(BeEmpty())
+			Expect(evicted.Status.Clusters).To(BeEmpty())
+			Expect(evicted.TargetClusterNames()).To(BeEmpty())
+			Expect(evicted.Annotations).To(HaveKey(common.ResyncAnnotation))
+		})
+	})
+})
@@ -2,13 +2,21 @@ package common
 
 import (
 	"context"
+	"fmt"
+	"github.com/keikoproj/manager/internal/config"
 	"github.com/keikoproj/manager/internal/utils"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"github.com/keikoproj/manager/pkg/retry"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/record"
+	"reflect"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/event"
 	"sigs.k8s.io/controller-runtime/pkg/predicate"
@@ -18,12 +26,14 @@ import (
 	ctrl "sigs.k8s.io/controller-runtime"
 )
 
-type StatusUpdatePredicate struct {
+//GenerationChangedPredicate lets through the updates changing the spec, labels, annotations or the deletion timestamp
+//Status updates made by the controllers and the agents don't trigger the reconcile
+type GenerationChangedPredicate struct {
 	predicate.Funcs
 }
 
 // Update implements default UpdateEvent filter for validating generation change
-func (StatusUpdatePredicate) Update(e event.UpdateEvent) bool {
+func (GenerationChangedPredicate) Update(e event.UpdateEvent) bool {
 	log := log.Logger(context.Background(), "controllers.status", "status", "Update")
 	if e.MetaOld == nil {
 		log.Error(nil, "Update event has no old metadata", "event", e)
@@ -41,35 +51,49 @@ func (StatusUpdatePredicate) Update(e event.UpdateEvent) bool {
 		log.Error(nil, "Update event has no new metadata", "event", e)
 		return false
 	}
-	//Better way to do it is to get GVK from ObjectKind but Kind is dropped during decode.
-	//For more details, check the status of the issue here
-	//https://github.com/kubernetes/kubernetes/issues/80609
-
-	// Try to type caste to cluster first if it doesn't work move to namespace type casting
-	if oldClusterObj, ok := e.ObjectOld.(*managerv1alpha1.Cluster); ok {
-		newClusterObj := e.ObjectNew.(*managerv1alpha1.Cluster)
-		if oldClusterObj.Status != newClusterObj.Status {
-			return false
-		}
-	} else if oldNamespaceObj, ok := e.ObjectOld.(*managerv1alpha1.ManagedNamespace); ok {
-		newNamespaceObj := e.ObjectNew.(*managerv1alpha1.ManagedNamespace)
-		if oldNamespaceObj.Status != newNamespaceObj.Status {
-			return false
-		}
-	} else if oldApplicationObj, ok := e.ObjectOld.(*managerv1alpha1.Application); ok {
-		newApplicationObj := e.ObjectNew.(*managerv1alpha1.Application)
-		if oldApplicationObj.Status != newApplicationObj.Status {
-			return false
-		}
-	}
-
-	return true
+	if e.MetaOld.GetGeneration() != e.MetaNew.GetGeneration() {
+		return true
+	}
+	//Metadata changes don't bump the generation. Resync annotation, trace context and labels used by the selectors must be handled
+	if !reflect.DeepEqual(e.MetaOld.GetAnnotations(), e.MetaNew.GetAnnotations()) || !reflect.DeepEqual(e.MetaOld.GetLabels(), e.MetaNew.GetLabels()) {
+		return true
+	}
+	return !e.MetaOld.GetDeletionTimestamp().Equal(e.MetaNew.GetDeletionTimestamp())
+}
+
+//ManagedNamespaceStatePredicate lets through the managed namespace updates changing the spec or the state
+//Owners rolling up the state of the managed namespaces are not triggered by the retry count updates
+type ManagedNamespaceStatePredicate struct {
+	predicate.Funcs
+}
+
+// Update implements UpdateEvent filter for validating the state change
+func (ManagedNamespaceStatePredicate) Update(e event.UpdateEvent) bool {
+	oldMns, ok := e.ObjectOld.(*managerv1alpha1.ManagedNamespace)
+	if !ok {
+		return false
+	}
+	newMns, ok := e.ObjectNew.(*managerv1alpha1.ManagedNamespace)
+	if !ok {
+		return false
+	}
+	if oldMns.Generation != newMns.Generation || oldMns.Status.ObservedGeneration != newMns.Status.ObservedGeneration {
+		return true
+	}
+	return oldMns.Status.State != newMns.Status.State || oldMns.Status.ErrorDescription != newMns.Status.ErrorDescription ||
+		oldMns.Status.ClusterName != newMns.Status.ClusterName
+}
+
+//Reconciled is implemented by the resources reporting the outcome of the reconcile as the standard conditions
+type Reconciled interface {
+	MarkReconciled(state managerv1alpha1.State)
 }
 
 // Client is a manager client to get the common stuff for all the controllers
 type Client struct {
 	client.Client
 	K8sSelfClient *k8s.Client
+	ClientPool    *k8s.ClientPool
 	Recorder      record.EventRecorder
 }
 
@@ -86,6 +110,11 @@ func (r *Client) UpdateMeta(ctx context.Context, object runtime.Object) {
 //UpdateStatus function updates the status based on the process step
 func (r *Client) UpdateStatus(ctx context.Context, obj runtime.Object, state managerv1alpha1.State, requeueTime ...float64) (ctrl.Result, error) {
 	log := log.Logger(ctx, "controllers.common", "common", "UpdateStatus")
+	trace.SpanFromContext(ctx).SetAttributes(attribute.String("manager.state", string(state)))
+
+	if reconciled, ok := obj.(Reconciled); ok {
+		reconciled.MarkReconciled(state)
+	}
 
 	if err := r.Status().Update(ctx, obj); err != nil {
 		log.Error(err, "Unable to update status", "status", state)
@@ -93,19 +122,33 @@ func (r *Client) UpdateStatus(ctx context.Context, obj runtime.Object, state man
 		return ctrl.Result{RequeueAfter: 30 * time.Second}, nil
 	}
 
-	if state != managerv1alpha1.Error {
+	//Failed objects are not retried until the spec changes
+	if state == managerv1alpha1.Ready || state == managerv1alpha1.Failed {
 		return ctrl.Result{}, nil
 	}
 
 	//if wait time is specified, requeue it after provided time
 	if len(requeueTime) == 0 {
-		requeueTime[0] = 0
+		return ctrl.Result{}, nil
 	}
 
 	log.Info("Requeue time", "time", requeueTime[0])
 	return ctrl.Result{RequeueAfter: time.Duration(requeueTime[0]) * time.Millisecond}, nil
 }
 
+//CountRetry counts the reconcile requeued to retry after the transient error
+func CountRetry(obj runtime.Object) {
+	//Objects from the cache don't have the type meta populated
+	metrics.ReconcileRetries.WithLabelValues(reflect.Indirect(reflect.ValueOf(obj)).Type().Name()).Inc()
+}
+
+//RequeueAfter returns the wait time in milliseconds before retrying the transient error based on the retry count
+//Wait time grows exponentially up to the max configured in the config map
+func RequeueAfter(retryCount int) float64 {
+	backoff := retry.Backoff(retryCount, time.Duration(config.Props.MaxRetryBackoff())*time.Second)
+	return float64(backoff / time.Millisecond)
+}
+
 //ClusterConfig function returns cluster rest config for the managed cluster
 func (r *Client) ClusterConfig(ctx context.Context, cluster *managerv1alpha1.Cluster) (*rest.Config, error) {
 	log := log.Logger(ctx, "controllers.common", "ClusterConfig")
@@ -124,12 +167,24 @@ func (r *Client) ClusterConfig(ctx context.Context, cluster *managerv1alpha1.Clu
 }
 
 //ManagedClusterK8sClient returns the k8s client struct for the managed cluster
+//Clients are served from the client pool and rebuilt only when cluster spec or credential secret changes
+//Credential secret is read from the manager cache so that the cache hits don't reach the api server
 func (r *Client) ManagedClusterK8sClient(ctx context.Context, cluster *managerv1alpha1.Cluster) (*k8s.Client, error) {
 	log := log.Logger(ctx, "controllers.common.common", "ManagedClusterK8sClient")
-	config, err := r.ClusterConfig(ctx, cluster)
-	if err != nil {
-		log.Error(err, "unable to prepare the rest config for the target cluster", "cluster", cluster.Spec.Name)
+
+	secret := &v1.Secret{}
+	if err := r.Get(ctx, types.NamespacedName{Namespace: cluster.ObjectMeta.Namespace, Name: cluster.Spec.Config.BearerTokenSecret}, secret); err != nil {
+		log.Error(err, "unable to retrieve the bearer token for the given cluster")
 		return nil, err
 	}
-	return k8s.NewK8sManagedClusterClientDoOrDie(config), nil
+	fingerprint := fmt.Sprintf("%d/%s", cluster.ObjectMeta.Generation, secret.ResourceVersion)
+
+	return r.ClientPool.Get(ctx, ClientPoolKey(cluster), fingerprint, func() (*rest.Config, error) {
+		return utils.PrepareK8sRestConfigFromClusterCR(ctx, cluster, secret)
+	})
+}
+
+//ClientPoolKey returns the client pool key for the cluster
+func ClientPoolKey(cluster *managerv1alpha1.Cluster) string {
+	return fmt.Sprintf("%s/%s", cluster.ObjectMeta.Namespace, cluster.ObjectMeta.Name)
 }
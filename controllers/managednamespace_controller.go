@@ -17,18 +17,24 @@ package controllers
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"github.com/go-logr/logr"
 	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
 	controllercommon "github.com/keikoproj/manager/controllers/common"
-	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/audit"
 	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/placement"
+	"github.com/keikoproj/manager/pkg/retry"
 	"github.com/keikoproj/manager/pkg/template"
+	"github.com/keikoproj/manager/pkg/tracing"
 	"github.com/pborman/uuid"
 	"k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/types"
 	"k8s.io/client-go/tools/record"
@@ -40,6 +46,10 @@ const (
 	namespaceFinalizerName = "namespace.finalizers.manager.keikoproj.io"
 )
 
+//errAwaitingAgent is returned for the clusters in agent mode since manager can not reach them.
+//Agent applies the changes and reports the state back
+var errAwaitingAgent = errors.New("waiting for the agent to sync the namespace")
+
 // ManagedNamespaceReconciler reconciles a ManagedNamespace object
 type ManagedNamespaceReconciler struct {
 	client.Client
@@ -47,6 +57,7 @@ type ManagedNamespaceReconciler struct {
 	Scheme        *runtime.Scheme
 	Recorder      record.EventRecorder
 	K8sSelfClient *k8s.Client
+	ClientPool    *k8s.ClientPool
 }
 
 // +kubebuilder:rbac:groups=manager.keikoproj.io,resources=managednamespaces,verbs=get;list;watch;create;update;patch;delete
@@ -61,6 +72,7 @@ func (r *ManagedNamespaceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, e
 	}()
 
 	ctx := context.WithValue(context.Background(), requestId, uuid.New())
+	ctx = audit.NewContext(ctx, &audit.Request{Principal: auditPrincipal, Operation: "ManagedNamespace/Reconcile"})
 	log := log.Logger(ctx, "controllers", "namespace_controller", "Reconcile")
 	log = log.WithValues("namespace", req.NamespacedName)
 	log.Info("Start of the request")
@@ -70,59 +82,229 @@ func (r *ManagedNamespaceReconciler) Reconcile(req ctrl.Request) (ctrl.Result, e
 	if err := r.Get(ctx, req.NamespacedName, &ns); err != nil {
 		return ctrl.Result{}, ignoreNotFound(err)
 	}
+	ctx, span := tracing.StartReconcile(ctx, "ManagedNamespace/Reconcile", "ManagedNamespace", &ns)
+	defer span.End()
 	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient}
 
-	//K8s client for the managed cluster
-	k8sManagedClient, err := r.ManagedClusterClient(ctx, &ns)
-	if err != nil {
-		log.Error(err, "unable to get the cluster details for the namespace")
-		desc := fmt.Sprintf("unable to get the cluster details for the namespace due to error %s", err.Error())
-		r.Recorder.Event(&ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-		ns.Status = managerv1alpha1.ManagedNamespaceStatus{RetryCount: ns.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-		return commonClient.UpdateStatus(ctx, &ns, managerv1alpha1.Error, errRequeueTime)
+	// Isit being deleted?
+	if !ns.ObjectMeta.DeletionTimestamp.IsZero() {
+		//oh oh.. This is delete use case
+		return r.HandleDelete(ctx, &ns)
 	}
 
-	firstTime := false
-	// Isit being deleted?
-	if ns.ObjectMeta.DeletionTimestamp.IsZero() {
-		//Good. This is not Delete use case
-		//Lets check if this is very first time use case
-		//TODO: We probably need to add finalizer to the cluster resource for each mns
-
-		if !utils.ContainsString(ns.ObjectMeta.Finalizers, namespaceFinalizerName) {
-			log.Info("New managed namespace resource. Adding the finalizer", "finalizer", namespaceFinalizerName)
-			ns.ObjectMeta.Finalizers = append(ns.ObjectMeta.Finalizers, namespaceFinalizerName)
-			firstTime = true
-			commonClient.UpdateMeta(ctx, &ns)
-		}
+	if ns.IsFailed() {
+		log.Info("Reconcile failed permanently for the current spec. Waiting for the spec change", "error", ns.Status.ErrorDescription)
+		return ctrl.Result{}, nil
+	}
 
-	} else {
-		//oh oh.. This is delete use case
-		//Lets make sure to clean up the iam role
-		if ns.Status.RetryCount != 0 {
-			ns.Status.RetryCount = ns.Status.RetryCount + 1
+	if len(ns.TargetClusterNames()) == 0 {
+		if err := r.ScheduleNamespace(ctx, &ns); err != nil {
+			log.Error(err, "unable to schedule the namespace to a cluster")
+			desc := fmt.Sprintf("unable to schedule the namespace to a cluster due to error %s", err.Error())
+			return r.reconcileFailed(ctx, &ns, managerv1alpha1.Error, desc, err)
 		}
-		log.Info("Namespace delete request")
+	}
 
-		// Ok. Lets delete the finalizer so controller can delete the custom object
-		log.Info("Removing finalizer from managed namespace")
-		ns.ObjectMeta.Finalizers = utils.RemoveString(ns.ObjectMeta.Finalizers, namespaceFinalizerName)
+	//Good. This is not Delete use case
+	//Lets check if this is very first time use case
+	firstTime := false
+	if !utils.ContainsString(ns.ObjectMeta.Finalizers, namespaceFinalizerName) {
+		log.Info("New managed namespace resource. Adding the finalizer", "finalizer", namespaceFinalizerName)
+		ns.ObjectMeta.Finalizers = append(ns.ObjectMeta.Finalizers, namespaceFinalizerName)
+		firstTime = true
+		removeClusterOwnerReferences(&ns)
+		commonClient.UpdateMeta(ctx, &ns)
+	} else if removeClusterOwnerReferences(&ns) {
+		log.Info("Removing the cluster owner reference")
 		commonClient.UpdateMeta(ctx, &ns)
-		log.Info("Successfully deleted managed namespace")
-		r.Recorder.Event(&ns, v1.EventTypeNormal, "Deleted", "Successfully deleted managed namespace")
-		return ctrl.Result{}, nil
 	}
 
 	// Final template to be processed
 	if err := r.FinalNSTemplate(ctx, &ns); err != nil {
 		log.Error(err, "unable to process namespace template", "template", ns.Spec.TemplateName)
 		desc := fmt.Sprintf("unable to process namespace template due to error %s", err.Error())
-		r.Recorder.Event(&ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-		ns.Status = managerv1alpha1.ManagedNamespaceStatus{RetryCount: ns.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-		return commonClient.UpdateStatus(ctx, &ns, managerv1alpha1.Error, errRequeueTime)
+		return r.reconcileFailed(ctx, &ns, managerv1alpha1.Error, desc, err)
+	}
+
+	return r.HandleClusters(ctx, &ns, firstTime)
+}
+
+//HandleClusters reconciles the namespace in each of the target clusters independently
+//and cleans up the clusters which are not targeted anymore based on the deletion policy
+func (r *ManagedNamespaceReconciler) HandleClusters(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, firstTime bool) (ctrl.Result, error) {
+	log := log.Logger(ctx, "controllers", "managednamespace_controller", "HandleClusters")
+	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder}
+
+	targets := ns.TargetClusterNames()
+	var statuses []managerv1alpha1.ClusterNamespaceStatus
+	//permanent is true as long as every cluster failed with the permanent error
+	permanent := true
+
+	for _, cs := range ns.Status.Clusters {
+		if utils.ContainsString(targets, cs.ClusterName) {
+			continue
+		}
+		log.Info("Cluster is not targeted anymore", "cluster", cs.ClusterName)
+		if err := r.CleanupCluster(ctx, ns, cs); err == errAwaitingAgent {
+			//Agent deletes the namespace and the entry gets removed once it is confirmed
+			cs.State = managerv1alpha1.Pending
+			cs.ErrorDescription = ""
+			statuses = append(statuses, cs)
+		} else if err != nil {
+			desc := fmt.Sprintf("unable to clean up the namespace in cluster %s due to error %s", cs.ClusterName, err.Error())
+			r.Recorder.Event(ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
+			cs.State = managerv1alpha1.Error
+			cs.ErrorDescription = desc
+			permanent = permanent && retry.IsPermanent(err)
+			//Keep it in the status so that clean up is retried
+			statuses = append(statuses, cs)
+		}
+	}
+
+	for _, clusterName := range targets {
+		cs := managerv1alpha1.ClusterNamespaceStatus{ClusterName: clusterName}
+		if existing := ns.ClusterStatus(clusterName); existing != nil {
+			cs = *existing
+		}
+		cs.Namespace = ns.Spec.NsResources.Namespace.Name
+		//Cluster added to the list later must be treated as first time too
+		clusterFirstTime := firstTime || (len(ns.Status.Clusters) > 0 && cs.LastSyncTime == nil)
+
+		if err := r.ApplyToCluster(ctx, ns, clusterName, clusterFirstTime); err == errAwaitingAgent {
+			//State reported by the agent is retained
+			if cs.State == "" {
+				cs.State = managerv1alpha1.Pending
+			}
+		} else if err != nil {
+			log.Error(err, "unable to reconcile the namespace in the cluster", "cluster", clusterName)
+			desc := fmt.Sprintf("unable to reconcile the namespace in cluster %s due to error %s", clusterName, err.Error())
+			r.Recorder.Event(ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
+			cs.State = managerv1alpha1.Error
+			cs.ErrorDescription = desc
+			permanent = permanent && retry.IsPermanent(err)
+		} else {
+			now := metav1.Now()
+			cs.State = managerv1alpha1.Ready
+			cs.ErrorDescription = ""
+			cs.LastSyncTime = &now
+		}
+		statuses = append(statuses, cs)
+	}
+	ns.Status.Clusters = statuses
+
+	if state, desc := ns.Status.ClusterSummary(); state != managerv1alpha1.Ready {
+		if state == managerv1alpha1.Pending {
+			ns.Status.ErrorDescription = desc
+			ns.Status.State = state
+			return commonClient.UpdateStatus(ctx, ns, state, errRequeueTime)
+		}
+		err := errors.New(desc)
+		//Namespace failing in some of the clusters is retried since the rest can recover
+		if state == managerv1alpha1.Error && permanent {
+			err = retry.Permanent(err)
+		}
+		return r.reconcileFailed(ctx, ns, state, desc, err)
+	}
+	log.Info("Successfully reconciled managed namespace resource", "name", ns.Name, "clusters", len(statuses))
+
+	r.Recorder.Event(ns, v1.EventTypeNormal, string(managerv1alpha1.Ready), "Successfully created managed namespace")
+	ns.Status.RetryCount = 0
+	ns.Status.FailedGeneration = 0
+	ns.Status.ErrorDescription = ""
+	ns.Status.State = managerv1alpha1.Ready
+	commonClient.UpdateStatus(ctx, ns, managerv1alpha1.Ready)
+
+	return ctrl.Result{}, nil
+}
+
+//ApplyToCluster creates/updates the namespace resources in the cluster
+func (r *ManagedNamespaceReconciler) ApplyToCluster(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, clusterName string, firstTime bool) error {
+	//K8s client for the managed cluster
+	k8sManagedClient, err := r.ManagedClusterClient(ctx, ns, clusterName)
+	if err != nil {
+		return err
+	}
+	return r.HandleNSResources(ctx, ns, k8sManagedClient, firstTime)
+}
+
+//HandleDelete cleans up the namespace in all the clusters based on the deletion policy and removes the finalizer
+func (r *ManagedNamespaceReconciler) HandleDelete(ctx context.Context, ns *managerv1alpha1.ManagedNamespace) (ctrl.Result, error) {
+	log := log.Logger(ctx, "controllers", "managednamespace_controller", "HandleDelete")
+	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder}
+	log.Info("Namespace delete request", "deletionPolicy", ns.Spec.DeletionPolicy)
+
+	if !utils.ContainsString(ns.ObjectMeta.Finalizers, namespaceFinalizerName) {
+		return ctrl.Result{}, nil
 	}
 
-	return r.HandleNSResources(ctx, &ns, k8sManagedClient, firstTime)
+	var statuses []managerv1alpha1.ClusterNamespaceStatus
+	for _, cs := range ns.Status.Clusters {
+		if err := r.CleanupCluster(ctx, ns, cs); err == errAwaitingAgent {
+			cs.State = managerv1alpha1.Pending
+			cs.ErrorDescription = ""
+			statuses = append(statuses, cs)
+		} else if err != nil {
+			desc := fmt.Sprintf("unable to clean up the namespace in cluster %s due to error %s", cs.ClusterName, err.Error())
+			r.Recorder.Event(ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
+			cs.State = managerv1alpha1.Error
+			cs.ErrorDescription = desc
+			statuses = append(statuses, cs)
+		}
+	}
+	if len(statuses) > 0 {
+		ns.Status.Clusters = statuses
+		state, _ := ns.Status.ClusterSummary()
+		if state != managerv1alpha1.Pending {
+			state = managerv1alpha1.Error
+			ns.Status.RetryCount = ns.Status.RetryCount + 1
+			controllercommon.CountRetry(ns)
+		}
+		ns.Status.ErrorDescription = fmt.Sprintf("unable to clean up the namespace in %d clusters", len(statuses))
+		ns.Status.State = state
+		if state == managerv1alpha1.Pending {
+			return commonClient.UpdateStatus(ctx, ns, state, errRequeueTime)
+		}
+		//Deletion is always retried since the spec can not change anymore
+		return commonClient.UpdateStatus(ctx, ns, state, controllercommon.RequeueAfter(ns.Status.RetryCount))
+	}
+
+	// Ok. Lets delete the finalizer so controller can delete the custom object
+	log.Info("Removing finalizer from managed namespace")
+	ns.ObjectMeta.Finalizers = utils.RemoveString(ns.ObjectMeta.Finalizers, namespaceFinalizerName)
+	commonClient.UpdateMeta(ctx, ns)
+	log.Info("Successfully deleted managed namespace")
+	r.Recorder.Event(ns, v1.EventTypeNormal, "Deleted", "Successfully deleted managed namespace")
+	return ctrl.Result{}, nil
+}
+
+//CleanupCluster deletes the namespace in the cluster if deletion policy is Delete
+func (r *ManagedNamespaceReconciler) CleanupCluster(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, cs managerv1alpha1.ClusterNamespaceStatus) error {
+	log := log.Logger(ctx, "controllers", "managednamespace_controller", "CleanupCluster")
+	log = log.WithValues("cluster", cs.ClusterName, "ns", cs.Namespace)
+
+	if ns.Spec.DeletionPolicy != managerv1alpha1.DeletionPolicyDelete || cs.Namespace == "" {
+		log.Info("Leaving the namespace as is in the cluster")
+		return nil
+	}
+	var cluster managerv1alpha1.Cluster
+	if err := r.Get(ctx, types.NamespacedName{Namespace: ns.ObjectMeta.Namespace, Name: cs.ClusterName}, &cluster); err != nil {
+		if apierrs.IsNotFound(err) {
+			//Cluster is not managed anymore. Nothing can be done here
+			log.Info("Cluster doesn't exist anymore")
+			return nil
+		}
+		return err
+	}
+	if !cluster.ObjectMeta.DeletionTimestamp.IsZero() {
+		//Cluster deletion policy decides what happens to the namespace
+		log.Info("Cluster is being deleted. Leaving the namespace to the cluster deletion policy")
+		return nil
+	}
+	k8sManagedClient, err := r.ManagedClusterClient(ctx, ns, cs.ClusterName)
+	if err != nil {
+		return err
+	}
+	return k8sManagedClient.DeleteNamespace(ctx, cs.Namespace)
 }
 
 //ResourceStatus represents each resource status
@@ -135,18 +317,13 @@ type ResourceStatus struct {
 }
 
 //HandleNSResources manages namespaces resources
-func (r *ManagedNamespaceReconciler) HandleNSResources(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, k8sManagedClient *k8s.Client, firstTime bool) (ctrl.Result, error) {
+func (r *ManagedNamespaceReconciler) HandleNSResources(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, k8sManagedClient *k8s.Client, firstTime bool) error {
 	log := log.Logger(ctx, "controllers", "managednamespace_controller", "HandleNSResources")
 
-	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder}
-
 	err := k8sManagedClient.CreateOrUpdateNamespace(ctx, ns.Spec.NsResources.Namespace)
 	if err != nil {
-		log.Error(err, "unable to create the namespace", "cluster", ns.Spec.ClusterName, "ns", ns.Spec.ClusterName)
-		desc := fmt.Sprintf("unable to create the namespace due to error %s", err.Error())
-		r.Recorder.Event(ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-		ns.Status = managerv1alpha1.ManagedNamespaceStatus{RetryCount: ns.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-		return commonClient.UpdateStatus(ctx, ns, managerv1alpha1.Error, errRequeueTime)
+		log.Error(err, "unable to create the namespace", "ns", ns.Spec.NsResources.Namespace.Name)
+		return err
 	}
 	statusMap := make(map[string]ResourceStatus)
 	var ResourceFunction func() (int, error)
@@ -160,35 +337,7 @@ func (r *ManagedNamespaceReconciler) HandleNSResources(ctx context.Context, ns *
 			if shouldProceed(ctx, statusMap, res, firstTime) {
 				n++
 				go func(statusMap map[string]ResourceStatus, res *namespace.Resource) {
-					//This is where i have to do switch case check
-					var err error
-					// Check whether
-					switch res.Type {
-					case common.ServiceAccountKind:
-						log.V(1).Info("Service Account creation is in progress", "name", res.ServiceAccount.Name)
-						err = k8sManagedClient.CreateServiceAccount(ctx, res.ServiceAccount, ns.Spec.NsResources.Namespace.Name)
-
-					case common.RoleKind:
-						log.V(1).Info("Role creation is in progress")
-						err = k8sManagedClient.CreateOrUpdateRole(ctx, res.Role, ns.Spec.NsResources.Namespace.Name)
-
-					case common.RoleBindingKind:
-						log.V(1).Info("RoleBinding creation is in progress", "name", res.RoleBinding.Name)
-						err = k8sManagedClient.CreateOrUpdateRoleBinding(ctx, res.RoleBinding, ns.Spec.NsResources.Namespace.Name)
-
-					case common.ResourceQuotaKind:
-						log.V(1).Info("Resource Quota creation is in progress")
-						err = k8sManagedClient.CreateOrUpdateResourceQuota(ctx, res.ResourceQuota, ns.Spec.NsResources.Namespace.Name)
-
-					case common.CustomResourceKind:
-						log.V(1).Info("Custom Resource creation is in progress")
-						err = k8sManagedClient.CreateOrUpdateCustomResource(ctx, res.CustomResource, ns.Spec.NsResources.Namespace.Name)
-
-					default:
-						//TODO: handle error management
-						log.Info("Invalid choice")
-
-					}
+					err := k8sManagedClient.ApplyResource(ctx, res, ns.Spec.NsResources.Namespace.Name)
 					status := statusMap[res.Name]
 					if err != nil {
 						log.Error(err, "unable to create the resource", "name", res.Name, "type", res.Type)
@@ -254,18 +403,9 @@ func (r *ManagedNamespaceReconciler) HandleNSResources(ctx context.Context, ns *
 	log.Info("Total Resources created", "count", count)
 	if err != nil {
 		log.Error(err, "unable to create one of the resource. aborting")
-		desc := fmt.Sprintf("unable to create the resource due to error %s", err.Error())
-		r.Recorder.Event(ns, v1.EventTypeWarning, string(managerv1alpha1.Error), desc)
-		ns.Status = managerv1alpha1.ManagedNamespaceStatus{RetryCount: ns.Status.RetryCount + 1, ErrorDescription: desc, State: managerv1alpha1.Error}
-		return commonClient.UpdateStatus(ctx, ns, managerv1alpha1.Error, errRequeueTime)
+		return err
 	}
-	log.Info("Successfully reconciled managed namespace resource", "name", ns.Name)
-
-	r.Recorder.Event(ns, v1.EventTypeNormal, string(managerv1alpha1.Ready), "Successfully created managed namespace")
-	ns.Status = managerv1alpha1.ManagedNamespaceStatus{RetryCount: 0, ErrorDescription: "", State: managerv1alpha1.Ready}
-	commonClient.UpdateStatus(ctx, ns, managerv1alpha1.Ready)
-
-	return ctrl.Result{}, nil
+	return nil
 }
 
 func (r *ManagedNamespaceReconciler) FinalNSTemplate(ctx context.Context, ns *managerv1alpha1.ManagedNamespace) error {
@@ -288,40 +428,47 @@ func (r *ManagedNamespaceReconciler) FinalNSTemplate(ctx context.Context, ns *ma
 
 	if err := template.ProcessTemplate(ctx, &nsTemplate, ns); err != nil {
 		log.Error(err, "unable to process namespace template", "template", ns.Spec.TemplateName)
-		return err
+		//Invalid template or params can be fixed only by changing the namespace
+		return retry.Permanent(err)
 	}
 	return nil
 }
 
-func (r *ManagedNamespaceReconciler) ManagedClusterClient(ctx context.Context, ns *managerv1alpha1.ManagedNamespace) (*k8s.Client, error) {
+//reconcileFailed records the error in the status. Transient errors are retried with backoff
+//and the permanent errors are not retried until the spec changes
+func (r *ManagedNamespaceReconciler) reconcileFailed(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, state managerv1alpha1.State, desc string, err error) (ctrl.Result, error) {
+	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder}
+	if retry.IsPermanent(err) {
+		state = managerv1alpha1.Failed
+		ns.Status.FailedGeneration = ns.Generation
+	} else {
+		ns.Status.RetryCount = ns.Status.RetryCount + 1
+		controllercommon.CountRetry(ns)
+	}
+	r.Recorder.Event(ns, v1.EventTypeWarning, string(state), desc)
+	ns.Status.ErrorDescription = desc
+	ns.Status.State = state
+	return commonClient.UpdateStatus(ctx, ns, state, controllercommon.RequeueAfter(ns.Status.RetryCount))
+}
+
+func (r *ManagedNamespaceReconciler) ManagedClusterClient(ctx context.Context, ns *managerv1alpha1.ManagedNamespace, clusterName string) (*k8s.Client, error) {
 	log := log.Logger(ctx, "controllers", "namespace_controller", "Reconcile")
-	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient}
+	commonClient := &controllercommon.Client{Client: r.Client, Recorder: r.Recorder, K8sSelfClient: r.K8sSelfClient, ClientPool: r.ClientPool}
 	// Get the cluster
-	log.V(1).Info("Retrieving cluster info")
+	log.V(1).Info("Retrieving cluster info", "cluster", clusterName)
 	var cluster managerv1alpha1.Cluster
-	clusterNamespacedName := types.NamespacedName{Namespace: ns.ObjectMeta.Namespace, Name: ns.Spec.ClusterName}
+	clusterNamespacedName := types.NamespacedName{Namespace: ns.ObjectMeta.Namespace, Name: clusterName}
 	if err := r.Get(ctx, clusterNamespacedName, &cluster); err != nil {
-		log.Error(err, "unable to get the cluster details for the namespace", "cluster", cluster.Spec.Name)
+		log.Error(err, "unable to get the cluster details for the namespace", "cluster", clusterName)
 		return nil, err
 	}
 
-	log.V(1).Info("Cluster info", "secretName", cluster.Spec.Config.BearerTokenSecret)
-
-	// lets set the reference to cluster resource as owner (may be just do this only for the first time?)
-	// Also check if this is created by Application and add owner only if its not created by application
-	isOwnerSet := false
-	for _, owner := range ns.ObjectMeta.OwnerReferences {
-		if *owner.Controller {
-			isOwnerSet = true
-		}
-	}
-	if !isOwnerSet {
-		if err := ctrl.SetControllerReference(&cluster, ns, r.Scheme); err != nil {
-			log.Error(err, "Unable to set the controller reference")
-			return nil, err
-		}
+	if cluster.IsAgentMode() {
+		return nil, errAwaitingAgent
 	}
 
+	log.V(1).Info("Cluster info", "secretName", cluster.Spec.Config.BearerTokenSecret)
+
 	k8sManagedClient, err := commonClient.ManagedClusterK8sClient(ctx, &cluster)
 	if err != nil {
 		log.Error(err, "unable to get the cluster details for the namespace", "cluster", cluster.Spec.Name)
@@ -330,13 +477,56 @@ func (r *ManagedNamespaceReconciler) ManagedClusterClient(ctx context.Context, n
 	return k8sManagedClient, nil
 }
 
+//ScheduleNamespace chooses the cluster for the namespace based on cluster selector and placement strategy
+//Chosen cluster is persisted in the status right away so that namespace stays in the same cluster
+func (r *ManagedNamespaceReconciler) ScheduleNamespace(ctx context.Context, ns *managerv1alpha1.ManagedNamespace) error {
+	log := log.Logger(ctx, "controllers", "namespace_controller", "ScheduleNamespace")
+
+	var clusterList managerv1alpha1.ClusterList
+	if err := r.List(ctx, &clusterList, client.InNamespace(ns.ObjectMeta.Namespace)); err != nil {
+		log.Error(err, "unable to list the clusters")
+		return err
+	}
+	cluster, err := placement.SelectCluster(ctx, ns.Spec.ClusterSelector, ns.Spec.PlacementStrategy, clusterList.Items)
+	if err != nil {
+		return err
+	}
+
+	ns.Status.ClusterName = cluster.Name
+	if err := r.Status().Update(ctx, ns); err != nil {
+		log.Error(err, "unable to record the chosen cluster", "cluster", cluster.Name)
+		return err
+	}
+	log.Info("Namespace scheduled", "cluster", cluster.Name)
+	r.Recorder.Event(ns, v1.EventTypeNormal, "Scheduled", fmt.Sprintf("namespace scheduled to cluster %s", cluster.Name))
+	return nil
+}
+
 func (r *ManagedNamespaceReconciler) SetupWithManager(mgr ctrl.Manager) error {
 	return ctrl.NewControllerManagedBy(mgr).
 		For(&managerv1alpha1.ManagedNamespace{}).
-		WithEventFilter(controllercommon.StatusUpdatePredicate{}).
+		WithEventFilter(controllercommon.GenerationChangedPredicate{}).
 		Complete(r)
 }
 
+//removeClusterOwnerReferences removes the cluster owner references set by the earlier versions
+//Cluster deletion policy decides what happens to the managed namespaces instead of garbage collection
+//It returns true if any reference got removed
+func removeClusterOwnerReferences(ns *managerv1alpha1.ManagedNamespace) bool {
+	var owners []metav1.OwnerReference
+	for _, owner := range ns.ObjectMeta.OwnerReferences {
+		if owner.APIVersion == apiGVStr && owner.Kind == "Cluster" {
+			continue
+		}
+		owners = append(owners, owner)
+	}
+	if len(owners) == len(ns.ObjectMeta.OwnerReferences) {
+		return false
+	}
+	ns.ObjectMeta.OwnerReferences = owners
+	return true
+}
+
 //shouldProceed checks whether to proceed further
 func shouldProceed(ctx context.Context, statusMap map[string]ResourceStatus, resource *namespace.Resource, firstTime bool) bool {
 	log := log.Logger(ctx, "controllers", "managednamespace_controller", "shouldProceed")
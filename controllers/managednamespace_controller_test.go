@@ -3,9 +3,23 @@ package controllers
 import (
 	"context"
 	"errors"
+	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/retry"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/kubernetes/scheme"
+	k8stesting "k8s.io/client-go/testing"
+	"k8s.io/client-go/tools/record"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 )
 
 var _ = Describe("ManagedNamespaceController", func() {
@@ -227,4 +241,29 @@ var _ = Describe("ManagedNamespaceController", func() {
 		})
 	})
 
+	Describe("HandleNSResources", func() {
+		Context("managed cluster forbids the namespace", func() {
+			It("should fail permanently", func() {
+				Expect(managerv1alpha1.AddToScheme(scheme.Scheme)).To(Succeed())
+				mns := &managerv1alpha1.ManagedNamespace{ObjectMeta: metav1.ObjectMeta{Name: "team-app-dev", Namespace: common.ManagerDeployedNamespace, Generation: 1}}
+				mns.Spec.NsResources = &namespace.NamespaceResources{Namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "team-app-dev"}}}
+				cl := fakeclient.NewFakeClientWithScheme(scheme.Scheme, mns)
+				r := &ManagedNamespaceReconciler{Client: cl, Recorder: record.NewFakeRecorder(10)}
+				managedCS := fake.NewSimpleClientset()
+				managedCS.PrependReactor("create", "namespaces", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, apierrs.NewForbidden(corev1.Resource("namespaces"), "team-app-dev", errors.New("denied"))
+				})
+
+				err := r.HandleNSResources(context.Background(), mns, k8s.NewK8sClient(managedCS), true)
+				Expect(apierrs.IsForbidden(err)).To(BeTrue())
+				Expect(retry.IsPermanent(err)).To(BeTrue())
+
+				_, err = r.reconcileFailed(context.Background(), mns, managerv1alpha1.Error, err.Error(), err)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(mns.Status.State).To(Equal(managerv1alpha1.Failed))
+				Expect(mns.Status.FailedGeneration).To(Equal(mns.Generation))
+				Expect(mns.Status.RetryCount).To(BeZero())
+			})
+		})
+	})
 })
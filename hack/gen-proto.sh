@@ -1,6 +1,9 @@
 #!/usr/bin/env bash
 
 search_dir=pkg/grpc/proto
+api_dir=$search_dir/apis
+#google/api/annotations.proto used for the REST gateway bindings
+googleapis=`go list -m -f '{{.Dir}}' github.com/grpc-ecosystem/grpc-gateway`/third_party/googleapis
 
 for entry in "$search_dir"/*
 do
@@ -8,6 +11,18 @@ do
   for pFile in $protoFile;
   do
     echo "Generating protogen files for $pFile"
-    protoc -I. -Ivendor/ --go_out=paths=source_relative,plugins=grpc:. $pFile
+    protoc -I. -Ivendor/ -I$googleapis --go_out=paths=source_relative,plugins=grpc:. $pFile
   done
-done
\ No newline at end of file
+done
+
+#REST gateway and the OpenAPI document for the services with http bindings
+services=`grep -l "google.api.http" $api_dir/*.proto`
+for pFile in $services;
+do
+  echo "Generating gateway files for $pFile"
+  protoc -I. -Ivendor/ -I$googleapis --grpc-gateway_out=paths=source_relative,logtostderr=true:. $pFile
+done
+
+echo "Generating OpenAPI document"
+protoc -I. -Ivendor/ -I$googleapis --swagger_out=allow_merge=true,merge_file_name=manager,logtostderr=true:$api_dir $services
+./hack/gen-openapi.sh $api_dir/manager.swagger.json server/gateway/openapi.go
@@ -11,6 +11,15 @@ const (
 
 	ManagerClusterRoleBinding = "keiko-manager-cluster-role-binding"
 
+	ManagerTokenRole = "keiko-manager-token-role"
+
+	ManagerTokenRoleBinding = "keiko-manager-token-role-binding"
+
+	//ManagerTokenSecretA and ManagerTokenSecretB are the service account token secrets manager alternates between while rotating the bearer token
+	ManagerTokenSecretA = "keiko-manager-sa-token-a"
+
+	ManagerTokenSecretB = "keiko-manager-sa-token-b"
+
 	RBACApiVersion = "rbac.authorization.k8s.io/v1"
 
 	ServiceAccountKind = "ServiceAccount"
@@ -30,11 +39,34 @@ const (
 	CustomResourceKind = "CustomResource"
 
 	ManagerDeployedNamespace = "manager-system"
+
+	//AgentSecretName is the secret in the system namespace of the agent mode cluster holding the agent token
+	AgentSecretName = "keiko-manager-agent"
+
+	//ResyncAnnotation is updated to trigger the reconcile of the resource right away
+	ResyncAnnotation = "manager.keikoproj.io/resync"
+
+	//ApplicationLabel is set on the managed namespaces created for the application environments
+	ApplicationLabel = "manager.keikoproj.io/application"
+
+	//EnvironmentLabel is set on the managed namespaces created for the application environments
+	EnvironmentLabel = "manager.keikoproj.io/environment"
+
+	//PromoteAnnotation names the application environment to be promoted next when the rollout requires manual promotion
+	PromoteAnnotation = "manager.keikoproj.io/promote"
 )
 
 const (
 	PropertyClusterValidationFrequency = "cluster.validation.frequency"
 
+	PropertyTokenRotationFrequency = "cluster.token.rotation.frequency"
+
+	PropertyAgentHeartbeatTimeout = "cluster.agent.heartbeat.timeout"
+
+	PropertyMaxRetryBackoff = "reconcile.retry.backoff.max"
+
+	PropertyNamespaceNamingPattern = "application.namespace.naming.pattern"
+
 	// ManagerNamespaceName is the namespace name where manager controllers are running
 	ManagerNamespaceName = "manager-system"
 
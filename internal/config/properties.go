@@ -18,6 +18,10 @@ var (
 
 type Properties struct {
 	clusterValidationFrequency int
+	tokenRotationFrequency     int
+	agentHeartbeatTimeout      int
+	maxRetryBackoff            int
+	namespaceNamingPattern     string
 }
 
 func init() {
@@ -69,6 +73,42 @@ func LoadProperties(env string, cm ...*v1.ConfigMap) error {
 		Props.clusterValidationFrequency = 1800
 	}
 
+	TokenRotationFrequency := cm[0].Data[common.PropertyTokenRotationFrequency]
+	if TokenRotationFrequency != "" {
+		TokenRotationFrequency, err := strconv.Atoi(TokenRotationFrequency)
+		if err != nil {
+			return err
+		}
+		Props.tokenRotationFrequency = TokenRotationFrequency
+	} else {
+		//7 days
+		Props.tokenRotationFrequency = 604800
+	}
+
+	AgentHeartbeatTimeout := cm[0].Data[common.PropertyAgentHeartbeatTimeout]
+	if AgentHeartbeatTimeout != "" {
+		AgentHeartbeatTimeout, err := strconv.Atoi(AgentHeartbeatTimeout)
+		if err != nil {
+			return err
+		}
+		Props.agentHeartbeatTimeout = AgentHeartbeatTimeout
+	} else {
+		Props.agentHeartbeatTimeout = 300
+	}
+
+	MaxRetryBackoff := cm[0].Data[common.PropertyMaxRetryBackoff]
+	if MaxRetryBackoff != "" {
+		MaxRetryBackoff, err := strconv.Atoi(MaxRetryBackoff)
+		if err != nil {
+			return err
+		}
+		Props.maxRetryBackoff = MaxRetryBackoff
+	} else {
+		Props.maxRetryBackoff = 300
+	}
+
+	Props.namespaceNamingPattern = cm[0].Data[common.PropertyNamespaceNamingPattern]
+
 	return nil
 }
 
@@ -76,6 +116,27 @@ func (p *Properties) ClusterValidationFrequency() int {
 	return p.clusterValidationFrequency
 }
 
+//TokenRotationFrequency returns the managed cluster bearer token rotation frequency in seconds. 0 disables the rotation
+func (p *Properties) TokenRotationFrequency() int {
+	return p.tokenRotationFrequency
+}
+
+//AgentHeartbeatTimeout returns the time in seconds after which the agent mode cluster is considered unreachable if agent doesn't sync
+func (p *Properties) AgentHeartbeatTimeout() int {
+	return p.agentHeartbeatTimeout
+}
+
+//MaxRetryBackoff returns the max time in seconds to wait before retrying the reconcile failed with a transient error
+func (p *Properties) MaxRetryBackoff() int {
+	return p.maxRetryBackoff
+}
+
+//NamespaceNamingPattern returns the pattern used to name the managed namespaces of the application environments
+//Empty value means the default pattern
+func (p *Properties) NamespaceNamingPattern() string {
+	return p.namespaceNamingPattern
+}
+
 func RunConfigMapInformer(ctx context.Context) {
 	log := log.Logger(context.Background(), "internal.config.properties", "RunConfigMapInformer")
 	cmInformer := k8s.GetConfigMapInformer(ctx, common.ManagerNamespaceName, common.ManagerConfigMapName)
@@ -0,0 +1,7 @@
+package utils
+
+//Unexported token helpers are exposed to the tests in utils_test package
+var (
+	ConfirmTokensMatch = confirmTokensMatch
+	DeleteOldToken     = deleteOldToken
+)
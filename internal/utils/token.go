@@ -1,24 +1,175 @@
 package utils
 
-import "k8s.io/api/core/v1"
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"k8s.io/api/core/v1"
+	"k8s.io/client-go/rest"
+	"strings"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
 
 //This file contains most of the tasks related to bearer token
 
-//confirmTokensMatch validates the target cluster and local secrets
-func confirmTokensMatch() {
+//NewTokenClient creates the client used to verify the new token before it is put to use
+//It can be replaced to verify the token without a real cluster
+var NewTokenClient = k8s.NewK8sManagedClusterClient
+
+//tokenSecretNames are the service account token secrets manager alternates between while rotating the bearer token
+var tokenSecretNames = []string{common.ManagerTokenSecretA, common.ManagerTokenSecretB}
+
+//TokenKey returns the key used to store the bearer token of the cluster in the cluster secret
+func TokenKey(clusterName string) string {
+	return fmt.Sprintf("%s_%s", SanitizeName(clusterName), "config")
+}
+
+//AgentTokenKey returns the key used to store the agent token of the cluster in the cluster secret
+func AgentTokenKey(clusterName string) string {
+	return fmt.Sprintf("%s_%s", SanitizeName(clusterName), "agent")
+}
 
+//NewAgentToken generates a random token to be used by the agent to authenticate with the manager
+func NewAgentToken() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return hex.EncodeToString(b), nil
 }
 
-func deleteOldToken() {
+//confirmTokensMatch validates the target cluster and local secrets
+//It returns the service account token secret in the target cluster which holds the token currently in use
+func confirmTokensMatch(token string, secrets []v1.Secret) *v1.Secret {
+	for i := range secrets {
+		if string(secrets[i].Data[v1.ServiceAccountTokenKey]) == token {
+			return &secrets[i]
+		}
+	}
+	return nil
+}
 
+//deleteOldToken revokes the old token by deleting the service account token secret in the target cluster
+func deleteOldToken(ctx context.Context, managedClient *k8s.Client, secret *v1.Secret) error {
+	return managedClient.DeleteK8sSecret(ctx, secret.Name, secret.Namespace)
 }
 
-func createNewToken() {
+//createNewToken mints a new token for the service account in the target cluster and verifies the token works
+func createNewToken(ctx context.Context, managedClient *k8s.Client, cfg *rest.Config, name string, saName string, ns string) (*v1.Secret, error) {
+	log := log.Logger(ctx, "internal.utils", "token", "createNewToken")
+
+	secret, err := managedClient.CreateServiceAccountToken(ctx, name, saName, ns)
+	if err != nil {
+		return nil, err
+	}
 
+	//Lets make sure new token works before we start using it
+	newCfg := rest.CopyConfig(cfg)
+	newCfg.BearerToken = string(secret.Data[v1.ServiceAccountTokenKey])
+	tokenClient, err := NewTokenClient(newCfg)
+	if err == nil {
+		err = tokenClient.Ping(ctx)
+	}
+	if err != nil {
+		log.Error(err, "new token verification failed. removing the new token", "secret_name", secret.Name)
+		if err := managedClient.DeleteK8sSecret(ctx, secret.Name, ns); err != nil {
+			log.Error(err, "unable to remove the new token", "secret_name", secret.Name)
+		}
+		return nil, err
+	}
+	return secret, nil
 }
 
 //Token function provides the latest token from the list of tokens
-func Token(secret *v1.Secret) {
-	//Ret
+func Token(cr *v1alpha1.Cluster, secret *v1.Secret) (string, error) {
+	token, ok := secret.Data[TokenKey(cr.Spec.Name)]
+	if !ok {
+		return "", errors.New("bearer token doesn't exist")
+	}
+	return string(token), nil
+}
+
+//RotateToken mints a new token in the target cluster, verifies it and only then updates the cluster secret and revokes the old token
+//It returns the name of the service account token secret which backs the new token
+func RotateToken(ctx context.Context, selfClient *k8s.Client, managedClient *k8s.Client, cr *v1alpha1.Cluster, cfg *rest.Config) (string, error) {
+	log := log.Logger(ctx, "internal.utils", "token", "RotateToken")
+	log = log.WithValues("cluster", cr.Spec.Name)
+
+	secret, err := selfClient.GetK8sSecret(ctx, cr.Spec.Config.BearerTokenSecret, cr.ObjectMeta.Namespace)
+	if err != nil {
+		return "", err
+	}
+	token, err := Token(cr, secret)
+	if err != nil {
+		return "", err
+	}
+
+	//Manager is only allowed to read its own token secrets in the target cluster
+	var tokens []v1.Secret
+	for _, name := range tokenSecretNames {
+		tokenSecret, err := managedClient.GetK8sSecret(ctx, name, common.SystemNameSpace)
+		if err != nil {
+			if apierr.IsNotFound(err) {
+				continue
+			}
+			return "", err
+		}
+		tokens = append(tokens, *tokenSecret)
+	}
+	oldToken := confirmTokensMatch(token, tokens)
+	if oldToken == nil {
+		msg := fmt.Sprintf("token in use is not one of the manager token secrets %s. Only the clusters registered with %s service account support the token rotation", strings.Join(tokenSecretNames, ", "), common.ManagerServiceAccountName)
+		err := errors.New(msg)
+		log.Error(err, msg)
+		return "", err
+	}
+	saName := oldToken.Annotations[v1.ServiceAccountNameKey]
+	log.V(1).Info("Current token located", "secret_name", oldToken.Name, "serviceAccount", saName)
+
+	//New token goes to the other token secret. Lets remove the leftover from the previous rotation if any
+	newName := common.ManagerTokenSecretA
+	if oldToken.Name == common.ManagerTokenSecretA {
+		newName = common.ManagerTokenSecretB
+	}
+	if err := managedClient.DeleteK8sSecret(ctx, newName, common.SystemNameSpace); err != nil {
+		return "", err
+	}
+	newToken, err := createNewToken(ctx, managedClient, cfg, newName, saName, common.SystemNameSpace)
+	if err != nil {
+		return "", err
+	}
+
+	//Update the cluster secret with new token
+	update := &v1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      secret.Name,
+			Namespace: secret.Namespace,
+		},
+		StringData: map[string]string{
+			TokenKey(cr.Spec.Name): string(newToken.Data[v1.ServiceAccountTokenKey]),
+		},
+	}
+	if err := selfClient.CreateOrUpdateK8sSecret(ctx, update, secret.Namespace); err != nil {
+		log.Error(err, "unable to update the cluster secret with new token. removing the new token", "secret_name", newToken.Name)
+		if err := deleteOldToken(ctx, managedClient, newToken); err != nil {
+			log.Error(err, "unable to remove the new token", "secret_name", newToken.Name)
+		}
+		return "", err
+	}
 
+	//Now it is safe to revoke the old token
+	if err := deleteOldToken(ctx, managedClient, oldToken); err != nil {
+		log.Error(err, "unable to revoke the old token", "secret_name", oldToken.Name)
+		return newToken.Name, err
+	}
+	log.Info("Successfully rotated the bearer token", "secret_name", newToken.Name)
+	return newToken.Name, nil
 }
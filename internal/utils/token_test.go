@@ -0,0 +1,249 @@
+package utils_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/internal/utils"
+	"github.com/keikoproj/manager/pkg/grpc/proto/cluster"
+	"github.com/keikoproj/manager/pkg/k8s"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+var _ = Describe("internal.utils.token test cases", func() {
+	cr := &v1alpha1.Cluster{
+		Spec: v1alpha1.ClusterSpec{
+			Cluster: cluster.Cluster{Name: "dev-patterns.manager-usw2"},
+		},
+	}
+
+	Describe("TokenKey() test cases", func() {
+		Context("cluster name with dots", func() {
+			It("should be sanitized", func() {
+				Expect(utils.TokenKey("dev-patterns.manager-usw2")).To(Equal("dev-patterns-manager-usw2_config"))
+			})
+		})
+	})
+
+	Describe("AgentTokenKey() test cases", func() {
+		Context("cluster name with dots", func() {
+			It("should be sanitized and not collide with the bearer token key", func() {
+				Expect(utils.AgentTokenKey("dev-patterns.manager-usw2")).To(Equal("dev-patterns-manager-usw2_agent"))
+				Expect(utils.AgentTokenKey("dev-patterns.manager-usw2")).NotTo(Equal(utils.TokenKey("dev-patterns.manager-usw2")))
+			})
+		})
+	})
+
+	Describe("NewAgentToken() test cases", func() {
+		Context("generating the tokens", func() {
+			It("should return unique tokens", func() {
+				first, err := utils.NewAgentToken()
+				Expect(err).NotTo(HaveOccurred())
+				second, err := utils.NewAgentToken()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(first).To(HaveLen(64))
+				Expect(first).NotTo(Equal(second))
+			})
+		})
+	})
+
+	Describe("Token() test cases", func() {
+		Context("secret with the token", func() {
+			It("should return the token", func() {
+				secret := &v1.Secret{Data: map[string][]byte{"dev-patterns-manager-usw2_config": []byte("some-token")}}
+				Expect(utils.Token(cr, secret)).To(Equal("some-token"))
+			})
+		})
+		Context("secret without the token", func() {
+			It("should return error", func() {
+				secret := &v1.Secret{Data: map[string][]byte{"other-cluster_config": []byte("some-token")}}
+				_, err := utils.Token(cr, secret)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("ConfirmTokensMatch() test cases", func() {
+		secrets := []v1.Secret{
+			{ObjectMeta: metav1.ObjectMeta{Name: "sa-token-1"}, Data: map[string][]byte{v1.ServiceAccountTokenKey: []byte("token-1")}},
+			{ObjectMeta: metav1.ObjectMeta{Name: "sa-token-2"}, Data: map[string][]byte{v1.ServiceAccountTokenKey: []byte("token-2")}},
+		}
+		Context("token in use is present", func() {
+			It("should return the secret holding the token", func() {
+				Expect(utils.ConfirmTokensMatch("token-2", secrets).Name).To(Equal("sa-token-2"))
+			})
+		})
+		Context("token in use is not present", func() {
+			It("should return nil", func() {
+				Expect(utils.ConfirmTokensMatch("token-3", secrets)).To(BeNil())
+			})
+		})
+	})
+
+	Describe("DeleteOldToken() test cases", func() {
+		Context("old token secret exists", func() {
+			It("should delete the secret", func() {
+				old := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "sa-token-1", Namespace: common.SystemNameSpace}}
+				cs := fake.NewSimpleClientset(old)
+				Expect(utils.DeleteOldToken(context.Background(), k8s.NewK8sClient(cs), old)).To(Succeed())
+				_, err := cs.CoreV1().Secrets(common.SystemNameSpace).Get(old.Name, metav1.GetOptions{})
+				Expect(apierr.IsNotFound(err)).To(BeTrue())
+			})
+		})
+		Context("old token secret is already gone", func() {
+			It("shouldn't fail", func() {
+				old := &v1.Secret{ObjectMeta: metav1.ObjectMeta{Name: "sa-token-1", Namespace: common.SystemNameSpace}}
+				Expect(utils.DeleteOldToken(context.Background(), k8s.NewK8sClient(fake.NewSimpleClientset()), old)).To(Succeed())
+			})
+		})
+	})
+
+	Describe("RotateToken() test cases", func() {
+		const clusterSecretName = "cluster-secret"
+		var (
+			rotateCR    *v1alpha1.Cluster
+			selfCS      *fake.Clientset
+			managedCS   *fake.Clientset
+			tokenClient func(cfg *rest.Config) (*k8s.Client, error)
+		)
+
+		BeforeEach(func() {
+			rotateCR = &v1alpha1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{Name: "dev-cluster", Namespace: common.ManagerDeployedNamespace},
+				Spec: v1alpha1.ClusterSpec{
+					Cluster: cluster.Cluster{Name: "dev-cluster", Config: &cluster.Config{BearerTokenSecret: clusterSecretName}},
+				},
+			}
+			selfCS = fake.NewSimpleClientset(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{Name: clusterSecretName, Namespace: common.ManagerDeployedNamespace},
+				Data:       map[string][]byte{utils.TokenKey("dev-cluster"): []byte("old-token")},
+			})
+			managedCS = fake.NewSimpleClientset(&v1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        common.ManagerTokenSecretA,
+					Namespace:   common.SystemNameSpace,
+					Annotations: map[string]string{v1.ServiceAccountNameKey: common.ManagerServiceAccountName},
+				},
+				Type: v1.SecretTypeServiceAccountToken,
+				Data: map[string][]byte{v1.ServiceAccountTokenKey: []byte("old-token")},
+			})
+			//Token controller populates the token of the new service account token secrets
+			managedCS.PrependReactor("create", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				secret := action.(k8stesting.CreateAction).GetObject().(*v1.Secret)
+				secret.Data = map[string][]byte{v1.ServiceAccountTokenKey: []byte("new-token")}
+				return false, nil, nil
+			})
+			tokenClient = utils.NewTokenClient
+			utils.NewTokenClient = func(cfg *rest.Config) (*k8s.Client, error) {
+				Expect(cfg.BearerToken).To(Equal("new-token"))
+				return k8s.NewK8sClient(managedCS), nil
+			}
+		})
+
+		AfterEach(func() {
+			utils.NewTokenClient = tokenClient
+		})
+
+		rotate := func() (string, error) {
+			return utils.RotateToken(context.Background(), k8s.NewK8sClient(selfCS), k8s.NewK8sClient(managedCS), rotateCR, &rest.Config{})
+		}
+
+		Context("new token works", func() {
+			It("should update the cluster secret and revoke the old token", func() {
+				name, err := rotate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(common.ManagerTokenSecretB))
+
+				clusterSecret, err := selfCS.CoreV1().Secrets(common.ManagerDeployedNamespace).Get(clusterSecretName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterSecret.StringData).To(HaveKeyWithValue(utils.TokenKey("dev-cluster"), "new-token"))
+
+				_, err = managedCS.CoreV1().Secrets(common.SystemNameSpace).Get(common.ManagerTokenSecretA, metav1.GetOptions{})
+				Expect(apierr.IsNotFound(err)).To(BeTrue())
+			})
+		})
+
+		Context("other token secret is left over from the previous rotation", func() {
+			It("should replace it with the new token", func() {
+				_, err := managedCS.CoreV1().Secrets(common.SystemNameSpace).Create(&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: common.ManagerTokenSecretB, Namespace: common.SystemNameSpace},
+					Data:       map[string][]byte{v1.ServiceAccountTokenKey: []byte("stale-token")},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				name, err := rotate()
+				Expect(err).NotTo(HaveOccurred())
+				Expect(name).To(Equal(common.ManagerTokenSecretB))
+
+				secret, err := managedCS.CoreV1().Secrets(common.SystemNameSpace).Get(common.ManagerTokenSecretB, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(string(secret.Data[v1.ServiceAccountTokenKey])).To(Equal("new-token"))
+				Expect(secret.Annotations).To(HaveKeyWithValue(v1.ServiceAccountNameKey, common.ManagerServiceAccountName))
+			})
+		})
+
+		Context("token in use is not found in the target cluster", func() {
+			It("should fail without minting a new token", func() {
+				selfCS = fake.NewSimpleClientset(&v1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: clusterSecretName, Namespace: common.ManagerDeployedNamespace},
+					Data:       map[string][]byte{utils.TokenKey("dev-cluster"): []byte("unknown-token")},
+				})
+				name, err := rotate()
+				Expect(err).To(HaveOccurred())
+				Expect(name).To(BeEmpty())
+
+				secrets, err := managedCS.CoreV1().Secrets(common.SystemNameSpace).List(metav1.ListOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secrets.Items).To(HaveLen(1))
+			})
+		})
+
+		Context("new token doesn't work", func() {
+			It("should remove the new token and keep the old token", func() {
+				utils.NewTokenClient = func(cfg *rest.Config) (*k8s.Client, error) {
+					return nil, errors.New("bad config")
+				}
+				name, err := rotate()
+				Expect(err).To(HaveOccurred())
+				Expect(name).To(BeEmpty())
+
+				clusterSecret, err := selfCS.CoreV1().Secrets(common.ManagerDeployedNamespace).Get(clusterSecretName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterSecret.StringData).To(BeEmpty())
+
+				secrets, err := managedCS.CoreV1().Secrets(common.SystemNameSpace).List(metav1.ListOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(secrets.Items).To(HaveLen(1))
+				Expect(secrets.Items[0].Name).To(Equal(common.ManagerTokenSecretA))
+			})
+		})
+
+		Context("old token can't be revoked", func() {
+			It("should return the new token along with the error", func() {
+				managedCS.PrependReactor("delete", "secrets", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					name := action.(k8stesting.DeleteAction).GetName()
+					if name != common.ManagerTokenSecretA {
+						return false, nil, nil
+					}
+					return true, nil, apierr.NewForbidden(v1.Resource("secrets"), name, errors.New("denied"))
+				})
+				name, err := rotate()
+				Expect(err).To(HaveOccurred())
+				Expect(name).To(Equal(common.ManagerTokenSecretB))
+
+				clusterSecret, err := selfCS.CoreV1().Secrets(common.ManagerDeployedNamespace).Get(clusterSecretName, metav1.GetOptions{})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(clusterSecret.StringData).To(HaveKeyWithValue(utils.TokenKey("dev-cluster"), "new-token"))
+			})
+		})
+	})
+})
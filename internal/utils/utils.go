@@ -2,7 +2,6 @@ package utils
 
 import (
 	"context"
-	"errors"
 	"fmt"
 	"github.com/keikoproj/manager/api/v1alpha1"
 	"github.com/keikoproj/manager/pkg/log"
@@ -29,17 +28,15 @@ func SanitizeName(name string) string {
 //PrepareK8sRestConfigFromClusterCR
 func PrepareK8sRestConfigFromClusterCR(ctx context.Context, cr *v1alpha1.Cluster, secret *v1.Secret) (*rest.Config, error) {
 	log := log.Logger(ctx, "internal.utils", "PrepareK8sRestConfigFromClusterCR")
-	token, ok := secret.Data[fmt.Sprintf("%s_%s", SanitizeName(cr.Spec.Name), "config")]
-	if !ok {
-		msg := "bearer token doesn't exist"
-		err := errors.New(msg)
-		log.Error(err, msg)
+	token, err := Token(cr, secret)
+	if err != nil {
+		log.Error(err, "unable to get the bearer token from the secret")
 		return nil, err
 	}
 
 	conf := &rest.Config{
 		Host:        cr.Spec.Config.Host,
-		BearerToken: string(token),
+		BearerToken: token,
 		TLSClientConfig: rest.TLSClientConfig{
 			CAData:     cr.Spec.Config.TlsClientConfig.CaData,
 			ServerName: cr.Spec.Config.TlsClientConfig.ServerName,
@@ -21,13 +21,18 @@ import (
 	"os"
 
 	"github.com/keikoproj/manager/internal/config"
+	"github.com/keikoproj/manager/pkg/audit"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"github.com/keikoproj/manager/pkg/webhooks"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	_ "k8s.io/client-go/plugin/pkg/client/auth/gcp"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
 
 	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
 	"github.com/keikoproj/manager/controllers"
@@ -50,12 +55,24 @@ func main() {
 	var metricsAddr string
 	var enableLeaderElection bool
 	var debug bool
+	var auditCfg audit.Config
+	var tracingCfg tracing.Config
+	var sampleRatio float64
+	var enableWebhooks bool
 
 	flag.StringVar(&metricsAddr, "metrics-addr", ":8080", "The address the metric endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "enable-leader-election", false,
 		"Enable leader election for controller manager. Enabling this will ensure there is only one active controller manager.")
 	flag.BoolVar(&debug, "debug", false, "Enable Debug?")
-
+	flag.BoolVar(&auditCfg.Stdout, "audit-stdout", false, "Write the audit records of the changes made to the clusters to stdout")
+	flag.StringVar(&auditCfg.File, "audit-file", "", "Append the audit records of the changes made to the clusters to the file")
+	flag.StringVar(&auditCfg.Webhook.URL, "audit-webhook-url", "", "Post the audit records of the changes made to the clusters to the url")
+	flag.StringVar(&tracingCfg.Exporter, "tracing-exporter", tracing.ExporterNone, "Export the spans using none, otlp or stdout")
+	flag.StringVar(&tracingCfg.Endpoint, "tracing-endpoint", "", "The OTLP gRPC collector address. Defaults to OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317")
+	flag.BoolVar(&tracingCfg.Insecure, "tracing-insecure", false, "Connect to the OTLP collector without TLS")
+	flag.Float64Var(&sampleRatio, "tracing-sample-ratio", 1, "The fraction of the new traces sampled")
+
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", false, "Serve the admission webhooks that reject applications whose environment namespaces collide. Requires the serving certificate in /tmp/k8s-webhook-server/serving-certs, config/default mounts it from cert-manager")
 	flag.Parse()
 
 	log.New()
@@ -63,6 +80,22 @@ func main() {
 
 	go config.RunConfigMapInformer(context.Background())
 
+	auditor, err := audit.NewFromConfig(auditCfg)
+	if err != nil {
+		log.Error(err, "unable to create the audit sinks")
+		os.Exit(1)
+	}
+	defer auditor.Close()
+	audit.SetDefault(auditor)
+
+	tracingCfg.SampleRatio = &sampleRatio
+	shutdownTracing, err := tracing.Setup(context.Background(), "manager-controller", tracingCfg)
+	if err != nil {
+		log.Error(err, "unable to set up the tracing")
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
 	mgr, err := ctrl.NewManager(ctrl.GetConfigOrDie(), ctrl.Options{
 		Scheme:             scheme,
 		MetricsBindAddress: metricsAddr,
@@ -75,14 +108,21 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err := metrics.Register(metrics.NewFleetCollector(mgr.GetClient())); err != nil {
+		log.Error(err, "unable to register the fleet metrics")
+		os.Exit(1)
+	}
+
 	log.V(1).Info("Setting up reconciler with manager")
 	k8sSelfClient := k8s.NewK8sSelfClientDoOrDie()
 	recorder := k8sSelfClient.SetUpEventHandler(context.Background())
+	clientPool := k8s.NewClientPool()
 	if err = (&controllers.ClusterReconciler{
 		Client:        mgr.GetClient(),
 		Log:           ctrl.Log.WithName("controllers").WithName("Cluster"),
 		Scheme:        mgr.GetScheme(),
 		K8sSelfClient: k8sSelfClient,
+		ClientPool:    clientPool,
 		Recorder:      recorder,
 	}).SetupWithManager(mgr); err != nil {
 		log.Error(err, "unable to create controller", "controller", "Cluster")
@@ -95,6 +135,7 @@ func main() {
 		Scheme:        mgr.GetScheme(),
 		Recorder:      recorder,
 		K8sSelfClient: k8sSelfClient,
+		ClientPool:    clientPool,
 	}).SetupWithManager(mgr); err != nil {
 		log.Error(err, "unable to create controller", "controller", "ManagedNamespace")
 		os.Exit(1)
@@ -112,6 +153,13 @@ func main() {
 	}
 	// +kubebuilder:scaffold:builder
 
+	if enableWebhooks {
+		mgr.GetWebhookServer().Register(webhooks.ApplicationValidatorPath, &webhook.Admission{Handler: &webhooks.ApplicationValidator{
+			Client:        mgr.GetClient(),
+			NamingPattern: config.Props.NamespaceNamingPattern,
+		}})
+	}
+
 	log.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
 		log.Error(err, "problem running manager")
@@ -0,0 +1,166 @@
+package agent
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/rbac"
+	"strings"
+	"time"
+
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	rbacv1 "k8s.io/api/rbac/v1"
+)
+
+//Agent pulls the desired state of the managed namespaces from manager and applies it in the cluster it is running in.
+//It is used for the clusters manager can not reach
+type Agent struct {
+	ClusterName string
+	Client      apis.AgentServiceClient
+	K8sClient   *k8s.Client
+	Interval    time.Duration
+}
+
+//Run syncs with the manager every interval until the context is done
+func (a *Agent) Run(ctx context.Context) {
+	log := log.Logger(ctx, "pkg.agent", "agent", "Run")
+
+	ticker := time.NewTicker(a.Interval)
+	defer ticker.Stop()
+	for {
+		if err := a.SyncOnce(ctx); err != nil {
+			log.Error(err, "unable to sync with the manager")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+//SyncOnce pulls the desired state from the manager, applies it and reports the status back
+func (a *Agent) SyncOnce(ctx context.Context) error {
+	log := log.Logger(ctx, "pkg.agent", "agent", "SyncOnce")
+
+	req := &apis.SyncRequest{ClusterName: a.ClusterName}
+	if version, _, err := a.K8sClient.ServerVersion(ctx); err == nil {
+		req.KubernetesVersion = version
+	}
+	if inventory, err := a.K8sClient.NodeInventory(ctx); err == nil {
+		req.NodeCount = int32(inventory.NodeCount)
+	}
+	resp, err := a.Client.Sync(ctx, req)
+	if err != nil {
+		return err
+	}
+	log.V(1).Info("Desired state received", "namespaces", len(resp.Namespaces), "deletions", len(resp.Deletions))
+
+	if err := a.CheckRBAC(ctx, resp.Namespaces); err != nil {
+		log.Error(err, "manager cluster role doesn't cover the namespaces")
+	}
+
+	stream, err := a.Client.ReportStatus(ctx)
+	if err != nil {
+		return err
+	}
+	for _, desired := range resp.Namespaces {
+		status := &apis.NamespaceStatus{
+			ClusterName: a.ClusterName,
+			Name:        desired.Name,
+			Namespace:   desired.Resources.Namespace.Name,
+			State:       string(v1alpha1.Ready),
+		}
+		if err := a.Apply(ctx, desired.Resources); err != nil {
+			log.Error(err, "unable to apply the namespace", "mns", desired.Name)
+			status.State = string(v1alpha1.Error)
+			status.ErrorDescription = fmt.Sprintf("unable to reconcile the namespace in cluster %s due to error %s", a.ClusterName, err.Error())
+		}
+		if err := stream.Send(status); err != nil {
+			return err
+		}
+	}
+	for _, deletion := range resp.Deletions {
+		status := &apis.NamespaceStatus{
+			ClusterName: a.ClusterName,
+			Name:        deletion.Name,
+			Namespace:   deletion.Namespace,
+			Deleted:     true,
+		}
+		if err := a.K8sClient.DeleteNamespace(ctx, deletion.Namespace); err != nil {
+			status.Deleted = false
+			status.State = string(v1alpha1.Error)
+			status.ErrorDescription = fmt.Sprintf("unable to clean up the namespace in cluster %s due to error %s", a.ClusterName, err.Error())
+		}
+		if err := stream.Send(status); err != nil {
+			return err
+		}
+	}
+	_, err = stream.CloseAndRecv()
+	return err
+}
+
+//Apply creates/updates the namespace and its resources in the order of their dependencies
+func (a *Agent) Apply(ctx context.Context, resources *namespace.NamespaceResources) error {
+	ns := resources.Namespace.Name
+	//createOnly resources are created only along with the namespace
+	firstTime := a.K8sClient.GetNamespace(ctx, ns) != nil
+	if err := a.K8sClient.CreateOrUpdateNamespace(ctx, resources.Namespace); err != nil {
+		return err
+	}
+
+	done := make(map[string]bool)
+	applied := make([]bool, len(resources.Resources))
+	for remaining := len(resources.Resources); remaining > 0; {
+		progress := false
+		for i, res := range resources.Resources {
+			if applied[i] || (res.DependsOn != "" && !done[res.DependsOn]) {
+				continue
+			}
+			if res.CreateOnly != "true" || firstTime {
+				if err := a.K8sClient.ApplyResource(ctx, res, ns); err != nil {
+					return err
+				}
+			}
+			applied[i] = true
+			done[res.Name] = true
+			remaining--
+			progress = true
+		}
+		if !progress {
+			return fmt.Errorf("unable to resolve the dependencies of %d resources", remaining)
+		}
+	}
+	return nil
+}
+
+//CheckRBAC reports the permissions missing from the manager cluster role for the kinds used in the namespaces
+//Agent never grants the permissions to itself. Admin grants them with "manager cluster sync-rbac"
+func (a *Agent) CheckRBAC(ctx context.Context, namespaces []*apis.DesiredNamespace) error {
+	log := log.Logger(ctx, "pkg.agent", "agent", "CheckRBAC")
+
+	ruleSets := [][]rbacv1.PolicyRule{rbac.BaseRules()}
+	for _, desired := range namespaces {
+		rules, err := rbac.TemplateRules(desired.Resources, a.K8sClient.ResourceFor)
+		if err != nil {
+			log.Error(err, "unable to generate the rules for the namespace", "mns", desired.Name)
+			continue
+		}
+		ruleSets = append(ruleSets, rules)
+	}
+	denied, err := a.K8sClient.DeniedPermissions(ctx, rbac.Permissions(rbac.Merge(ruleSets...)))
+	if err != nil {
+		return err
+	}
+	if len(denied) > 0 {
+		var list []string
+		for _, p := range denied {
+			list = append(list, fmt.Sprintf("%s %s.%s", p.Verb, p.Resource, p.Group))
+		}
+		return fmt.Errorf("manager is not allowed to %s. Run \"manager cluster sync-rbac\" with cluster admin access to grant them", strings.Join(list, ", "))
+	}
+	return nil
+}
@@ -0,0 +1,23 @@
+package agent
+
+import (
+	"context"
+)
+
+//TokenCredentials sends the agent token in the metadata of every request
+type TokenCredentials struct {
+	Token  string
+	Secure bool
+}
+
+//GetRequestMetadata returns the authorization metadata
+func (t TokenCredentials) GetRequestMetadata(ctx context.Context, uri ...string) (map[string]string, error) {
+	return map[string]string{
+		"authorization": "Bearer " + t.Token,
+	}, nil
+}
+
+//RequireTransportSecurity returns true if the token must be sent only over TLS
+func (t TokenCredentials) RequireTransportSecurity() bool {
+	return t.Secure
+}
@@ -0,0 +1,235 @@
+package audit
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"sync"
+	"time"
+
+	"github.com/keikoproj/manager/pkg/log"
+)
+
+const (
+	//Actions on the objects
+	Create = "create"
+	Update = "update"
+	Delete = "delete"
+
+	//Results of the operation
+	Success = "success"
+	Failure = "failure"
+
+	//requestIDKey is the context key for the request id. Same key is used by the controllers and the logger
+	requestIDKey = "request_id"
+)
+
+//Record is a structured audit record
+//Every change to an object is recorded with the hash of the object before and after the change
+//Requests which didn't change any object are recorded once with the result of the request
+type Record struct {
+	Timestamp time.Time `json:"timestamp"`
+	RequestID string    `json:"request_id,omitempty"`
+	//Principal is the authenticated user or the controller who made the change
+	Principal string   `json:"principal,omitempty"`
+	Groups    []string `json:"groups,omitempty"`
+	//Operation is the grpc method or the controller operation
+	Operation string `json:"operation"`
+	//Action is create, update or delete. Empty if the request didn't change any object
+	Action string `json:"action,omitempty"`
+	//Cluster is the managed cluster the change is made in. Empty for the control plane cluster
+	Cluster    string `json:"cluster,omitempty"`
+	Object     Object `json:"object"`
+	BeforeHash string `json:"before_hash,omitempty"`
+	AfterHash  string `json:"after_hash,omitempty"`
+	Result     string `json:"result"`
+	Error      string `json:"error,omitempty"`
+}
+
+//Object identifies the object the operation is performed on
+type Object struct {
+	Kind      string `json:"kind,omitempty"`
+	Namespace string `json:"namespace,omitempty"`
+	Name      string `json:"name,omitempty"`
+}
+
+//Request contains the details of the caller shared by all the records of the same request
+type Request struct {
+	Principal string
+	Groups    []string
+	Operation string
+
+	mu      sync.Mutex
+	changes int
+}
+
+//SetPrincipal sets the authenticated caller of the request
+func (r *Request) SetPrincipal(principal string, groups []string) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	r.Principal = principal
+	r.Groups = groups
+}
+
+//Changes returns the number of changes recorded for the request
+func (r *Request) Changes() int {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+	return r.changes
+}
+
+type requestKey struct{}
+
+//NewContext returns the context carrying the request
+func NewContext(ctx context.Context, r *Request) context.Context {
+	return context.WithValue(ctx, requestKey{}, r)
+}
+
+//FromContext returns the request from the context or nil if there is none
+func FromContext(ctx context.Context) *Request {
+	r, _ := ctx.Value(requestKey{}).(*Request)
+	return r
+}
+
+//WithRequestID returns the context carrying the request id
+func WithRequestID(ctx context.Context, id string) context.Context {
+	return context.WithValue(ctx, requestIDKey, id)
+}
+
+//RequestID returns the request id from the context or empty string if there is none
+func RequestID(ctx context.Context) string {
+	if id := ctx.Value(requestIDKey); id != nil {
+		return fmt.Sprint(id)
+	}
+	return ""
+}
+
+//Auditor writes the records to all the sinks
+type Auditor struct {
+	sinks []Sink
+}
+
+//New returns the auditor writing to the sinks
+func New(sinks ...Sink) *Auditor {
+	return &Auditor{sinks: sinks}
+}
+
+//Log fills in the request details from the context and writes the record to the sinks
+//Sink failures are logged and never fail the operation being audited
+func (a *Auditor) Log(ctx context.Context, r *Record) {
+	log := log.Logger(ctx, "pkg.audit", "Auditor", "Log")
+
+	if r.Timestamp.IsZero() {
+		r.Timestamp = time.Now().UTC()
+	}
+	if r.RequestID == "" {
+		r.RequestID = RequestID(ctx)
+	}
+	if req := FromContext(ctx); req != nil {
+		req.mu.Lock()
+		if r.Principal == "" {
+			r.Principal = req.Principal
+			r.Groups = req.Groups
+		}
+		if r.Operation == "" {
+			r.Operation = req.Operation
+		}
+		if r.Action != "" {
+			req.changes++
+		}
+		req.mu.Unlock()
+	}
+	for _, s := range a.sinks {
+		if err := s.Write(r); err != nil {
+			log.Error(err, "unable to write the audit record", "sink", fmt.Sprintf("%T", s))
+		}
+	}
+}
+
+//Close closes all the sinks
+func (a *Auditor) Close() error {
+	var firstErr error
+	for _, s := range a.sinks {
+		if err := s.Close(); err != nil && firstErr == nil {
+			firstErr = err
+		}
+	}
+	return firstErr
+}
+
+var (
+	defaultMu      sync.RWMutex
+	defaultAuditor = New()
+)
+
+//SetDefault sets the auditor used by the package level functions. Records are discarded until it is set
+func SetDefault(a *Auditor) {
+	defaultMu.Lock()
+	defer defaultMu.Unlock()
+	defaultAuditor = a
+}
+
+//Log writes the record with the default auditor
+func Log(ctx context.Context, r *Record) {
+	defaultMu.RLock()
+	a := defaultAuditor
+	defaultMu.RUnlock()
+	a.Log(ctx, r)
+}
+
+//RecordChange records the change to the object with the default auditor
+//before is nil for the created objects and after is nil for the deleted objects
+//Successful updates which didn't change the object are not recorded
+func RecordChange(ctx context.Context, cluster string, action string, obj Object, before interface{}, after interface{}, err error) {
+	r := &Record{
+		Action:     action,
+		Cluster:    cluster,
+		Object:     obj,
+		BeforeHash: Hash(before),
+		AfterHash:  Hash(after),
+		Result:     Success,
+	}
+	if err != nil {
+		r.Result = Failure
+		r.Error = err.Error()
+		//After state is unknown when the change failed
+		r.AfterHash = ""
+	} else if action == Update && r.BeforeHash == r.AfterHash {
+		return
+	}
+	Log(ctx, r)
+}
+
+//volatileMetadata are the metadata fields which change without the object being changed
+var volatileMetadata = []string{"resourceVersion", "generation", "managedFields", "creationTimestamp", "uid", "selfLink"}
+
+//Hash returns the sha256 hash of the object excluding the status and the server populated metadata
+//Empty string is returned for nil
+func Hash(obj interface{}) string {
+	if obj == nil {
+		return ""
+	}
+	b, err := json.Marshal(obj)
+	if err != nil || string(b) == "null" {
+		return ""
+	}
+	fields := make(map[string]interface{})
+	if err := json.Unmarshal(b, &fields); err == nil {
+		delete(fields, "status")
+		delete(fields, "apiVersion")
+		delete(fields, "kind")
+		if meta, ok := fields["metadata"].(map[string]interface{}); ok {
+			for _, f := range volatileMetadata {
+				delete(meta, f)
+			}
+		}
+		//Map keys are sorted by json marshal, so the hash is stable
+		if b, err = json.Marshal(fields); err != nil {
+			return ""
+		}
+	}
+	sum := sha256.Sum256(b)
+	return hex.EncodeToString(sum[:])
+}
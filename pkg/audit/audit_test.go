@@ -0,0 +1,230 @@
+package audit_test
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"os"
+	"path/filepath"
+	"strings"
+	"sync"
+
+	"github.com/keikoproj/manager/pkg/audit"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//memorySink keeps the records in memory
+type memorySink struct {
+	mu      sync.Mutex
+	records []*audit.Record
+}
+
+func (s *memorySink) Write(r *audit.Record) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.records = append(s.records, r)
+	return nil
+}
+
+func (s *memorySink) Close() error {
+	return nil
+}
+
+func (s *memorySink) Records() []*audit.Record {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.records
+}
+
+type nameRequest struct {
+	name string
+}
+
+func (r *nameRequest) GetName() string {
+	return r.name
+}
+
+func configMap(data string, rv string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "cm", Namespace: "ns", ResourceVersion: rv},
+		Data:       map[string]string{"key": data},
+	}
+}
+
+var _ = Describe("Audit", func() {
+	var sink *memorySink
+
+	BeforeEach(func() {
+		sink = &memorySink{}
+		audit.SetDefault(audit.New(sink))
+	})
+
+	AfterEach(func() {
+		audit.SetDefault(audit.New())
+	})
+
+	Describe("Hash", func() {
+		It("ignores the server populated metadata and the status", func() {
+			a := configMap("value", "1")
+			b := configMap("value", "2")
+			b.UID = "uid"
+			Expect(audit.Hash(a)).To(Equal(audit.Hash(b)))
+			Expect(audit.Hash(a)).To(HaveLen(64))
+		})
+
+		It("changes when the object changes", func() {
+			Expect(audit.Hash(configMap("value", "1"))).NotTo(Equal(audit.Hash(configMap("other", "1"))))
+		})
+
+		It("returns empty string for nil objects", func() {
+			var cm *corev1.ConfigMap
+			Expect(audit.Hash(nil)).To(BeEmpty())
+			Expect(audit.Hash(cm)).To(BeEmpty())
+		})
+	})
+
+	Describe("RecordChange", func() {
+		It("fills in the request details from the context", func() {
+			req := &audit.Request{Operation: "/apis.ClusterService/RegisterCluster"}
+			req.SetPrincipal("alice", []string{"admins"})
+			ctx := audit.WithRequestID(audit.NewContext(context.Background(), req), "id-1")
+
+			audit.RecordChange(ctx, "ns/cluster", audit.Create, audit.Object{Kind: "ConfigMap", Namespace: "ns", Name: "cm"}, nil, configMap("value", "1"), nil)
+
+			Expect(sink.Records()).To(HaveLen(1))
+			r := sink.Records()[0]
+			Expect(r.RequestID).To(Equal("id-1"))
+			Expect(r.Principal).To(Equal("alice"))
+			Expect(r.Groups).To(Equal([]string{"admins"}))
+			Expect(r.Operation).To(Equal("/apis.ClusterService/RegisterCluster"))
+			Expect(r.Cluster).To(Equal("ns/cluster"))
+			Expect(r.BeforeHash).To(BeEmpty())
+			Expect(r.AfterHash).To(Equal(audit.Hash(configMap("value", "1"))))
+			Expect(r.Result).To(Equal(audit.Success))
+			Expect(r.Timestamp.IsZero()).To(BeFalse())
+			Expect(req.Changes()).To(Equal(1))
+		})
+
+		It("skips the updates which didn't change the object", func() {
+			audit.RecordChange(context.Background(), "", audit.Update, audit.Object{Kind: "ConfigMap"}, configMap("value", "1"), configMap("value", "2"), nil)
+			Expect(sink.Records()).To(BeEmpty())
+		})
+
+		It("records the failures without the after hash", func() {
+			audit.RecordChange(context.Background(), "", audit.Update, audit.Object{Kind: "ConfigMap"}, configMap("value", "1"), configMap("other", "2"), errors.New("conflict"))
+			Expect(sink.Records()).To(HaveLen(1))
+			r := sink.Records()[0]
+			Expect(r.Result).To(Equal(audit.Failure))
+			Expect(r.Error).To(Equal("conflict"))
+			Expect(r.BeforeHash).NotTo(BeEmpty())
+			Expect(r.AfterHash).To(BeEmpty())
+		})
+	})
+
+	Describe("Interceptor", func() {
+		var (
+			interceptor grpc.UnaryServerInterceptor
+			info        = &grpc.UnaryServerInfo{FullMethod: "/apis.NamespaceService/DeleteNamespace"}
+		)
+
+		BeforeEach(func() {
+			interceptor = (&audit.Interceptor{Kind: func(method string) (string, bool) {
+				return "ManagedNamespace", strings.HasSuffix(method, "DeleteNamespace")
+			}}).Unary()
+		})
+
+		It("records the failed requests", func() {
+			_, err := interceptor(context.Background(), &nameRequest{name: "team"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, status.Error(codes.PermissionDenied, "denied")
+			})
+			Expect(err).To(HaveOccurred())
+			Expect(sink.Records()).To(HaveLen(1))
+			r := sink.Records()[0]
+			Expect(r.Operation).To(Equal(info.FullMethod))
+			Expect(r.Object).To(Equal(audit.Object{Kind: "ManagedNamespace", Name: "team"}))
+			Expect(r.Result).To(Equal(audit.Failure))
+			Expect(r.Error).To(Equal("PermissionDenied: denied"))
+			Expect(r.RequestID).NotTo(BeEmpty())
+		})
+
+		It("doesn't record the request again when the changes are recorded", func() {
+			_, err := interceptor(context.Background(), &nameRequest{name: "team"}, info, func(ctx context.Context, req interface{}) (interface{}, error) {
+				audit.RecordChange(ctx, "", audit.Delete, audit.Object{Kind: "ManagedNamespace", Name: "team"}, nil, nil, nil)
+				return nil, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Records()).To(HaveLen(1))
+			Expect(sink.Records()[0].Action).To(Equal(audit.Delete))
+			Expect(sink.Records()[0].Operation).To(Equal(info.FullMethod))
+		})
+
+		It("doesn't record the read only requests", func() {
+			_, err := interceptor(context.Background(), &nameRequest{name: "team"}, &grpc.UnaryServerInfo{FullMethod: "/apis.NamespaceService/GetNamespace"}, func(ctx context.Context, req interface{}) (interface{}, error) {
+				return nil, nil
+			})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(sink.Records()).To(BeEmpty())
+		})
+	})
+
+	Describe("Sinks", func() {
+		record := func() *audit.Record {
+			return &audit.Record{RequestID: "id-1", Operation: "op", Result: audit.Success}
+		}
+
+		It("writes json lines to the writer", func() {
+			buf := &bytes.Buffer{}
+			s := audit.NewWriterSink(buf)
+			Expect(s.Write(record())).To(Succeed())
+			Expect(s.Write(record())).To(Succeed())
+			lines := strings.Split(strings.TrimSpace(buf.String()), "\n")
+			Expect(lines).To(HaveLen(2))
+			r := &audit.Record{}
+			Expect(json.Unmarshal([]byte(lines[0]), r)).To(Succeed())
+			Expect(r.RequestID).To(Equal("id-1"))
+		})
+
+		It("appends to the file", func() {
+			dir, err := ioutil.TempDir("", "audit")
+			Expect(err).NotTo(HaveOccurred())
+			defer os.RemoveAll(dir)
+			path := filepath.Join(dir, "audit.log")
+
+			for i := 0; i < 2; i++ {
+				s, err := audit.NewFileSink(path)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(s.Write(record())).To(Succeed())
+				Expect(s.Close()).To(Succeed())
+			}
+			b, err := ioutil.ReadFile(path)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(strings.Count(string(b), "\n")).To(Equal(2))
+		})
+
+		It("posts the records to the webhook", func() {
+			received := make(chan *audit.Record, 1)
+			srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+				rec := &audit.Record{}
+				Expect(json.NewDecoder(r.Body).Decode(rec)).To(Succeed())
+				received <- rec
+			}))
+			defer srv.Close()
+
+			s := audit.NewWebhookSink(srv.URL, 0)
+			Expect(s.Write(record())).To(Succeed())
+			Expect(s.Close()).To(Succeed())
+			Eventually(received).Should(Receive(WithTransform(func(r *audit.Record) string { return r.RequestID }, Equal("id-1"))))
+			Expect(s.Write(record())).NotTo(Succeed())
+		})
+	})
+})
@@ -0,0 +1,103 @@
+package audit
+
+import (
+	"context"
+
+	"github.com/pborman/uuid"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+)
+
+const (
+	//RequestIDHeader is the metadata key carrying the request id. Callers may set it to correlate the records
+	RequestIDHeader = "x-request-id"
+	//ClusterKind is the kind of the managed cluster objects. Records of the cluster objects are attributed to the cluster itself
+	ClusterKind = "Cluster"
+)
+
+//Interceptor assigns the request id to every rpc and records the mutating rpcs in the audit trail
+//It must be the outermost interceptor so that the rejected requests are recorded too
+type Interceptor struct {
+	//Kind returns the kind of the object changed by the method. Methods returning false are not recorded
+	Kind func(fullMethod string) (string, bool)
+}
+
+//Unary returns the unary server interceptor
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx = withRequestID(ctx)
+		kind, mutating := i.Kind(info.FullMethod)
+		if !mutating {
+			return handler(ctx, req)
+		}
+
+		r := &Request{Operation: info.FullMethod}
+		ctx = NewContext(ctx, r)
+		resp, err := handler(ctx, req)
+		//Changes made by the request are already recorded
+		if r.Changes() > 0 {
+			return resp, err
+		}
+		rec := &Record{
+			Object: Object{Kind: kind},
+			Result: Success,
+		}
+		rec.Cluster, rec.Object.Name = target(req)
+		if kind == ClusterKind && rec.Cluster == "" {
+			rec.Cluster = rec.Object.Name
+		}
+		if err != nil {
+			st := status.Convert(err)
+			rec.Result = Failure
+			rec.Error = st.Code().String() + ": " + st.Message()
+		}
+		Log(ctx, rec)
+		return resp, err
+	}
+}
+
+//Stream returns the stream server interceptor. Streams are read only, so only the request id is assigned
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		return handler(srv, &serverStream{ServerStream: ss, ctx: withRequestID(ss.Context())})
+	}
+}
+
+//withRequestID returns the context with the request id from the caller or a new one
+//Request id is sent back to the caller in the response header
+func withRequestID(ctx context.Context) context.Context {
+	id := ""
+	if md, ok := metadata.FromIncomingContext(ctx); ok {
+		if v := md.Get(RequestIDHeader); len(v) > 0 {
+			id = v[0]
+		}
+	}
+	if id == "" {
+		id = uuid.New()
+	}
+	grpc.SetHeader(ctx, metadata.Pairs(RequestIDHeader, id))
+	return WithRequestID(ctx, id)
+}
+
+//target returns the cluster and the object name from the request message
+//Requests of the cluster service identify the cluster with clusterName
+func target(req interface{}) (cluster string, name string) {
+	if m, ok := req.(interface{ GetClusterName() string }); ok {
+		cluster = m.GetClusterName()
+		name = cluster
+	}
+	if m, ok := req.(interface{ GetName() string }); ok {
+		name = m.GetName()
+	}
+	return cluster, name
+}
+
+type serverStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *serverStream) Context() context.Context {
+	return s.ctx
+}
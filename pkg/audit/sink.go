@@ -0,0 +1,187 @@
+package audit
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"sync"
+	"time"
+
+	"github.com/keikoproj/manager/pkg/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	//webhookQueueSize is the number of records buffered for the webhook
+	webhookQueueSize = 1000
+	//defaultWebhookTimeout is the timeout for posting a record to the webhook
+	defaultWebhookTimeout = 5 * time.Second
+)
+
+//ErrQueueFull is returned when the record is dropped because the sink is not able to keep up
+var ErrQueueFull = errors.New("audit sink queue is full")
+
+//Sink writes the audit records to the destination
+type Sink interface {
+	Write(r *Record) error
+	Close() error
+}
+
+//Config contains the audit sinks to be enabled
+type Config struct {
+	//Stdout writes the records to stdout as json lines
+	Stdout bool `json:"stdout,omitempty"`
+	//File writes the records to the file as json lines. File is created if it doesn't exist
+	File string `json:"file,omitempty"`
+	//Webhook posts every record as json to the url
+	Webhook WebhookConfig `json:"webhook,omitempty"`
+}
+
+//WebhookConfig contains the webhook sink options
+type WebhookConfig struct {
+	URL string `json:"url,omitempty"`
+	//Timeout for posting a record. Default is 5s
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+}
+
+//NewFromConfig returns the auditor with the sinks enabled in the config
+func NewFromConfig(cfg Config) (*Auditor, error) {
+	var sinks []Sink
+	if cfg.Stdout {
+		sinks = append(sinks, NewWriterSink(os.Stdout))
+	}
+	if cfg.File != "" {
+		s, err := NewFileSink(cfg.File)
+		if err != nil {
+			return nil, err
+		}
+		sinks = append(sinks, s)
+	}
+	if cfg.Webhook.URL != "" {
+		sinks = append(sinks, NewWebhookSink(cfg.Webhook.URL, cfg.Webhook.Timeout.Duration))
+	}
+	return New(sinks...), nil
+}
+
+//writerSink writes the records as json lines
+type writerSink struct {
+	mu     sync.Mutex
+	w      io.Writer
+	closer io.Closer
+}
+
+//NewWriterSink returns the sink writing the records as json lines to the writer
+func NewWriterSink(w io.Writer) Sink {
+	return &writerSink{w: w}
+}
+
+//NewFileSink returns the sink appending the records as json lines to the file
+func NewFileSink(path string) (Sink, error) {
+	f, err := os.OpenFile(path, os.O_APPEND|os.O_CREATE|os.O_WRONLY, 0600)
+	if err != nil {
+		return nil, fmt.Errorf("unable to open the audit file %s: %v", path, err)
+	}
+	return &writerSink{w: f, closer: f}, nil
+}
+
+func (s *writerSink) Write(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	_, err = s.w.Write(append(b, '\n'))
+	return err
+}
+
+func (s *writerSink) Close() error {
+	if s.closer == nil {
+		return nil
+	}
+	return s.closer.Close()
+}
+
+//webhookSink posts the records in the background so the audited operations are not slowed down by the webhook
+type webhookSink struct {
+	url    string
+	client *http.Client
+	queue  chan *Record
+	done   chan struct{}
+
+	mu     sync.RWMutex
+	closed bool
+}
+
+//NewWebhookSink returns the sink posting every record as json to the url
+//Records are dropped with ErrQueueFull if the webhook is not able to keep up
+func NewWebhookSink(url string, timeout time.Duration) Sink {
+	if timeout == 0 {
+		timeout = defaultWebhookTimeout
+	}
+	s := &webhookSink{
+		url:    url,
+		client: &http.Client{Timeout: timeout},
+		queue:  make(chan *Record, webhookQueueSize),
+		done:   make(chan struct{}),
+	}
+	go s.run()
+	return s
+}
+
+func (s *webhookSink) Write(r *Record) error {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	if s.closed {
+		return errors.New("audit sink is closed")
+	}
+	select {
+	case s.queue <- r:
+		return nil
+	default:
+		return ErrQueueFull
+	}
+}
+
+//Close sends the queued records and stops the sink
+func (s *webhookSink) Close() error {
+	s.mu.Lock()
+	if !s.closed {
+		s.closed = true
+		close(s.queue)
+	}
+	s.mu.Unlock()
+	<-s.done
+	return nil
+}
+
+func (s *webhookSink) run() {
+	log := log.Logger(context.Background(), "pkg.audit", "webhookSink", "run")
+	defer close(s.done)
+	for r := range s.queue {
+		if err := s.post(r); err != nil {
+			log.Error(err, "unable to post the audit record", "url", s.url, "request_id", r.RequestID)
+		}
+	}
+}
+
+func (s *webhookSink) post(r *Record) error {
+	b, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+	resp, err := s.client.Post(s.url, "application/json", bytes.NewReader(b))
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode >= 300 {
+		return fmt.Errorf("webhook responded with %s", resp.Status)
+	}
+	return nil
+}
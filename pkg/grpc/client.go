@@ -1,20 +1,27 @@
 package grpc
 
 import (
+	cryptotls "crypto/tls"
+	"crypto/x509"
 	"flag"
 	"fmt"
+	"github.com/keikoproj/manager/pkg/agent"
 	pb "github.com/keikoproj/manager/pkg/grpc/proto/apis"
 	"google.golang.org/grpc"
 	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/testdata"
+	"io/ioutil"
 	"log"
+	"os"
 )
 
 var (
 	tls                = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
 	caFile             = flag.String("ca_file", "", "The file containing the CA root cert file")
 	serverAddr         = flag.String("server_addr", "localhost:10000", "The server address in the format of host:port")
-	serverHostOverride = flag.String("server_host_override", "x.test.youtube.com", "The server name use to verify the hostname returned by TLS handshake")
+	serverHostOverride = flag.String("server_host_override", "", "The server name used to verify the hostname returned by TLS handshake. Defaults to the host in server_addr")
+	certFile           = flag.String("cert_file", "", "The client cert file for mTLS authentication")
+	keyFile            = flag.String("key_file", "", "The client key file for mTLS authentication")
+	token              = flag.String("token", os.Getenv("MANAGER_TOKEN"), "Kubernetes bearer token to authenticate with the server. Defaults to MANAGER_TOKEN env variable")
 )
 
 type grpcClient struct {
@@ -26,10 +33,7 @@ func NewConnectionOrDie() *grpcClient {
 	fmt.Println("Request received successfully")
 	var opts []grpc.DialOption
 	if *tls {
-		if *caFile == "" {
-			*caFile = testdata.Path("ca.pem")
-		}
-		creds, err := credentials.NewClientTLSFromFile(*caFile, *serverHostOverride)
+		creds, err := clientCredentials()
 		if err != nil {
 			log.Fatalf("Failed to create TLS credentials %v \n", err)
 		}
@@ -37,6 +41,9 @@ func NewConnectionOrDie() *grpcClient {
 	} else {
 		opts = append(opts, grpc.WithInsecure())
 	}
+	if *token != "" {
+		opts = append(opts, grpc.WithPerRPCCredentials(agent.TokenCredentials{Token: *token, Secure: *tls}))
+	}
 
 	opts = append(opts, grpc.WithBlock())
 	conn, err := grpc.Dial(*serverAddr, opts...)
@@ -47,8 +54,54 @@ func NewConnectionOrDie() *grpcClient {
 	return &grpcClient{conn: conn}
 }
 
+//clientCredentials returns the TLS credentials. Client certificate is presented if provided
+func clientCredentials() (credentials.TransportCredentials, error) {
+	config := &cryptotls.Config{
+		ServerName: *serverHostOverride,
+	}
+	if *certFile != "" && *keyFile != "" {
+		cert, err := cryptotls.LoadX509KeyPair(*certFile, *keyFile)
+		if err != nil {
+			return nil, err
+		}
+		config.Certificates = []cryptotls.Certificate{cert}
+	}
+	//System root CAs are used if the CA file is not provided
+	if *caFile != "" {
+		ca, err := ioutil.ReadFile(*caFile)
+		if err != nil {
+			return nil, err
+		}
+		config.RootCAs = x509.NewCertPool()
+		if !config.RootCAs.AppendCertsFromPEM(ca) {
+			return nil, fmt.Errorf("unable to parse the CA file %s", *caFile)
+		}
+	}
+	return credentials.NewTLS(config), nil
+}
+
 //NewClusterClientOrDie function returns cluster client
 func (client *grpcClient) NewClusterClientOrDie() pb.ClusterServiceClient {
 	fmt.Println("Cluster client created successfully")
 	return pb.NewClusterServiceClient(client.conn)
 }
+
+//NewNamespaceClientOrDie function returns namespace client
+func (client *grpcClient) NewNamespaceClientOrDie() pb.NamespaceServiceClient {
+	return pb.NewNamespaceServiceClient(client.conn)
+}
+
+//NewApplicationClientOrDie function returns application client
+func (client *grpcClient) NewApplicationClientOrDie() pb.ApplicationServiceClient {
+	return pb.NewApplicationServiceClient(client.conn)
+}
+
+//NewTemplateClientOrDie function returns template client
+func (client *grpcClient) NewTemplateClientOrDie() pb.TemplateServiceClient {
+	return pb.NewTemplateServiceClient(client.conn)
+}
+
+//NewWatchClientOrDie function returns watch client
+func (client *grpcClient) NewWatchClientOrDie() pb.WatchServiceClient {
+	return pb.NewWatchServiceClient(client.conn)
+}
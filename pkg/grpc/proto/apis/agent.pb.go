@@ -0,0 +1,543 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pkg/grpc/proto/apis/agent.proto
+
+package apis
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	namespace "github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type SyncRequest struct {
+	//clusterName of the cluster agent is running in
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//kubernetesVersion of the cluster
+	KubernetesVersion string `protobuf:"bytes,2,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	//nodeCount is the number of nodes in the cluster
+	NodeCount            int32    `protobuf:"varint,3,opt,name=nodeCount,proto3" json:"nodeCount,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *SyncRequest) Reset()         { *m = SyncRequest{} }
+func (m *SyncRequest) String() string { return proto.CompactTextString(m) }
+func (*SyncRequest) ProtoMessage()    {}
+func (*SyncRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{0}
+}
+
+func (m *SyncRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncRequest.Unmarshal(m, b)
+}
+func (m *SyncRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncRequest.Marshal(b, m, deterministic)
+}
+func (m *SyncRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncRequest.Merge(m, src)
+}
+func (m *SyncRequest) XXX_Size() int {
+	return xxx_messageInfo_SyncRequest.Size(m)
+}
+func (m *SyncRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncRequest proto.InternalMessageInfo
+
+func (m *SyncRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *SyncRequest) GetKubernetesVersion() string {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return ""
+}
+
+func (m *SyncRequest) GetNodeCount() int32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+// DesiredNamespace is the final namespace state to be applied by the agent
+type DesiredNamespace struct {
+	//name of the managed namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//resources after processing the namespace template
+	Resources            *namespace.NamespaceResources `protobuf:"bytes,2,opt,name=resources,proto3" json:"resources,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                      `json:"-"`
+	XXX_unrecognized     []byte                        `json:"-"`
+	XXX_sizecache        int32                         `json:"-"`
+}
+
+func (m *DesiredNamespace) Reset()         { *m = DesiredNamespace{} }
+func (m *DesiredNamespace) String() string { return proto.CompactTextString(m) }
+func (*DesiredNamespace) ProtoMessage()    {}
+func (*DesiredNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{1}
+}
+
+func (m *DesiredNamespace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DesiredNamespace.Unmarshal(m, b)
+}
+func (m *DesiredNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DesiredNamespace.Marshal(b, m, deterministic)
+}
+func (m *DesiredNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DesiredNamespace.Merge(m, src)
+}
+func (m *DesiredNamespace) XXX_Size() int {
+	return xxx_messageInfo_DesiredNamespace.Size(m)
+}
+func (m *DesiredNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_DesiredNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DesiredNamespace proto.InternalMessageInfo
+
+func (m *DesiredNamespace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *DesiredNamespace) GetResources() *namespace.NamespaceResources {
+	if m != nil {
+		return m.Resources
+	}
+	return nil
+}
+
+// NamespaceDeletion is the namespace to be deleted by the agent
+type NamespaceDeletion struct {
+	//name of the managed namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//namespace to be deleted in the cluster
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceDeletion) Reset()         { *m = NamespaceDeletion{} }
+func (m *NamespaceDeletion) String() string { return proto.CompactTextString(m) }
+func (*NamespaceDeletion) ProtoMessage()    {}
+func (*NamespaceDeletion) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{2}
+}
+
+func (m *NamespaceDeletion) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceDeletion.Unmarshal(m, b)
+}
+func (m *NamespaceDeletion) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceDeletion.Marshal(b, m, deterministic)
+}
+func (m *NamespaceDeletion) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceDeletion.Merge(m, src)
+}
+func (m *NamespaceDeletion) XXX_Size() int {
+	return xxx_messageInfo_NamespaceDeletion.Size(m)
+}
+func (m *NamespaceDeletion) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceDeletion.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceDeletion proto.InternalMessageInfo
+
+func (m *NamespaceDeletion) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceDeletion) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+type SyncResponse struct {
+	Namespaces           []*DesiredNamespace  `protobuf:"bytes,1,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	Deletions            []*NamespaceDeletion `protobuf:"bytes,2,rep,name=deletions,proto3" json:"deletions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}             `json:"-"`
+	XXX_unrecognized     []byte               `json:"-"`
+	XXX_sizecache        int32                `json:"-"`
+}
+
+func (m *SyncResponse) Reset()         { *m = SyncResponse{} }
+func (m *SyncResponse) String() string { return proto.CompactTextString(m) }
+func (*SyncResponse) ProtoMessage()    {}
+func (*SyncResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{3}
+}
+
+func (m *SyncResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_SyncResponse.Unmarshal(m, b)
+}
+func (m *SyncResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_SyncResponse.Marshal(b, m, deterministic)
+}
+func (m *SyncResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_SyncResponse.Merge(m, src)
+}
+func (m *SyncResponse) XXX_Size() int {
+	return xxx_messageInfo_SyncResponse.Size(m)
+}
+func (m *SyncResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_SyncResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_SyncResponse proto.InternalMessageInfo
+
+func (m *SyncResponse) GetNamespaces() []*DesiredNamespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *SyncResponse) GetDeletions() []*NamespaceDeletion {
+	if m != nil {
+		return m.Deletions
+	}
+	return nil
+}
+
+// NamespaceStatus is the state of the managed namespace in the agent cluster
+type NamespaceStatus struct {
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//name of the managed namespace
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//namespace in the cluster
+	Namespace string `protobuf:"bytes,3,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	//state of the namespace. Ready or Error
+	State            string `protobuf:"bytes,4,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorDescription string `protobuf:"bytes,5,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	//deleted is set once the namespace is deleted in the cluster
+	Deleted              bool     `protobuf:"varint,6,opt,name=deleted,proto3" json:"deleted,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *NamespaceStatus) Reset()         { *m = NamespaceStatus{} }
+func (m *NamespaceStatus) String() string { return proto.CompactTextString(m) }
+func (*NamespaceStatus) ProtoMessage()    {}
+func (*NamespaceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{4}
+}
+
+func (m *NamespaceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_NamespaceStatus.Unmarshal(m, b)
+}
+func (m *NamespaceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_NamespaceStatus.Marshal(b, m, deterministic)
+}
+func (m *NamespaceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_NamespaceStatus.Merge(m, src)
+}
+func (m *NamespaceStatus) XXX_Size() int {
+	return xxx_messageInfo_NamespaceStatus.Size(m)
+}
+func (m *NamespaceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_NamespaceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_NamespaceStatus proto.InternalMessageInfo
+
+func (m *NamespaceStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *NamespaceStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *NamespaceStatus) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *NamespaceStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *NamespaceStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *NamespaceStatus) GetDeleted() bool {
+	if m != nil {
+		return m.Deleted
+	}
+	return false
+}
+
+type ReportStatusResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ReportStatusResponse) Reset()         { *m = ReportStatusResponse{} }
+func (m *ReportStatusResponse) String() string { return proto.CompactTextString(m) }
+func (*ReportStatusResponse) ProtoMessage()    {}
+func (*ReportStatusResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_a72c459954c1c928, []int{5}
+}
+
+func (m *ReportStatusResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ReportStatusResponse.Unmarshal(m, b)
+}
+func (m *ReportStatusResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ReportStatusResponse.Marshal(b, m, deterministic)
+}
+func (m *ReportStatusResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ReportStatusResponse.Merge(m, src)
+}
+func (m *ReportStatusResponse) XXX_Size() int {
+	return xxx_messageInfo_ReportStatusResponse.Size(m)
+}
+func (m *ReportStatusResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ReportStatusResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ReportStatusResponse proto.InternalMessageInfo
+
+func init() {
+	proto.RegisterType((*SyncRequest)(nil), "apis.SyncRequest")
+	proto.RegisterType((*DesiredNamespace)(nil), "apis.DesiredNamespace")
+	proto.RegisterType((*NamespaceDeletion)(nil), "apis.NamespaceDeletion")
+	proto.RegisterType((*SyncResponse)(nil), "apis.SyncResponse")
+	proto.RegisterType((*NamespaceStatus)(nil), "apis.NamespaceStatus")
+	proto.RegisterType((*ReportStatusResponse)(nil), "apis.ReportStatusResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/grpc/proto/apis/agent.proto", fileDescriptor_a72c459954c1c928)
+}
+
+var fileDescriptor_a72c459954c1c928 = []byte{
+	// 453 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x93, 0xcf, 0x6f, 0xd3, 0x30,
+	0x14, 0xc7, 0x97, 0xad, 0x1d, 0xf4, 0xb5, 0x12, 0xab, 0x35, 0x46, 0x54, 0x81, 0x88, 0x72, 0x21,
+	0x9a, 0xa6, 0x58, 0x2a, 0x82, 0x0b, 0x27, 0xa0, 0xbb, 0xee, 0xe0, 0x4a, 0x1c, 0xb8, 0xb9, 0xee,
+	0x53, 0x08, 0x6d, 0x6c, 0x63, 0x3b, 0x48, 0x08, 0x71, 0xe5, 0xff, 0xe2, 0x3f, 0x43, 0x8e, 0x97,
+	0x1f, 0x6a, 0x87, 0xc4, 0x2d, 0xfe, 0xfa, 0xf3, 0xfc, 0xbe, 0xdf, 0x17, 0x1b, 0x5e, 0xea, 0x5d,
+	0x41, 0x0b, 0xa3, 0x05, 0xd5, 0x46, 0x39, 0x45, 0xb9, 0x2e, 0x2d, 0xe5, 0x05, 0x4a, 0x97, 0x37,
+	0x02, 0x19, 0x79, 0x65, 0xf1, 0xea, 0x00, 0x93, 0xbc, 0x42, 0xab, 0xb9, 0x40, 0xea, 0xb0, 0xd2,
+	0x7b, 0xee, 0x30, 0xe0, 0xe9, 0x4f, 0x98, 0xae, 0x7f, 0x48, 0xc1, 0xf0, 0x5b, 0x8d, 0xd6, 0x91,
+	0x04, 0xa6, 0x62, 0x5f, 0x5b, 0x87, 0xe6, 0x8e, 0x57, 0x18, 0x47, 0x49, 0x94, 0x4d, 0xd8, 0x50,
+	0x22, 0x37, 0x30, 0xdf, 0xd5, 0x1b, 0x34, 0x12, 0x1d, 0xda, 0x4f, 0x68, 0x6c, 0xa9, 0x64, 0x7c,
+	0xda, 0x70, 0xc7, 0x1b, 0xe4, 0x39, 0x4c, 0xa4, 0xda, 0xe2, 0x47, 0x55, 0x4b, 0x17, 0x9f, 0x25,
+	0x51, 0x36, 0x66, 0xbd, 0x90, 0x0a, 0xb8, 0x58, 0xa1, 0x2d, 0x0d, 0x6e, 0xef, 0x5a, 0x7f, 0x84,
+	0xc0, 0x48, 0xf6, 0xad, 0x9b, 0x6f, 0xf2, 0x0e, 0x26, 0x06, 0xad, 0xaa, 0x8d, 0x40, 0xdb, 0xf4,
+	0x9a, 0x2e, 0x5f, 0xe4, 0x5d, 0xa4, 0xbc, 0x2b, 0x66, 0x2d, 0xc4, 0x7a, 0x3e, 0xbd, 0x85, 0x79,
+	0x07, 0xac, 0x70, 0x8f, 0xce, 0xfb, 0x7a, 0xa8, 0x8b, 0xf7, 0xda, 0x82, 0xf7, 0x89, 0x7a, 0x21,
+	0xfd, 0x05, 0xb3, 0x30, 0x28, 0xab, 0x95, 0xb4, 0x48, 0xde, 0x02, 0x74, 0x9b, 0x36, 0x8e, 0x92,
+	0xb3, 0x6c, 0xba, 0xbc, 0xca, 0xfd, 0xf0, 0xf3, 0xc3, 0x4c, 0x6c, 0x40, 0x92, 0x37, 0x30, 0xd9,
+	0xde, 0xbb, 0xf0, 0x59, 0x7c, 0xd9, 0xb3, 0x50, 0x76, 0xe4, 0x92, 0xf5, 0x64, 0xfa, 0x27, 0x82,
+	0x27, 0x1d, 0xb0, 0x76, 0xdc, 0xd5, 0xf6, 0x3f, 0x7e, 0x56, 0x1b, 0xf3, 0xf4, 0x5f, 0x31, 0xcf,
+	0x0e, 0x62, 0x92, 0x4b, 0x18, 0x5b, 0xc7, 0x1d, 0xc6, 0xa3, 0x66, 0x27, 0x2c, 0xc8, 0x35, 0x5c,
+	0xa0, 0x31, 0xca, 0xac, 0xd0, 0x0a, 0x53, 0x6a, 0x6f, 0x29, 0x1e, 0x37, 0xc0, 0x91, 0x4e, 0x62,
+	0x78, 0xd4, 0xd8, 0xc6, 0x6d, 0x7c, 0x9e, 0x44, 0xd9, 0x63, 0xd6, 0x2e, 0xd3, 0x2b, 0xb8, 0x64,
+	0xa8, 0x95, 0x71, 0xc1, 0x7f, 0x3b, 0xca, 0xe5, 0xef, 0x08, 0x66, 0xef, 0xfd, 0x15, 0x5e, 0xa3,
+	0xf9, 0x5e, 0x0a, 0x24, 0x14, 0x46, 0x7e, 0xd6, 0x64, 0x1e, 0x06, 0x33, 0xb8, 0xa0, 0x0b, 0x32,
+	0x94, 0x42, 0x7d, 0x7a, 0x42, 0x6e, 0x61, 0x36, 0x3c, 0x99, 0x3c, 0x3d, 0x98, 0x68, 0x90, 0x17,
+	0x8b, 0x20, 0x3f, 0x64, 0x22, 0x3d, 0xc9, 0xa2, 0x0f, 0x37, 0x9f, 0xaf, 0x8b, 0xd2, 0x7d, 0xa9,
+	0x37, 0xb9, 0x50, 0x15, 0xdd, 0x61, 0xb9, 0x53, 0xda, 0xa8, 0xaf, 0xb4, 0xe2, 0x92, 0x17, 0x68,
+	0x68, 0xf7, 0xa8, 0xfc, 0x31, 0x9b, 0xf3, 0xe6, 0x05, 0xbd, 0xfe, 0x3b, 0x00, 0x7c, 0x44, 0x93,
+	0x0c, 0x93, 0x03, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// AgentServiceClient is the client API for AgentService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type AgentServiceClient interface {
+	Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error)
+	ReportStatus(ctx context.Context, opts ...grpc.CallOption) (AgentService_ReportStatusClient, error)
+}
+
+type agentServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewAgentServiceClient(cc grpc.ClientConnInterface) AgentServiceClient {
+	return &agentServiceClient{cc}
+}
+
+func (c *agentServiceClient) Sync(ctx context.Context, in *SyncRequest, opts ...grpc.CallOption) (*SyncResponse, error) {
+	out := new(SyncResponse)
+	err := c.cc.Invoke(ctx, "/apis.AgentService/Sync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *agentServiceClient) ReportStatus(ctx context.Context, opts ...grpc.CallOption) (AgentService_ReportStatusClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_AgentService_serviceDesc.Streams[0], "/apis.AgentService/ReportStatus", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &agentServiceReportStatusClient{stream}
+	return x, nil
+}
+
+type AgentService_ReportStatusClient interface {
+	Send(*NamespaceStatus) error
+	CloseAndRecv() (*ReportStatusResponse, error)
+	grpc.ClientStream
+}
+
+type agentServiceReportStatusClient struct {
+	grpc.ClientStream
+}
+
+func (x *agentServiceReportStatusClient) Send(m *NamespaceStatus) error {
+	return x.ClientStream.SendMsg(m)
+}
+
+func (x *agentServiceReportStatusClient) CloseAndRecv() (*ReportStatusResponse, error) {
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	m := new(ReportStatusResponse)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// AgentServiceServer is the server API for AgentService service.
+type AgentServiceServer interface {
+	Sync(context.Context, *SyncRequest) (*SyncResponse, error)
+	ReportStatus(AgentService_ReportStatusServer) error
+}
+
+// UnimplementedAgentServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedAgentServiceServer struct {
+}
+
+func (*UnimplementedAgentServiceServer) Sync(ctx context.Context, req *SyncRequest) (*SyncResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Sync not implemented")
+}
+func (*UnimplementedAgentServiceServer) ReportStatus(srv AgentService_ReportStatusServer) error {
+	return status.Errorf(codes.Unimplemented, "method ReportStatus not implemented")
+}
+
+func RegisterAgentServiceServer(s *grpc.Server, srv AgentServiceServer) {
+	s.RegisterService(&_AgentService_serviceDesc, srv)
+}
+
+func _AgentService_Sync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(SyncRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(AgentServiceServer).Sync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.AgentService/Sync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(AgentServiceServer).Sync(ctx, req.(*SyncRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _AgentService_ReportStatus_Handler(srv interface{}, stream grpc.ServerStream) error {
+	return srv.(AgentServiceServer).ReportStatus(&agentServiceReportStatusServer{stream})
+}
+
+type AgentService_ReportStatusServer interface {
+	SendAndClose(*ReportStatusResponse) error
+	Recv() (*NamespaceStatus, error)
+	grpc.ServerStream
+}
+
+type agentServiceReportStatusServer struct {
+	grpc.ServerStream
+}
+
+func (x *agentServiceReportStatusServer) SendAndClose(m *ReportStatusResponse) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+func (x *agentServiceReportStatusServer) Recv() (*NamespaceStatus, error) {
+	m := new(NamespaceStatus)
+	if err := x.ServerStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+var _AgentService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apis.AgentService",
+	HandlerType: (*AgentServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Sync",
+			Handler:    _AgentService_Sync_Handler,
+		},
+	},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "ReportStatus",
+			Handler:       _AgentService_ReportStatus_Handler,
+			ClientStreams: true,
+		},
+	},
+	Metadata: "pkg/grpc/proto/apis/agent.proto",
+}
@@ -0,0 +1,933 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pkg/grpc/proto/apis/application_service.proto
+
+package apis
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	application "github.com/keikoproj/manager/pkg/grpc/proto/application"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Application represents the Application custom resource
+type Application struct {
+	//name of the application resource. Defaults to the appName
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//labels of the application
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//spec is the desired state of the application
+	Spec *application.Application `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	//status is the observed state of the application. Ignored in create/update requests
+	Status *ApplicationStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	//resourceVersion must be provided to update only if the application is not modified in the meantime
+	ResourceVersion string `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	//creationTimestamp in RFC3339 format
+	CreationTimestamp    string   `protobuf:"bytes,6,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Application) Reset()         { *m = Application{} }
+func (m *Application) String() string { return proto.CompactTextString(m) }
+func (*Application) ProtoMessage()    {}
+func (*Application) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{0}
+}
+
+func (m *Application) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Application.Unmarshal(m, b)
+}
+func (m *Application) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Application.Marshal(b, m, deterministic)
+}
+func (m *Application) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Application.Merge(m, src)
+}
+func (m *Application) XXX_Size() int {
+	return xxx_messageInfo_Application.Size(m)
+}
+func (m *Application) XXX_DiscardUnknown() {
+	xxx_messageInfo_Application.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Application proto.InternalMessageInfo
+
+func (m *Application) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Application) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Application) GetSpec() *application.Application {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Application) GetStatus() *ApplicationStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *Application) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *Application) GetCreationTimestamp() string {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return ""
+}
+
+type ApplicationStatus struct {
+	State            string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	RetryCount       int32  `protobuf:"varint,2,opt,name=retryCount,proto3" json:"retryCount,omitempty"`
+	ErrorDescription string `protobuf:"bytes,3,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	//environments contains the state of the managed namespace of each environment
+	Environments []*EnvironmentStatus `protobuf:"bytes,4,rep,name=environments,proto3" json:"environments,omitempty"`
+	//readyEnvironments is the number of environments in Ready state
+	ReadyEnvironments    int32    `protobuf:"varint,5,opt,name=readyEnvironments,proto3" json:"readyEnvironments,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ApplicationStatus) Reset()         { *m = ApplicationStatus{} }
+func (m *ApplicationStatus) String() string { return proto.CompactTextString(m) }
+func (*ApplicationStatus) ProtoMessage()    {}
+func (*ApplicationStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{1}
+}
+
+func (m *ApplicationStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ApplicationStatus.Unmarshal(m, b)
+}
+func (m *ApplicationStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ApplicationStatus.Marshal(b, m, deterministic)
+}
+func (m *ApplicationStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ApplicationStatus.Merge(m, src)
+}
+func (m *ApplicationStatus) XXX_Size() int {
+	return xxx_messageInfo_ApplicationStatus.Size(m)
+}
+func (m *ApplicationStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ApplicationStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ApplicationStatus proto.InternalMessageInfo
+
+func (m *ApplicationStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ApplicationStatus) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *ApplicationStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *ApplicationStatus) GetEnvironments() []*EnvironmentStatus {
+	if m != nil {
+		return m.Environments
+	}
+	return nil
+}
+
+func (m *ApplicationStatus) GetReadyEnvironments() int32 {
+	if m != nil {
+		return m.ReadyEnvironments
+	}
+	return 0
+}
+
+type EnvironmentStatus struct {
+	//name of the environment
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//namespaceName is the name of the managed namespace created for the environment
+	NamespaceName    string `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
+	State            string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorDescription string `protobuf:"bytes,4,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	//clusters contains the state of the namespace in each of the target clusters
+	Clusters             []*ClusterNamespaceStatus `protobuf:"bytes,5,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *EnvironmentStatus) Reset()         { *m = EnvironmentStatus{} }
+func (m *EnvironmentStatus) String() string { return proto.CompactTextString(m) }
+func (*EnvironmentStatus) ProtoMessage()    {}
+func (*EnvironmentStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{2}
+}
+
+func (m *EnvironmentStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_EnvironmentStatus.Unmarshal(m, b)
+}
+func (m *EnvironmentStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_EnvironmentStatus.Marshal(b, m, deterministic)
+}
+func (m *EnvironmentStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_EnvironmentStatus.Merge(m, src)
+}
+func (m *EnvironmentStatus) XXX_Size() int {
+	return xxx_messageInfo_EnvironmentStatus.Size(m)
+}
+func (m *EnvironmentStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_EnvironmentStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_EnvironmentStatus proto.InternalMessageInfo
+
+func (m *EnvironmentStatus) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *EnvironmentStatus) GetNamespaceName() string {
+	if m != nil {
+		return m.NamespaceName
+	}
+	return ""
+}
+
+func (m *EnvironmentStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *EnvironmentStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *EnvironmentStatus) GetClusters() []*ClusterNamespaceStatus {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+type GetApplicationRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetApplicationRequest) Reset()         { *m = GetApplicationRequest{} }
+func (m *GetApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*GetApplicationRequest) ProtoMessage()    {}
+func (*GetApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{3}
+}
+
+func (m *GetApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetApplicationRequest.Unmarshal(m, b)
+}
+func (m *GetApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *GetApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetApplicationRequest.Merge(m, src)
+}
+func (m *GetApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_GetApplicationRequest.Size(m)
+}
+func (m *GetApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetApplicationRequest proto.InternalMessageInfo
+
+func (m *GetApplicationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListApplicationsRequest struct {
+	//pageSize is the maximum number of applications to be returned. Default is 100
+	PageSize int32 `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	//pageToken is the nextPageToken returned by the previous request
+	PageToken            string   `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationsRequest) Reset()         { *m = ListApplicationsRequest{} }
+func (m *ListApplicationsRequest) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationsRequest) ProtoMessage()    {}
+func (*ListApplicationsRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{4}
+}
+
+func (m *ListApplicationsRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationsRequest.Unmarshal(m, b)
+}
+func (m *ListApplicationsRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationsRequest.Marshal(b, m, deterministic)
+}
+func (m *ListApplicationsRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationsRequest.Merge(m, src)
+}
+func (m *ListApplicationsRequest) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationsRequest.Size(m)
+}
+func (m *ListApplicationsRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationsRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationsRequest proto.InternalMessageInfo
+
+func (m *ListApplicationsRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListApplicationsRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListApplicationsResponse struct {
+	Items []*Application `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	//nextPageToken is empty if there are no more applications
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListApplicationsResponse) Reset()         { *m = ListApplicationsResponse{} }
+func (m *ListApplicationsResponse) String() string { return proto.CompactTextString(m) }
+func (*ListApplicationsResponse) ProtoMessage()    {}
+func (*ListApplicationsResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{5}
+}
+
+func (m *ListApplicationsResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListApplicationsResponse.Unmarshal(m, b)
+}
+func (m *ListApplicationsResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListApplicationsResponse.Marshal(b, m, deterministic)
+}
+func (m *ListApplicationsResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListApplicationsResponse.Merge(m, src)
+}
+func (m *ListApplicationsResponse) XXX_Size() int {
+	return xxx_messageInfo_ListApplicationsResponse.Size(m)
+}
+func (m *ListApplicationsResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListApplicationsResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListApplicationsResponse proto.InternalMessageInfo
+
+func (m *ListApplicationsResponse) GetItems() []*Application {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListApplicationsResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DeleteApplicationRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteApplicationRequest) Reset()         { *m = DeleteApplicationRequest{} }
+func (m *DeleteApplicationRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteApplicationRequest) ProtoMessage()    {}
+func (*DeleteApplicationRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{6}
+}
+
+func (m *DeleteApplicationRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteApplicationRequest.Unmarshal(m, b)
+}
+func (m *DeleteApplicationRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteApplicationRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteApplicationRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteApplicationRequest.Merge(m, src)
+}
+func (m *DeleteApplicationRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteApplicationRequest.Size(m)
+}
+func (m *DeleteApplicationRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteApplicationRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteApplicationRequest proto.InternalMessageInfo
+
+func (m *DeleteApplicationRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteApplicationResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteApplicationResponse) Reset()         { *m = DeleteApplicationResponse{} }
+func (m *DeleteApplicationResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteApplicationResponse) ProtoMessage()    {}
+func (*DeleteApplicationResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{7}
+}
+
+func (m *DeleteApplicationResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteApplicationResponse.Unmarshal(m, b)
+}
+func (m *DeleteApplicationResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteApplicationResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteApplicationResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteApplicationResponse.Merge(m, src)
+}
+func (m *DeleteApplicationResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteApplicationResponse.Size(m)
+}
+func (m *DeleteApplicationResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteApplicationResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteApplicationResponse proto.InternalMessageInfo
+
+type AddEnvironmentRequest struct {
+	//name of the application
+	Name                 string                   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Environment          *application.Environment `protobuf:"bytes,2,opt,name=environment,proto3" json:"environment,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                 `json:"-"`
+	XXX_unrecognized     []byte                   `json:"-"`
+	XXX_sizecache        int32                    `json:"-"`
+}
+
+func (m *AddEnvironmentRequest) Reset()         { *m = AddEnvironmentRequest{} }
+func (m *AddEnvironmentRequest) String() string { return proto.CompactTextString(m) }
+func (*AddEnvironmentRequest) ProtoMessage()    {}
+func (*AddEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{8}
+}
+
+func (m *AddEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_AddEnvironmentRequest.Unmarshal(m, b)
+}
+func (m *AddEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_AddEnvironmentRequest.Marshal(b, m, deterministic)
+}
+func (m *AddEnvironmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_AddEnvironmentRequest.Merge(m, src)
+}
+func (m *AddEnvironmentRequest) XXX_Size() int {
+	return xxx_messageInfo_AddEnvironmentRequest.Size(m)
+}
+func (m *AddEnvironmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_AddEnvironmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_AddEnvironmentRequest proto.InternalMessageInfo
+
+func (m *AddEnvironmentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *AddEnvironmentRequest) GetEnvironment() *application.Environment {
+	if m != nil {
+		return m.Environment
+	}
+	return nil
+}
+
+type RemoveEnvironmentRequest struct {
+	//name of the application
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//environmentName to be removed
+	EnvironmentName      string   `protobuf:"bytes,2,opt,name=environmentName,proto3" json:"environmentName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *RemoveEnvironmentRequest) Reset()         { *m = RemoveEnvironmentRequest{} }
+func (m *RemoveEnvironmentRequest) String() string { return proto.CompactTextString(m) }
+func (*RemoveEnvironmentRequest) ProtoMessage()    {}
+func (*RemoveEnvironmentRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_f435d1d9fa9fcf92, []int{9}
+}
+
+func (m *RemoveEnvironmentRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_RemoveEnvironmentRequest.Unmarshal(m, b)
+}
+func (m *RemoveEnvironmentRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_RemoveEnvironmentRequest.Marshal(b, m, deterministic)
+}
+func (m *RemoveEnvironmentRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_RemoveEnvironmentRequest.Merge(m, src)
+}
+func (m *RemoveEnvironmentRequest) XXX_Size() int {
+	return xxx_messageInfo_RemoveEnvironmentRequest.Size(m)
+}
+func (m *RemoveEnvironmentRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_RemoveEnvironmentRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_RemoveEnvironmentRequest proto.InternalMessageInfo
+
+func (m *RemoveEnvironmentRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *RemoveEnvironmentRequest) GetEnvironmentName() string {
+	if m != nil {
+		return m.EnvironmentName
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*Application)(nil), "apis.Application")
+	proto.RegisterMapType((map[string]string)(nil), "apis.Application.LabelsEntry")
+	proto.RegisterType((*ApplicationStatus)(nil), "apis.ApplicationStatus")
+	proto.RegisterType((*EnvironmentStatus)(nil), "apis.EnvironmentStatus")
+	proto.RegisterType((*GetApplicationRequest)(nil), "apis.GetApplicationRequest")
+	proto.RegisterType((*ListApplicationsRequest)(nil), "apis.ListApplicationsRequest")
+	proto.RegisterType((*ListApplicationsResponse)(nil), "apis.ListApplicationsResponse")
+	proto.RegisterType((*DeleteApplicationRequest)(nil), "apis.DeleteApplicationRequest")
+	proto.RegisterType((*DeleteApplicationResponse)(nil), "apis.DeleteApplicationResponse")
+	proto.RegisterType((*AddEnvironmentRequest)(nil), "apis.AddEnvironmentRequest")
+	proto.RegisterType((*RemoveEnvironmentRequest)(nil), "apis.RemoveEnvironmentRequest")
+}
+
+func init() {
+	proto.RegisterFile("pkg/grpc/proto/apis/application_service.proto", fileDescriptor_f435d1d9fa9fcf92)
+}
+
+var fileDescriptor_f435d1d9fa9fcf92 = []byte{
+	// 819 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x56, 0xdd, 0x6e, 0xe3, 0x44,
+	0x14, 0x96, 0xf3, 0xa7, 0xed, 0x09, 0xec, 0xc6, 0x23, 0xaa, 0x7a, 0xdd, 0xb0, 0x5b, 0x59, 0x2b,
+	0x11, 0x65, 0x43, 0x2c, 0xba, 0x02, 0x75, 0x83, 0x84, 0x54, 0xda, 0x8a, 0x9b, 0xaa, 0x42, 0x6e,
+	0xa9, 0x10, 0x12, 0x42, 0xae, 0x73, 0x64, 0x4c, 0x62, 0x8f, 0x99, 0x19, 0x47, 0x84, 0xaa, 0x37,
+	0x5c, 0xf0, 0x02, 0xdc, 0xf0, 0x4a, 0x70, 0xcb, 0x0b, 0x70, 0xc1, 0x83, 0xa0, 0x19, 0x3b, 0xc9,
+	0x24, 0xb6, 0x51, 0xc5, 0xdd, 0xf8, 0xfc, 0x7c, 0xe7, 0x7c, 0x5f, 0xbe, 0x19, 0x05, 0x3e, 0x4c,
+	0x67, 0xa1, 0x1b, 0xb2, 0x34, 0x70, 0x53, 0x46, 0x05, 0x75, 0xfd, 0x34, 0xe2, 0xae, 0x9f, 0xa6,
+	0xf3, 0x28, 0xf0, 0x45, 0x44, 0x93, 0xef, 0x38, 0xb2, 0x45, 0x14, 0xe0, 0x58, 0xa5, 0x49, 0x4b,
+	0xe6, 0xed, 0xd7, 0x55, 0x4d, 0x89, 0x1f, 0x23, 0x4f, 0xfd, 0x00, 0xb7, 0x5b, 0xec, 0x51, 0xa9,
+	0x78, 0x0d, 0xae, 0x9f, 0x8b, 0xea, 0x7e, 0x48, 0x69, 0x38, 0x47, 0x09, 0xe9, 0xfa, 0x49, 0x42,
+	0x85, 0x4a, 0xf2, 0x3c, 0xeb, 0xfc, 0xd9, 0x80, 0xee, 0xe9, 0xa6, 0x87, 0x10, 0x68, 0xc9, 0xb1,
+	0x96, 0x71, 0x64, 0x0c, 0xf6, 0x3c, 0x75, 0x26, 0x1f, 0x43, 0x67, 0xee, 0xdf, 0xe1, 0x9c, 0x5b,
+	0x8d, 0xa3, 0xe6, 0xa0, 0x7b, 0xfc, 0xfe, 0x58, 0xae, 0x37, 0xd6, 0xda, 0xc6, 0x97, 0x2a, 0x7f,
+	0x91, 0x08, 0xb6, 0xf4, 0x8a, 0x62, 0x32, 0x82, 0x16, 0x4f, 0x31, 0xb0, 0x9a, 0x47, 0xc6, 0xa0,
+	0x7b, 0x6c, 0x8d, 0xf5, 0xd5, 0xb4, 0x5e, 0x4f, 0x55, 0x11, 0x17, 0x3a, 0x5c, 0xf8, 0x22, 0xe3,
+	0x56, 0x4b, 0xd5, 0x1f, 0x94, 0x86, 0x5c, 0xab, 0xb4, 0x57, 0x94, 0x91, 0x01, 0x3c, 0x63, 0xc8,
+	0x69, 0xc6, 0x02, 0xbc, 0x45, 0xc6, 0x23, 0x9a, 0x58, 0x6d, 0xb5, 0xf4, 0x6e, 0x98, 0x8c, 0xc0,
+	0x0c, 0x18, 0x2a, 0x8c, 0x9b, 0x28, 0x46, 0x2e, 0xfc, 0x38, 0xb5, 0x3a, 0xaa, 0xb6, 0x9c, 0xb0,
+	0xdf, 0x42, 0x57, 0x63, 0x43, 0x7a, 0xd0, 0x9c, 0xe1, 0xb2, 0xd0, 0x43, 0x1e, 0xc9, 0x7b, 0xd0,
+	0x5e, 0xf8, 0xf3, 0x0c, 0xad, 0x86, 0x8a, 0xe5, 0x1f, 0x93, 0xc6, 0x89, 0xe1, 0xfc, 0x6d, 0x80,
+	0x59, 0x5a, 0x58, 0xd6, 0xcb, 0x95, 0x57, 0x9a, 0xe6, 0x1f, 0xe4, 0x05, 0x00, 0x43, 0xc1, 0x96,
+	0x67, 0x34, 0x4b, 0x84, 0x82, 0x6a, 0x7b, 0x5a, 0x84, 0x0c, 0xa1, 0x87, 0x8c, 0x51, 0x76, 0x8e,
+	0x3c, 0x60, 0x51, 0x2a, 0xf1, 0x94, 0x92, 0x7b, 0x5e, 0x29, 0x4e, 0x3e, 0x85, 0x77, 0x30, 0x59,
+	0x44, 0x8c, 0x26, 0x31, 0x26, 0x42, 0x2a, 0xd8, 0xdc, 0x28, 0x78, 0xb1, 0xc9, 0x14, 0x0a, 0x6e,
+	0x15, 0x4b, 0x75, 0x18, 0xfa, 0xd3, 0xe5, 0x85, 0x8e, 0xd0, 0x56, 0xfb, 0x94, 0x13, 0xce, 0x1f,
+	0x06, 0x98, 0x25, 0xc4, 0x4a, 0xd7, 0xbc, 0x82, 0x77, 0xd7, 0x06, 0xbe, 0x92, 0xc9, 0x5c, 0xae,
+	0xed, 0xe0, 0x46, 0x9c, 0xa6, 0x2e, 0x4e, 0x15, 0xf9, 0x56, 0x0d, 0xf9, 0x13, 0x78, 0x12, 0xcc,
+	0x33, 0x2e, 0x90, 0xc9, 0xb5, 0x25, 0xf1, 0x7e, 0x4e, 0xfc, 0x2c, 0x8f, 0x5e, 0xad, 0xe6, 0x15,
+	0xec, 0xd7, 0xd5, 0xce, 0x6b, 0xd8, 0xff, 0x02, 0x85, 0x6e, 0x45, 0xfc, 0x31, 0x43, 0x2e, 0xaa,
+	0xe8, 0x38, 0xd7, 0x70, 0x70, 0x19, 0x71, 0xbd, 0x9a, 0xaf, 0xca, 0x6d, 0x78, 0x92, 0xfa, 0x21,
+	0x5e, 0x47, 0x3f, 0xe7, 0x2d, 0x6d, 0x6f, 0xfd, 0x4d, 0xfa, 0xb0, 0x27, 0xcf, 0x37, 0x74, 0x86,
+	0x49, 0xa1, 0xc0, 0x26, 0xe0, 0x44, 0x60, 0x95, 0x41, 0x79, 0x4a, 0x13, 0x8e, 0xe4, 0x03, 0x68,
+	0x47, 0x02, 0x63, 0x6e, 0x19, 0x8a, 0x94, 0x59, 0xba, 0x0f, 0x5e, 0x9e, 0x57, 0x42, 0xe3, 0x4f,
+	0xe2, 0xcb, 0x9d, 0x31, 0xdb, 0x41, 0x67, 0x0c, 0xd6, 0x39, 0xce, 0x51, 0xe0, 0x23, 0xf9, 0x1e,
+	0xc2, 0xf3, 0x8a, 0xfa, 0x7c, 0x37, 0x27, 0x84, 0xfd, 0xd3, 0xe9, 0x54, 0xf3, 0xc1, 0x7f, 0x20,
+	0x91, 0x09, 0x74, 0x35, 0xc3, 0x59, 0x8d, 0x8a, 0xe7, 0x40, 0x47, 0xd2, 0x8b, 0x9d, 0xaf, 0xc1,
+	0xf2, 0x30, 0xa6, 0x0b, 0x7c, 0xe4, 0xac, 0x01, 0x3c, 0xd3, 0xda, 0x35, 0xdb, 0xed, 0x86, 0x8f,
+	0x7f, 0xef, 0x00, 0xd1, 0xef, 0x6a, 0xfe, 0xc2, 0x92, 0x5b, 0x30, 0xcf, 0xe4, 0x93, 0xa0, 0xd3,
+	0x26, 0x65, 0xed, 0xed, 0x72, 0xc8, 0x39, 0xfc, 0xe5, 0xaf, 0x7f, 0x7e, 0x6b, 0xec, 0x3b, 0x3d,
+	0x77, 0xf1, 0x91, 0xfe, 0x0e, 0xf3, 0x89, 0x31, 0x24, 0x3e, 0x3c, 0xdd, 0xf6, 0x1a, 0x39, 0xcc,
+	0x11, 0x2a, 0x1d, 0x58, 0x05, 0xff, 0x52, 0xc1, 0x3f, 0x27, 0x07, 0xbb, 0xf0, 0xee, 0xbd, 0xa4,
+	0xfe, 0x40, 0x66, 0xd0, 0xdb, 0x35, 0x13, 0x29, 0x9e, 0xea, 0x1a, 0xe7, 0xda, 0x2f, 0xea, 0xd2,
+	0xc5, 0xef, 0x6c, 0xa9, 0x99, 0x84, 0x94, 0x28, 0x91, 0x6f, 0xc1, 0xfc, 0x2a, 0x9d, 0xfe, 0x2f,
+	0x9d, 0x1c, 0x05, 0xda, 0xb7, 0xeb, 0x88, 0x48, 0xb9, 0x32, 0x30, 0x4b, 0xee, 0x23, 0xc5, 0xb6,
+	0x75, 0x36, 0xb6, 0x5f, 0xd6, 0xe6, 0x0b, 0x3a, 0x85, 0x84, 0xc3, 0x5a, 0x09, 0xef, 0xe1, 0xe9,
+	0xb6, 0xaf, 0x57, 0xbf, 0x52, 0xa5, 0xdb, 0xab, 0xc8, 0xbd, 0x55, 0x23, 0xde, 0x38, 0xaf, 0x6a,
+	0x46, 0xb8, 0xfa, 0xdb, 0x3b, 0xd1, 0xbd, 0x4e, 0x7e, 0x35, 0xc0, 0x2c, 0x99, 0x7d, 0x45, 0xba,
+	0xee, 0x16, 0x54, 0xed, 0xf0, 0x99, 0xda, 0xe1, 0x64, 0xf8, 0xc9, 0x63, 0x76, 0x70, 0xef, 0x77,
+	0x6e, 0xc6, 0xc3, 0xe7, 0xa3, 0x6f, 0x86, 0x61, 0x24, 0xbe, 0xcf, 0xee, 0xc6, 0x01, 0x8d, 0xdd,
+	0x19, 0x46, 0x33, 0x9a, 0x32, 0xfa, 0x83, 0x1b, 0xfb, 0x89, 0x1f, 0x22, 0x73, 0xd7, 0x7f, 0x3f,
+	0xe4, 0xe4, 0xbb, 0x8e, 0xfa, 0x23, 0xf1, 0xe6, 0xdf, 0x01, 0x00, 0x15, 0x8e, 0xce, 0x59, 0xf8,
+	0x08, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// ApplicationServiceClient is the client API for ApplicationService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type ApplicationServiceClient interface {
+	CreateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*Application, error)
+	GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error)
+	ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error)
+	UpdateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*Application, error)
+	DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error)
+	AddEnvironment(ctx context.Context, in *AddEnvironmentRequest, opts ...grpc.CallOption) (*Application, error)
+	RemoveEnvironment(ctx context.Context, in *RemoveEnvironmentRequest, opts ...grpc.CallOption) (*Application, error)
+}
+
+type applicationServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewApplicationServiceClient(cc grpc.ClientConnInterface) ApplicationServiceClient {
+	return &applicationServiceClient{cc}
+}
+
+func (c *applicationServiceClient) CreateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/CreateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) GetApplication(ctx context.Context, in *GetApplicationRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/GetApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) ListApplications(ctx context.Context, in *ListApplicationsRequest, opts ...grpc.CallOption) (*ListApplicationsResponse, error) {
+	out := new(ListApplicationsResponse)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/ListApplications", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) UpdateApplication(ctx context.Context, in *Application, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/UpdateApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) DeleteApplication(ctx context.Context, in *DeleteApplicationRequest, opts ...grpc.CallOption) (*DeleteApplicationResponse, error) {
+	out := new(DeleteApplicationResponse)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/DeleteApplication", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) AddEnvironment(ctx context.Context, in *AddEnvironmentRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/AddEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *applicationServiceClient) RemoveEnvironment(ctx context.Context, in *RemoveEnvironmentRequest, opts ...grpc.CallOption) (*Application, error) {
+	out := new(Application)
+	err := c.cc.Invoke(ctx, "/apis.ApplicationService/RemoveEnvironment", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// ApplicationServiceServer is the server API for ApplicationService service.
+type ApplicationServiceServer interface {
+	CreateApplication(context.Context, *Application) (*Application, error)
+	GetApplication(context.Context, *GetApplicationRequest) (*Application, error)
+	ListApplications(context.Context, *ListApplicationsRequest) (*ListApplicationsResponse, error)
+	UpdateApplication(context.Context, *Application) (*Application, error)
+	DeleteApplication(context.Context, *DeleteApplicationRequest) (*DeleteApplicationResponse, error)
+	AddEnvironment(context.Context, *AddEnvironmentRequest) (*Application, error)
+	RemoveEnvironment(context.Context, *RemoveEnvironmentRequest) (*Application, error)
+}
+
+// UnimplementedApplicationServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedApplicationServiceServer struct {
+}
+
+func (*UnimplementedApplicationServiceServer) CreateApplication(ctx context.Context, req *Application) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method CreateApplication not implemented")
+}
+func (*UnimplementedApplicationServiceServer) GetApplication(ctx context.Context, req *GetApplicationRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetApplication not implemented")
+}
+func (*UnimplementedApplicationServiceServer) ListApplications(ctx context.Context, req *ListApplicationsRequest) (*ListApplicationsResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListApplications not implemented")
+}
+func (*UnimplementedApplicationServiceServer) UpdateApplication(ctx context.Context, req *Application) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method UpdateApplication not implemented")
+}
+func (*UnimplementedApplicationServiceServer) DeleteApplication(ctx context.Context, req *DeleteApplicationRequest) (*DeleteApplicationResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DeleteApplication not implemented")
+}
+func (*UnimplementedApplicationServiceServer) AddEnvironment(ctx context.Context, req *AddEnvironmentRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method AddEnvironment not implemented")
+}
+func (*UnimplementedApplicationServiceServer) RemoveEnvironment(ctx context.Context, req *RemoveEnvironmentRequest) (*Application, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method RemoveEnvironment not implemented")
+}
+
+func RegisterApplicationServiceServer(s *grpc.Server, srv ApplicationServiceServer) {
+	s.RegisterService(&_ApplicationService_serviceDesc, srv)
+}
+
+func _ApplicationService_CreateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Application)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).CreateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/CreateApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).CreateApplication(ctx, req.(*Application))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_GetApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).GetApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/GetApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).GetApplication(ctx, req.(*GetApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_ListApplications_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListApplicationsRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).ListApplications(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/ListApplications",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).ListApplications(ctx, req.(*ListApplicationsRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_UpdateApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Application)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).UpdateApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/UpdateApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).UpdateApplication(ctx, req.(*Application))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_DeleteApplication_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteApplicationRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).DeleteApplication(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/DeleteApplication",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).DeleteApplication(ctx, req.(*DeleteApplicationRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_AddEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(AddEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).AddEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/AddEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).AddEnvironment(ctx, req.(*AddEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ApplicationService_RemoveEnvironment_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(RemoveEnvironmentRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ApplicationServiceServer).RemoveEnvironment(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ApplicationService/RemoveEnvironment",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ApplicationServiceServer).RemoveEnvironment(ctx, req.(*RemoveEnvironmentRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _ApplicationService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apis.ApplicationService",
+	HandlerType: (*ApplicationServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "CreateApplication",
+			Handler:    _ApplicationService_CreateApplication_Handler,
+		},
+		{
+			MethodName: "GetApplication",
+			Handler:    _ApplicationService_GetApplication_Handler,
+		},
+		{
+			MethodName: "ListApplications",
+			Handler:    _ApplicationService_ListApplications_Handler,
+		},
+		{
+			MethodName: "UpdateApplication",
+			Handler:    _ApplicationService_UpdateApplication_Handler,
+		},
+		{
+			MethodName: "DeleteApplication",
+			Handler:    _ApplicationService_DeleteApplication_Handler,
+		},
+		{
+			MethodName: "AddEnvironment",
+			Handler:    _ApplicationService_AddEnvironment_Handler,
+		},
+		{
+			MethodName: "RemoveEnvironment",
+			Handler:    _ApplicationService_RemoveEnvironment_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/grpc/proto/apis/application_service.proto",
+}
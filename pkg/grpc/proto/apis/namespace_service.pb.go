@@ -0,0 +1,861 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pkg/grpc/proto/apis/namespace_service.proto
+
+package apis
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	namespace "github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// ManagedNamespace represents the ManagedNamespace custom resource in the manager namespace
+type ManagedNamespace struct {
+	//name of the managed namespace
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//labels of the managed namespace
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//spec is the desired state of the managed namespace
+	Spec *namespace.Namespace `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	//status is the observed state of the managed namespace. Ignored in create/update requests
+	Status *ManagedNamespaceStatus `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	//resourceVersion must be provided to update only if the managed namespace is not modified in the meantime
+	ResourceVersion string `protobuf:"bytes,5,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	//creationTimestamp in RFC3339 format
+	CreationTimestamp    string   `protobuf:"bytes,6,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ManagedNamespace) Reset()         { *m = ManagedNamespace{} }
+func (m *ManagedNamespace) String() string { return proto.CompactTextString(m) }
+func (*ManagedNamespace) ProtoMessage()    {}
+func (*ManagedNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{0}
+}
+
+func (m *ManagedNamespace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagedNamespace.Unmarshal(m, b)
+}
+func (m *ManagedNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagedNamespace.Marshal(b, m, deterministic)
+}
+func (m *ManagedNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedNamespace.Merge(m, src)
+}
+func (m *ManagedNamespace) XXX_Size() int {
+	return xxx_messageInfo_ManagedNamespace.Size(m)
+}
+func (m *ManagedNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedNamespace proto.InternalMessageInfo
+
+func (m *ManagedNamespace) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ManagedNamespace) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ManagedNamespace) GetSpec() *namespace.Namespace {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ManagedNamespace) GetStatus() *ManagedNamespaceStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ManagedNamespace) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *ManagedNamespace) GetCreationTimestamp() string {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return ""
+}
+
+type ManagedNamespaceStatus struct {
+	State            string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	RetryCount       int32  `protobuf:"varint,2,opt,name=retryCount,proto3" json:"retryCount,omitempty"`
+	ErrorDescription string `protobuf:"bytes,3,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	//clusterName is the cluster chosen by the placement
+	ClusterName string `protobuf:"bytes,4,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//clusters contains the state of the namespace in each of the target clusters
+	Clusters             []*ClusterNamespaceStatus `protobuf:"bytes,5,rep,name=clusters,proto3" json:"clusters,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}                  `json:"-"`
+	XXX_unrecognized     []byte                    `json:"-"`
+	XXX_sizecache        int32                     `json:"-"`
+}
+
+func (m *ManagedNamespaceStatus) Reset()         { *m = ManagedNamespaceStatus{} }
+func (m *ManagedNamespaceStatus) String() string { return proto.CompactTextString(m) }
+func (*ManagedNamespaceStatus) ProtoMessage()    {}
+func (*ManagedNamespaceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{1}
+}
+
+func (m *ManagedNamespaceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ManagedNamespaceStatus.Unmarshal(m, b)
+}
+func (m *ManagedNamespaceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ManagedNamespaceStatus.Marshal(b, m, deterministic)
+}
+func (m *ManagedNamespaceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ManagedNamespaceStatus.Merge(m, src)
+}
+func (m *ManagedNamespaceStatus) XXX_Size() int {
+	return xxx_messageInfo_ManagedNamespaceStatus.Size(m)
+}
+func (m *ManagedNamespaceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ManagedNamespaceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ManagedNamespaceStatus proto.InternalMessageInfo
+
+func (m *ManagedNamespaceStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ManagedNamespaceStatus) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *ManagedNamespaceStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *ManagedNamespaceStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ManagedNamespaceStatus) GetClusters() []*ClusterNamespaceStatus {
+	if m != nil {
+		return m.Clusters
+	}
+	return nil
+}
+
+type ClusterNamespaceStatus struct {
+	ClusterName      string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	Namespace        string `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	State            string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorDescription string `protobuf:"bytes,4,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	//lastSyncTime in RFC3339 format
+	LastSyncTime         string   `protobuf:"bytes,5,opt,name=lastSyncTime,proto3" json:"lastSyncTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterNamespaceStatus) Reset()         { *m = ClusterNamespaceStatus{} }
+func (m *ClusterNamespaceStatus) String() string { return proto.CompactTextString(m) }
+func (*ClusterNamespaceStatus) ProtoMessage()    {}
+func (*ClusterNamespaceStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{2}
+}
+
+func (m *ClusterNamespaceStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterNamespaceStatus.Unmarshal(m, b)
+}
+func (m *ClusterNamespaceStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterNamespaceStatus.Marshal(b, m, deterministic)
+}
+func (m *ClusterNamespaceStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterNamespaceStatus.Merge(m, src)
+}
+func (m *ClusterNamespaceStatus) XXX_Size() int {
+	return xxx_messageInfo_ClusterNamespaceStatus.Size(m)
+}
+func (m *ClusterNamespaceStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterNamespaceStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterNamespaceStatus proto.InternalMessageInfo
+
+func (m *ClusterNamespaceStatus) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ClusterNamespaceStatus) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *ClusterNamespaceStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ClusterNamespaceStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *ClusterNamespaceStatus) GetLastSyncTime() string {
+	if m != nil {
+		return m.LastSyncTime
+	}
+	return ""
+}
+
+type GetNamespaceRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetNamespaceRequest) Reset()         { *m = GetNamespaceRequest{} }
+func (m *GetNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*GetNamespaceRequest) ProtoMessage()    {}
+func (*GetNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{3}
+}
+
+func (m *GetNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetNamespaceRequest.Unmarshal(m, b)
+}
+func (m *GetNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetNamespaceRequest.Marshal(b, m, deterministic)
+}
+func (m *GetNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetNamespaceRequest.Merge(m, src)
+}
+func (m *GetNamespaceRequest) XXX_Size() int {
+	return xxx_messageInfo_GetNamespaceRequest.Size(m)
+}
+func (m *GetNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetNamespaceRequest proto.InternalMessageInfo
+
+func (m *GetNamespaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListNamespacesRequest struct {
+	//clusterName returns only the namespaces targeting the cluster
+	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	//templateName returns only the namespaces using the template
+	TemplateName string `protobuf:"bytes,2,opt,name=templateName,proto3" json:"templateName,omitempty"`
+	//state returns only the namespaces in the state
+	State string `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	//pageSize is the maximum number of namespaces to be returned. Default is 100
+	PageSize int32 `protobuf:"varint,4,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	//pageToken is the nextPageToken returned by the previous request
+	PageToken            string   `protobuf:"bytes,5,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNamespacesRequest) Reset()         { *m = ListNamespacesRequest{} }
+func (m *ListNamespacesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListNamespacesRequest) ProtoMessage()    {}
+func (*ListNamespacesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{4}
+}
+
+func (m *ListNamespacesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNamespacesRequest.Unmarshal(m, b)
+}
+func (m *ListNamespacesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNamespacesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListNamespacesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespacesRequest.Merge(m, src)
+}
+func (m *ListNamespacesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListNamespacesRequest.Size(m)
+}
+func (m *ListNamespacesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespacesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespacesRequest proto.InternalMessageInfo
+
+func (m *ListNamespacesRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+func (m *ListNamespacesRequest) GetTemplateName() string {
+	if m != nil {
+		return m.TemplateName
+	}
+	return ""
+}
+
+func (m *ListNamespacesRequest) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ListNamespacesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListNamespacesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListNamespacesResponse struct {
+	Items []*ManagedNamespace `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	//nextPageToken is empty if there are no more namespaces
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListNamespacesResponse) Reset()         { *m = ListNamespacesResponse{} }
+func (m *ListNamespacesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListNamespacesResponse) ProtoMessage()    {}
+func (*ListNamespacesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{5}
+}
+
+func (m *ListNamespacesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListNamespacesResponse.Unmarshal(m, b)
+}
+func (m *ListNamespacesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListNamespacesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListNamespacesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListNamespacesResponse.Merge(m, src)
+}
+func (m *ListNamespacesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListNamespacesResponse.Size(m)
+}
+func (m *ListNamespacesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListNamespacesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListNamespacesResponse proto.InternalMessageInfo
+
+func (m *ListNamespacesResponse) GetItems() []*ManagedNamespace {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListNamespacesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DeleteNamespaceRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNamespaceRequest) Reset()         { *m = DeleteNamespaceRequest{} }
+func (m *DeleteNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteNamespaceRequest) ProtoMessage()    {}
+func (*DeleteNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{6}
+}
+
+func (m *DeleteNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNamespaceRequest.Unmarshal(m, b)
+}
+func (m *DeleteNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNamespaceRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNamespaceRequest.Merge(m, src)
+}
+func (m *DeleteNamespaceRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteNamespaceRequest.Size(m)
+}
+func (m *DeleteNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNamespaceRequest proto.InternalMessageInfo
+
+func (m *DeleteNamespaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteNamespaceResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteNamespaceResponse) Reset()         { *m = DeleteNamespaceResponse{} }
+func (m *DeleteNamespaceResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteNamespaceResponse) ProtoMessage()    {}
+func (*DeleteNamespaceResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{7}
+}
+
+func (m *DeleteNamespaceResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteNamespaceResponse.Unmarshal(m, b)
+}
+func (m *DeleteNamespaceResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteNamespaceResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteNamespaceResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteNamespaceResponse.Merge(m, src)
+}
+func (m *DeleteNamespaceResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteNamespaceResponse.Size(m)
+}
+func (m *DeleteNamespaceResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteNamespaceResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteNamespaceResponse proto.InternalMessageInfo
+
+type ResyncNamespaceRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ResyncNamespaceRequest) Reset()         { *m = ResyncNamespaceRequest{} }
+func (m *ResyncNamespaceRequest) String() string { return proto.CompactTextString(m) }
+func (*ResyncNamespaceRequest) ProtoMessage()    {}
+func (*ResyncNamespaceRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_021b4ce5ee3fe153, []int{8}
+}
+
+func (m *ResyncNamespaceRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ResyncNamespaceRequest.Unmarshal(m, b)
+}
+func (m *ResyncNamespaceRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ResyncNamespaceRequest.Marshal(b, m, deterministic)
+}
+func (m *ResyncNamespaceRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ResyncNamespaceRequest.Merge(m, src)
+}
+func (m *ResyncNamespaceRequest) XXX_Size() int {
+	return xxx_messageInfo_ResyncNamespaceRequest.Size(m)
+}
+func (m *ResyncNamespaceRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ResyncNamespaceRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ResyncNamespaceRequest proto.InternalMessageInfo
+
+func (m *ResyncNamespaceRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func init() {
+	proto.RegisterType((*ManagedNamespace)(nil), "apis.ManagedNamespace")
+	proto.RegisterMapType((map[string]string)(nil), "apis.ManagedNamespace.LabelsEntry")
+	proto.RegisterType((*ManagedNamespaceStatus)(nil), "apis.ManagedNamespaceStatus")
+	proto.RegisterType((*ClusterNamespaceStatus)(nil), "apis.ClusterNamespaceStatus")
+	proto.RegisterType((*GetNamespaceRequest)(nil), "apis.GetNamespaceRequest")
+	proto.RegisterType((*ListNamespacesRequest)(nil), "apis.ListNamespacesRequest")
+	proto.RegisterType((*ListNamespacesResponse)(nil), "apis.ListNamespacesResponse")
+	proto.RegisterType((*DeleteNamespaceRequest)(nil), "apis.DeleteNamespaceRequest")
+	proto.RegisterType((*DeleteNamespaceResponse)(nil), "apis.DeleteNamespaceResponse")
+	proto.RegisterType((*ResyncNamespaceRequest)(nil), "apis.ResyncNamespaceRequest")
+}
+
+func init() {
+	proto.RegisterFile("pkg/grpc/proto/apis/namespace_service.proto", fileDescriptor_021b4ce5ee3fe153)
+}
+
+var fileDescriptor_021b4ce5ee3fe153 = []byte{
+	// 756 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x9c, 0x55, 0xdd, 0x6e, 0xd3, 0x48,
+	0x14, 0x96, 0xf3, 0x63, 0x35, 0x27, 0xdd, 0xdd, 0xec, 0xb4, 0x4d, 0x5d, 0x6f, 0xba, 0xca, 0x5a,
+	0x2b, 0x11, 0x42, 0x14, 0x8b, 0xc2, 0x45, 0xc9, 0x25, 0x2d, 0xea, 0x4d, 0x41, 0xc8, 0x29, 0x20,
+	0x7a, 0x83, 0x5c, 0xf7, 0xc8, 0x98, 0x38, 0x1e, 0x33, 0x33, 0xa9, 0x08, 0x88, 0x1b, 0x5e, 0x81,
+	0xe7, 0xe0, 0x15, 0xb8, 0xe4, 0x05, 0x10, 0x3c, 0x01, 0x0f, 0x82, 0x66, 0xec, 0xc4, 0xf9, 0xb1,
+	0x01, 0x71, 0x37, 0x73, 0xe6, 0x3b, 0xdf, 0xf9, 0xce, 0x77, 0x4e, 0x62, 0xb8, 0x11, 0x8f, 0x7c,
+	0xdb, 0x67, 0xb1, 0x67, 0xc7, 0x8c, 0x0a, 0x6a, 0xbb, 0x71, 0xc0, 0xed, 0xc8, 0x1d, 0x23, 0x8f,
+	0x5d, 0x0f, 0x9f, 0x71, 0x64, 0x57, 0x81, 0x87, 0x7d, 0xf5, 0x48, 0x2a, 0xf2, 0xd5, 0xec, 0xac,
+	0xa4, 0xcc, 0xd1, 0xd9, 0x29, 0xc1, 0x9b, 0x2d, 0x9f, 0x52, 0x3f, 0x44, 0x49, 0x6a, 0xbb, 0x51,
+	0x44, 0x85, 0x2b, 0x02, 0x1a, 0xf1, 0xe4, 0xd5, 0xfa, 0x52, 0x82, 0xc6, 0x7d, 0x37, 0x72, 0x7d,
+	0xbc, 0x7c, 0x30, 0x4b, 0x24, 0x04, 0x2a, 0x92, 0xc5, 0xd0, 0xda, 0x5a, 0xa7, 0xe6, 0xa8, 0x33,
+	0x19, 0x80, 0x1e, 0xba, 0x17, 0x18, 0x72, 0xa3, 0xd4, 0x2e, 0x77, 0xea, 0x07, 0x56, 0x5f, 0xea,
+	0xe8, 0xaf, 0xe6, 0xf6, 0x4f, 0x15, 0xe8, 0x5e, 0x24, 0xd8, 0xd4, 0x49, 0x33, 0x48, 0x07, 0x2a,
+	0x3c, 0x46, 0xcf, 0x28, 0xb7, 0xb5, 0x4e, 0xfd, 0x60, 0xbb, 0x9f, 0x49, 0x9c, 0xe7, 0x39, 0x0a,
+	0x41, 0x6e, 0x83, 0xce, 0x85, 0x2b, 0x26, 0xdc, 0xa8, 0x28, 0x6c, 0x2b, 0xbf, 0xca, 0x50, 0x61,
+	0x9c, 0x14, 0x4b, 0x3a, 0xf0, 0x17, 0x43, 0x4e, 0x27, 0xcc, 0xc3, 0xc7, 0xc8, 0x78, 0x40, 0x23,
+	0xa3, 0xaa, 0xa4, 0xaf, 0x86, 0x49, 0x0f, 0xfe, 0xf6, 0x18, 0x2a, 0x07, 0xce, 0x82, 0x31, 0x72,
+	0xe1, 0x8e, 0x63, 0x43, 0x57, 0xd8, 0xf5, 0x07, 0xf3, 0x0e, 0xd4, 0x17, 0xda, 0x21, 0x0d, 0x28,
+	0x8f, 0x70, 0x9a, 0xba, 0x22, 0x8f, 0x64, 0x1b, 0xaa, 0x57, 0x6e, 0x38, 0x41, 0xa3, 0xa4, 0x62,
+	0xc9, 0x65, 0x50, 0x3a, 0xd4, 0xac, 0xaf, 0x1a, 0x34, 0xf3, 0x55, 0xcb, 0x24, 0xa9, 0x7b, 0x66,
+	0x6f, 0x72, 0x21, 0xff, 0x02, 0x30, 0x14, 0x6c, 0x7a, 0x44, 0x27, 0x91, 0x50, 0x7c, 0x55, 0x67,
+	0x21, 0x42, 0xba, 0xd0, 0x40, 0xc6, 0x28, 0x3b, 0x46, 0xee, 0xb1, 0x20, 0x96, 0x42, 0x95, 0x9f,
+	0x35, 0x67, 0x2d, 0x4e, 0xda, 0x50, 0xf7, 0xc2, 0x09, 0x17, 0xc8, 0x64, 0x6d, 0x65, 0x65, 0xcd,
+	0x59, 0x0c, 0x91, 0x43, 0xd8, 0x48, 0xaf, 0xdc, 0xa8, 0xb6, 0xcb, 0x99, 0xd3, 0x47, 0x19, 0x68,
+	0xd1, 0xe9, 0x39, 0xda, 0xfa, 0xa8, 0x41, 0x33, 0x1f, 0xb4, 0x5a, 0x56, 0x5b, 0x2f, 0xdb, 0x82,
+	0xda, 0x7c, 0xf6, 0xa9, 0x67, 0x59, 0x20, 0x33, 0xa6, 0xbc, 0x68, 0x4c, 0x5e, 0xe3, 0x95, 0x82,
+	0xc6, 0x2d, 0xd8, 0x0c, 0x5d, 0x2e, 0x86, 0xd3, 0xc8, 0x93, 0x53, 0x4c, 0xb7, 0x60, 0x29, 0x66,
+	0x5d, 0x87, 0xad, 0x13, 0x14, 0xd9, 0xe2, 0xe1, 0xcb, 0x09, 0x72, 0x91, 0xb7, 0xf3, 0xd6, 0x07,
+	0x0d, 0x76, 0x4e, 0x03, 0x9e, 0x81, 0xf9, 0x0c, 0xfd, 0xf3, 0x56, 0x2d, 0xd8, 0x14, 0x38, 0x8e,
+	0x43, 0x57, 0xa0, 0x82, 0x24, 0xdd, 0x2e, 0xc5, 0x0a, 0x1a, 0x36, 0x61, 0x23, 0x76, 0x7d, 0x1c,
+	0x06, 0xaf, 0x93, 0xd1, 0x55, 0x9d, 0xf9, 0x5d, 0x1a, 0x28, 0xcf, 0x67, 0x74, 0x84, 0xb3, 0x1d,
+	0xcf, 0x02, 0x56, 0x08, 0xcd, 0x55, 0xb9, 0x3c, 0xa6, 0x11, 0x47, 0xd2, 0x83, 0x6a, 0x20, 0x70,
+	0xcc, 0x0d, 0x4d, 0x0d, 0xbb, 0x99, 0xff, 0xb3, 0x72, 0x12, 0x10, 0xf9, 0x1f, 0xfe, 0x88, 0xf0,
+	0x95, 0x78, 0x38, 0xaf, 0x94, 0x88, 0x5f, 0x0e, 0x5a, 0x3d, 0x68, 0x1e, 0x63, 0x88, 0x02, 0xb3,
+	0xfc, 0x1f, 0x78, 0xb9, 0x07, 0xbb, 0x6b, 0xe8, 0x44, 0x9c, 0x24, 0x72, 0x90, 0x4f, 0x23, 0xef,
+	0x57, 0x88, 0x0e, 0x3e, 0x55, 0xa0, 0x91, 0x6d, 0x5e, 0xf2, 0xd7, 0x48, 0x86, 0xa0, 0x1f, 0xc9,
+	0x9f, 0x2f, 0x92, 0x82, 0xd6, 0xcc, 0x82, 0xb8, 0xb5, 0xf7, 0xee, 0xf3, 0xb7, 0xf7, 0xa5, 0x2d,
+	0xeb, 0x4f, 0xfb, 0xea, 0x66, 0xf6, 0xdf, 0xc9, 0x07, 0x5a, 0x97, 0x3c, 0x81, 0xf2, 0x09, 0x0a,
+	0xb2, 0x97, 0x64, 0xe6, 0x2c, 0x4d, 0x21, 0xe9, 0xbe, 0x22, 0xdd, 0x25, 0x3b, 0xcb, 0xa4, 0xf6,
+	0x1b, 0x79, 0x7e, 0x4b, 0x9e, 0x42, 0x45, 0xce, 0x89, 0xfc, 0x93, 0xa4, 0xe7, 0xae, 0x98, 0xd9,
+	0xca, 0x7f, 0x4c, 0x3d, 0x6b, 0xaa, 0x0a, 0x0d, 0xb2, 0x22, 0x9b, 0x9c, 0x83, 0xfe, 0x28, 0xbe,
+	0xfc, 0x1d, 0x23, 0xda, 0x8a, 0xd1, 0x34, 0xf3, 0x35, 0x4b, 0x3f, 0x2e, 0x41, 0x4f, 0x46, 0x48,
+	0x52, 0x6d, 0xf9, 0xe3, 0x37, 0xf7, 0x0b, 0x5e, 0x53, 0xe9, 0xa9, 0x39, 0xdd, 0x02, 0x73, 0x7c,
+	0xd0, 0x93, 0x6d, 0x98, 0x55, 0xc9, 0xdf, 0x8d, 0xc2, 0x3e, 0xae, 0x29, 0xfa, 0xff, 0xac, 0x56,
+	0x2e, 0xbd, 0xcd, 0x14, 0xdb, 0x40, 0xeb, 0xde, 0xed, 0x9d, 0x77, 0xfd, 0x40, 0x3c, 0x9f, 0x5c,
+	0xf4, 0x3d, 0x3a, 0xb6, 0x47, 0x18, 0x8c, 0x68, 0xcc, 0xe8, 0x0b, 0x7b, 0xac, 0x18, 0x99, 0x3d,
+	0xff, 0xc2, 0xca, 0x3a, 0x17, 0xba, 0xfa, 0x5e, 0xde, 0xfa, 0x3e, 0x00, 0xf5, 0x2f, 0xe7, 0x88,
+	0xac, 0x07, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// NamespaceServiceClient is the client API for NamespaceService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type NamespaceServiceClient interface {
+	Create(ctx context.Context, in *ManagedNamespace, opts ...grpc.CallOption) (*ManagedNamespace, error)
+	Get(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*ManagedNamespace, error)
+	List(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error)
+	Update(ctx context.Context, in *ManagedNamespace, opts ...grpc.CallOption) (*ManagedNamespace, error)
+	Delete(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error)
+	//Resync triggers the reconcile of the managed namespace right away
+	Resync(ctx context.Context, in *ResyncNamespaceRequest, opts ...grpc.CallOption) (*ManagedNamespace, error)
+}
+
+type namespaceServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewNamespaceServiceClient(cc grpc.ClientConnInterface) NamespaceServiceClient {
+	return &namespaceServiceClient{cc}
+}
+
+func (c *namespaceServiceClient) Create(ctx context.Context, in *ManagedNamespace, opts ...grpc.CallOption) (*ManagedNamespace, error) {
+	out := new(ManagedNamespace)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Get(ctx context.Context, in *GetNamespaceRequest, opts ...grpc.CallOption) (*ManagedNamespace, error) {
+	out := new(ManagedNamespace)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) List(ctx context.Context, in *ListNamespacesRequest, opts ...grpc.CallOption) (*ListNamespacesResponse, error) {
+	out := new(ListNamespacesResponse)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Update(ctx context.Context, in *ManagedNamespace, opts ...grpc.CallOption) (*ManagedNamespace, error) {
+	out := new(ManagedNamespace)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Delete(ctx context.Context, in *DeleteNamespaceRequest, opts ...grpc.CallOption) (*DeleteNamespaceResponse, error) {
+	out := new(DeleteNamespaceResponse)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *namespaceServiceClient) Resync(ctx context.Context, in *ResyncNamespaceRequest, opts ...grpc.CallOption) (*ManagedNamespace, error) {
+	out := new(ManagedNamespace)
+	err := c.cc.Invoke(ctx, "/apis.NamespaceService/Resync", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// NamespaceServiceServer is the server API for NamespaceService service.
+type NamespaceServiceServer interface {
+	Create(context.Context, *ManagedNamespace) (*ManagedNamespace, error)
+	Get(context.Context, *GetNamespaceRequest) (*ManagedNamespace, error)
+	List(context.Context, *ListNamespacesRequest) (*ListNamespacesResponse, error)
+	Update(context.Context, *ManagedNamespace) (*ManagedNamespace, error)
+	Delete(context.Context, *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error)
+	//Resync triggers the reconcile of the managed namespace right away
+	Resync(context.Context, *ResyncNamespaceRequest) (*ManagedNamespace, error)
+}
+
+// UnimplementedNamespaceServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedNamespaceServiceServer struct {
+}
+
+func (*UnimplementedNamespaceServiceServer) Create(ctx context.Context, req *ManagedNamespace) (*ManagedNamespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedNamespaceServiceServer) Get(ctx context.Context, req *GetNamespaceRequest) (*ManagedNamespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedNamespaceServiceServer) List(ctx context.Context, req *ListNamespacesRequest) (*ListNamespacesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedNamespaceServiceServer) Update(ctx context.Context, req *ManagedNamespace) (*ManagedNamespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedNamespaceServiceServer) Delete(ctx context.Context, req *DeleteNamespaceRequest) (*DeleteNamespaceResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedNamespaceServiceServer) Resync(ctx context.Context, req *ResyncNamespaceRequest) (*ManagedNamespace, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Resync not implemented")
+}
+
+func RegisterNamespaceServiceServer(s *grpc.Server, srv NamespaceServiceServer) {
+	s.RegisterService(&_NamespaceService_serviceDesc, srv)
+}
+
+func _NamespaceService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagedNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Create(ctx, req.(*ManagedNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Get(ctx, req.(*GetNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListNamespacesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).List(ctx, req.(*ListNamespacesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ManagedNamespace)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Update(ctx, req.(*ManagedNamespace))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Delete(ctx, req.(*DeleteNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _NamespaceService_Resync_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ResyncNamespaceRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(NamespaceServiceServer).Resync(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.NamespaceService/Resync",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(NamespaceServiceServer).Resync(ctx, req.(*ResyncNamespaceRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _NamespaceService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apis.NamespaceService",
+	HandlerType: (*NamespaceServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _NamespaceService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _NamespaceService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _NamespaceService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _NamespaceService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _NamespaceService_Delete_Handler,
+		},
+		{
+			MethodName: "Resync",
+			Handler:    _NamespaceService_Resync_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/grpc/proto/apis/namespace_service.proto",
+}
@@ -8,6 +8,7 @@ import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
 	cluster "github.com/keikoproj/manager/pkg/grpc/proto/cluster"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
 	grpc "google.golang.org/grpc"
 	codes "google.golang.org/grpc/codes"
 	status "google.golang.org/grpc/status"
@@ -95,9 +96,606 @@ func (m *UnregisterClusterResponse) XXX_DiscardUnknown() {
 
 var xxx_messageInfo_UnregisterClusterResponse proto.InternalMessageInfo
 
+// ClusterInfo represents the Cluster custom resource. Credentials are always redacted
+type ClusterInfo struct {
+	Name   string            `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Spec   *cluster.Cluster  `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	Status *ClusterStatus    `protobuf:"bytes,4,opt,name=status,proto3" json:"status,omitempty"`
+	//creationTimestamp in RFC3339 format
+	CreationTimestamp    string   `protobuf:"bytes,5,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	ResourceVersion      string   `protobuf:"bytes,6,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterInfo) Reset()         { *m = ClusterInfo{} }
+func (m *ClusterInfo) String() string { return proto.CompactTextString(m) }
+func (*ClusterInfo) ProtoMessage()    {}
+func (*ClusterInfo) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{2}
+}
+
+func (m *ClusterInfo) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterInfo.Unmarshal(m, b)
+}
+func (m *ClusterInfo) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterInfo.Marshal(b, m, deterministic)
+}
+func (m *ClusterInfo) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterInfo.Merge(m, src)
+}
+func (m *ClusterInfo) XXX_Size() int {
+	return xxx_messageInfo_ClusterInfo.Size(m)
+}
+func (m *ClusterInfo) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterInfo.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterInfo proto.InternalMessageInfo
+
+func (m *ClusterInfo) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *ClusterInfo) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *ClusterInfo) GetSpec() *cluster.Cluster {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *ClusterInfo) GetStatus() *ClusterStatus {
+	if m != nil {
+		return m.Status
+	}
+	return nil
+}
+
+func (m *ClusterInfo) GetCreationTimestamp() string {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return ""
+}
+
+func (m *ClusterInfo) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type ClusterStatus struct {
+	State            string `protobuf:"bytes,1,opt,name=state,proto3" json:"state,omitempty"`
+	RetryCount       int32  `protobuf:"varint,2,opt,name=retryCount,proto3" json:"retryCount,omitempty"`
+	ErrorDescription string `protobuf:"bytes,3,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	NamespaceCount   int32  `protobuf:"varint,4,opt,name=namespaceCount,proto3" json:"namespaceCount,omitempty"`
+	//namespaceStateCounts contains the number of managed namespaces in the cluster per state
+	NamespaceStateCounts map[string]int32 `protobuf:"bytes,5,rep,name=namespaceStateCounts,proto3" json:"namespaceStateCounts,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	KubernetesVersion    string           `protobuf:"bytes,6,opt,name=kubernetesVersion,proto3" json:"kubernetesVersion,omitempty"`
+	NodeCount            int32            `protobuf:"varint,7,opt,name=nodeCount,proto3" json:"nodeCount,omitempty"`
+	AllocatableCPU       string           `protobuf:"bytes,8,opt,name=allocatableCPU,proto3" json:"allocatableCPU,omitempty"`
+	AllocatableMemory    string           `protobuf:"bytes,9,opt,name=allocatableMemory,proto3" json:"allocatableMemory,omitempty"`
+	ApiLatency           string           `protobuf:"bytes,10,opt,name=apiLatency,proto3" json:"apiLatency,omitempty"`
+	//lastProbeTime in RFC3339 format
+	LastProbeTime        string              `protobuf:"bytes,11,opt,name=lastProbeTime,proto3" json:"lastProbeTime,omitempty"`
+	Conditions           []*ClusterCondition `protobuf:"bytes,12,rep,name=conditions,proto3" json:"conditions,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *ClusterStatus) Reset()         { *m = ClusterStatus{} }
+func (m *ClusterStatus) String() string { return proto.CompactTextString(m) }
+func (*ClusterStatus) ProtoMessage()    {}
+func (*ClusterStatus) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{3}
+}
+
+func (m *ClusterStatus) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterStatus.Unmarshal(m, b)
+}
+func (m *ClusterStatus) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterStatus.Marshal(b, m, deterministic)
+}
+func (m *ClusterStatus) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterStatus.Merge(m, src)
+}
+func (m *ClusterStatus) XXX_Size() int {
+	return xxx_messageInfo_ClusterStatus.Size(m)
+}
+func (m *ClusterStatus) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterStatus.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterStatus proto.InternalMessageInfo
+
+func (m *ClusterStatus) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetRetryCount() int32 {
+	if m != nil {
+		return m.RetryCount
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetNamespaceCount() int32 {
+	if m != nil {
+		return m.NamespaceCount
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetNamespaceStateCounts() map[string]int32 {
+	if m != nil {
+		return m.NamespaceStateCounts
+	}
+	return nil
+}
+
+func (m *ClusterStatus) GetKubernetesVersion() string {
+	if m != nil {
+		return m.KubernetesVersion
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetNodeCount() int32 {
+	if m != nil {
+		return m.NodeCount
+	}
+	return 0
+}
+
+func (m *ClusterStatus) GetAllocatableCPU() string {
+	if m != nil {
+		return m.AllocatableCPU
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetAllocatableMemory() string {
+	if m != nil {
+		return m.AllocatableMemory
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetApiLatency() string {
+	if m != nil {
+		return m.ApiLatency
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetLastProbeTime() string {
+	if m != nil {
+		return m.LastProbeTime
+	}
+	return ""
+}
+
+func (m *ClusterStatus) GetConditions() []*ClusterCondition {
+	if m != nil {
+		return m.Conditions
+	}
+	return nil
+}
+
+type ClusterCondition struct {
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Status  string `protobuf:"bytes,2,opt,name=status,proto3" json:"status,omitempty"`
+	Reason  string `protobuf:"bytes,3,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,4,opt,name=message,proto3" json:"message,omitempty"`
+	//lastTransitionTime in RFC3339 format
+	LastTransitionTime   string   `protobuf:"bytes,5,opt,name=lastTransitionTime,proto3" json:"lastTransitionTime,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterCondition) Reset()         { *m = ClusterCondition{} }
+func (m *ClusterCondition) String() string { return proto.CompactTextString(m) }
+func (*ClusterCondition) ProtoMessage()    {}
+func (*ClusterCondition) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{4}
+}
+
+func (m *ClusterCondition) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterCondition.Unmarshal(m, b)
+}
+func (m *ClusterCondition) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterCondition.Marshal(b, m, deterministic)
+}
+func (m *ClusterCondition) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterCondition.Merge(m, src)
+}
+func (m *ClusterCondition) XXX_Size() int {
+	return xxx_messageInfo_ClusterCondition.Size(m)
+}
+func (m *ClusterCondition) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterCondition.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterCondition proto.InternalMessageInfo
+
+func (m *ClusterCondition) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ClusterCondition) GetStatus() string {
+	if m != nil {
+		return m.Status
+	}
+	return ""
+}
+
+func (m *ClusterCondition) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ClusterCondition) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ClusterCondition) GetLastTransitionTime() string {
+	if m != nil {
+		return m.LastTransitionTime
+	}
+	return ""
+}
+
+// HostedNamespace represents the managed namespace created in the cluster
+type HostedNamespace struct {
+	//managedNamespace is the name of the managed namespace resource
+	ManagedNamespace string `protobuf:"bytes,1,opt,name=managedNamespace,proto3" json:"managedNamespace,omitempty"`
+	//namespace is the name of the namespace in the cluster
+	Namespace            string   `protobuf:"bytes,2,opt,name=namespace,proto3" json:"namespace,omitempty"`
+	State                string   `protobuf:"bytes,3,opt,name=state,proto3" json:"state,omitempty"`
+	ErrorDescription     string   `protobuf:"bytes,4,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *HostedNamespace) Reset()         { *m = HostedNamespace{} }
+func (m *HostedNamespace) String() string { return proto.CompactTextString(m) }
+func (*HostedNamespace) ProtoMessage()    {}
+func (*HostedNamespace) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{5}
+}
+
+func (m *HostedNamespace) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_HostedNamespace.Unmarshal(m, b)
+}
+func (m *HostedNamespace) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_HostedNamespace.Marshal(b, m, deterministic)
+}
+func (m *HostedNamespace) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_HostedNamespace.Merge(m, src)
+}
+func (m *HostedNamespace) XXX_Size() int {
+	return xxx_messageInfo_HostedNamespace.Size(m)
+}
+func (m *HostedNamespace) XXX_DiscardUnknown() {
+	xxx_messageInfo_HostedNamespace.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_HostedNamespace proto.InternalMessageInfo
+
+func (m *HostedNamespace) GetManagedNamespace() string {
+	if m != nil {
+		return m.ManagedNamespace
+	}
+	return ""
+}
+
+func (m *HostedNamespace) GetNamespace() string {
+	if m != nil {
+		return m.Namespace
+	}
+	return ""
+}
+
+func (m *HostedNamespace) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *HostedNamespace) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+type ClusterEvent struct {
+	Type    string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	Reason  string `protobuf:"bytes,2,opt,name=reason,proto3" json:"reason,omitempty"`
+	Message string `protobuf:"bytes,3,opt,name=message,proto3" json:"message,omitempty"`
+	Count   int32  `protobuf:"varint,4,opt,name=count,proto3" json:"count,omitempty"`
+	//lastTimestamp in RFC3339 format
+	LastTimestamp        string   `protobuf:"bytes,5,opt,name=lastTimestamp,proto3" json:"lastTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ClusterEvent) Reset()         { *m = ClusterEvent{} }
+func (m *ClusterEvent) String() string { return proto.CompactTextString(m) }
+func (*ClusterEvent) ProtoMessage()    {}
+func (*ClusterEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{6}
+}
+
+func (m *ClusterEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ClusterEvent.Unmarshal(m, b)
+}
+func (m *ClusterEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ClusterEvent.Marshal(b, m, deterministic)
+}
+func (m *ClusterEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ClusterEvent.Merge(m, src)
+}
+func (m *ClusterEvent) XXX_Size() int {
+	return xxx_messageInfo_ClusterEvent.Size(m)
+}
+func (m *ClusterEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_ClusterEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ClusterEvent proto.InternalMessageInfo
+
+func (m *ClusterEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *ClusterEvent) GetReason() string {
+	if m != nil {
+		return m.Reason
+	}
+	return ""
+}
+
+func (m *ClusterEvent) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+func (m *ClusterEvent) GetCount() int32 {
+	if m != nil {
+		return m.Count
+	}
+	return 0
+}
+
+func (m *ClusterEvent) GetLastTimestamp() string {
+	if m != nil {
+		return m.LastTimestamp
+	}
+	return ""
+}
+
+type ListClustersRequest struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListClustersRequest) Reset()         { *m = ListClustersRequest{} }
+func (m *ListClustersRequest) String() string { return proto.CompactTextString(m) }
+func (*ListClustersRequest) ProtoMessage()    {}
+func (*ListClustersRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{7}
+}
+
+func (m *ListClustersRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClustersRequest.Unmarshal(m, b)
+}
+func (m *ListClustersRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClustersRequest.Marshal(b, m, deterministic)
+}
+func (m *ListClustersRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClustersRequest.Merge(m, src)
+}
+func (m *ListClustersRequest) XXX_Size() int {
+	return xxx_messageInfo_ListClustersRequest.Size(m)
+}
+func (m *ListClustersRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClustersRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClustersRequest proto.InternalMessageInfo
+
+type ListClustersResponse struct {
+	Items                []*ClusterInfo `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}       `json:"-"`
+	XXX_unrecognized     []byte         `json:"-"`
+	XXX_sizecache        int32          `json:"-"`
+}
+
+func (m *ListClustersResponse) Reset()         { *m = ListClustersResponse{} }
+func (m *ListClustersResponse) String() string { return proto.CompactTextString(m) }
+func (*ListClustersResponse) ProtoMessage()    {}
+func (*ListClustersResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{8}
+}
+
+func (m *ListClustersResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListClustersResponse.Unmarshal(m, b)
+}
+func (m *ListClustersResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListClustersResponse.Marshal(b, m, deterministic)
+}
+func (m *ListClustersResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListClustersResponse.Merge(m, src)
+}
+func (m *ListClustersResponse) XXX_Size() int {
+	return xxx_messageInfo_ListClustersResponse.Size(m)
+}
+func (m *ListClustersResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListClustersResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListClustersResponse proto.InternalMessageInfo
+
+func (m *ListClustersResponse) GetItems() []*ClusterInfo {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+type GetClusterRequest struct {
+	ClusterName          string   `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetClusterRequest) Reset()         { *m = GetClusterRequest{} }
+func (m *GetClusterRequest) String() string { return proto.CompactTextString(m) }
+func (*GetClusterRequest) ProtoMessage()    {}
+func (*GetClusterRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{9}
+}
+
+func (m *GetClusterRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetClusterRequest.Unmarshal(m, b)
+}
+func (m *GetClusterRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetClusterRequest.Marshal(b, m, deterministic)
+}
+func (m *GetClusterRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetClusterRequest.Merge(m, src)
+}
+func (m *GetClusterRequest) XXX_Size() int {
+	return xxx_messageInfo_GetClusterRequest.Size(m)
+}
+func (m *GetClusterRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetClusterRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetClusterRequest proto.InternalMessageInfo
+
+func (m *GetClusterRequest) GetClusterName() string {
+	if m != nil {
+		return m.ClusterName
+	}
+	return ""
+}
+
+type DescribeClusterResponse struct {
+	Cluster *ClusterInfo `protobuf:"bytes,1,opt,name=cluster,proto3" json:"cluster,omitempty"`
+	//namespaces contains the managed namespaces targeting the cluster
+	Namespaces []*HostedNamespace `protobuf:"bytes,2,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	//events contains the most recent events of the cluster
+	Events               []*ClusterEvent `protobuf:"bytes,3,rep,name=events,proto3" json:"events,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *DescribeClusterResponse) Reset()         { *m = DescribeClusterResponse{} }
+func (m *DescribeClusterResponse) String() string { return proto.CompactTextString(m) }
+func (*DescribeClusterResponse) ProtoMessage()    {}
+func (*DescribeClusterResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_223c4f3164bb6ab0, []int{10}
+}
+
+func (m *DescribeClusterResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DescribeClusterResponse.Unmarshal(m, b)
+}
+func (m *DescribeClusterResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DescribeClusterResponse.Marshal(b, m, deterministic)
+}
+func (m *DescribeClusterResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DescribeClusterResponse.Merge(m, src)
+}
+func (m *DescribeClusterResponse) XXX_Size() int {
+	return xxx_messageInfo_DescribeClusterResponse.Size(m)
+}
+func (m *DescribeClusterResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DescribeClusterResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DescribeClusterResponse proto.InternalMessageInfo
+
+func (m *DescribeClusterResponse) GetCluster() *ClusterInfo {
+	if m != nil {
+		return m.Cluster
+	}
+	return nil
+}
+
+func (m *DescribeClusterResponse) GetNamespaces() []*HostedNamespace {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+func (m *DescribeClusterResponse) GetEvents() []*ClusterEvent {
+	if m != nil {
+		return m.Events
+	}
+	return nil
+}
+
 func init() {
 	proto.RegisterType((*UnregisterClusterRequest)(nil), "apis.UnregisterClusterRequest")
 	proto.RegisterType((*UnregisterClusterResponse)(nil), "apis.UnregisterClusterResponse")
+	proto.RegisterType((*ClusterInfo)(nil), "apis.ClusterInfo")
+	proto.RegisterMapType((map[string]string)(nil), "apis.ClusterInfo.LabelsEntry")
+	proto.RegisterType((*ClusterStatus)(nil), "apis.ClusterStatus")
+	proto.RegisterMapType((map[string]int32)(nil), "apis.ClusterStatus.NamespaceStateCountsEntry")
+	proto.RegisterType((*ClusterCondition)(nil), "apis.ClusterCondition")
+	proto.RegisterType((*HostedNamespace)(nil), "apis.HostedNamespace")
+	proto.RegisterType((*ClusterEvent)(nil), "apis.ClusterEvent")
+	proto.RegisterType((*ListClustersRequest)(nil), "apis.ListClustersRequest")
+	proto.RegisterType((*ListClustersResponse)(nil), "apis.ListClustersResponse")
+	proto.RegisterType((*GetClusterRequest)(nil), "apis.GetClusterRequest")
+	proto.RegisterType((*DescribeClusterResponse)(nil), "apis.DescribeClusterResponse")
 }
 
 func init() {
@@ -105,21 +703,67 @@ func init() {
 }
 
 var fileDescriptor_223c4f3164bb6ab0 = []byte{
-	// 223 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0xe2, 0x52, 0x2c, 0xc8, 0x4e, 0xd7,
-	0x4f, 0x2f, 0x2a, 0x48, 0xd6, 0x2f, 0x28, 0xca, 0x2f, 0xc9, 0xd7, 0x4f, 0x2c, 0xc8, 0x2c, 0xd6,
-	0x2f, 0x4e, 0x2d, 0x2a, 0xcb, 0x4c, 0x4e, 0xd5, 0x03, 0x0b, 0x09, 0xb1, 0x80, 0xc4, 0xa4, 0x54,
-	0xd0, 0x14, 0x26, 0xe7, 0x94, 0x16, 0x97, 0xa4, 0x16, 0xc1, 0x68, 0x88, 0x5a, 0x25, 0x1b, 0x2e,
-	0x89, 0xd0, 0xbc, 0xa2, 0xd4, 0xf4, 0x4c, 0x90, 0x98, 0x33, 0x44, 0x2a, 0x28, 0xb5, 0xb0, 0x34,
-	0xb5, 0xb8, 0x44, 0x48, 0x81, 0x8b, 0x1b, 0xaa, 0xd8, 0x2f, 0x31, 0x37, 0x55, 0x82, 0x51, 0x81,
-	0x51, 0x83, 0x33, 0x08, 0x59, 0x48, 0x49, 0x9a, 0x4b, 0x12, 0x8b, 0xee, 0xe2, 0x82, 0xfc, 0xbc,
-	0xe2, 0x54, 0xa3, 0x85, 0x8c, 0x5c, 0x7c, 0x50, 0xb1, 0x60, 0x88, 0xfb, 0x84, 0xcc, 0xb9, 0xf8,
-	0x83, 0x50, 0x55, 0x0b, 0x09, 0xe8, 0xc1, 0x1c, 0x04, 0x15, 0x91, 0xc2, 0x10, 0x51, 0x62, 0x10,
-	0x0a, 0xe3, 0x12, 0xc4, 0xb0, 0x48, 0x48, 0x4e, 0x0f, 0xe4, 0x51, 0x3d, 0x5c, 0xee, 0x97, 0x92,
-	0xc7, 0x29, 0x0f, 0x71, 0xa1, 0x12, 0x83, 0x93, 0x4e, 0x94, 0x56, 0x7a, 0x66, 0x49, 0x46, 0x69,
-	0x92, 0x5e, 0x72, 0x7e, 0xae, 0x7e, 0x76, 0x6a, 0x66, 0x76, 0x7e, 0x41, 0x51, 0x7e, 0x96, 0x7e,
-	0x6e, 0x62, 0x5e, 0x62, 0x7a, 0x6a, 0x91, 0x3e, 0x3c, 0x0c, 0x41, 0x26, 0x25, 0xb1, 0x81, 0xc3,
-	0xcc, 0x18, 0x10, 0x00, 0x00, 0xff, 0xff, 0x04, 0x7e, 0x86, 0x7d, 0x84, 0x01, 0x00, 0x00,
+	// 960 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x94, 0x56, 0x4d, 0x6f, 0x23, 0x45,
+	0x13, 0xd6, 0xf8, 0x2b, 0xaf, 0xcb, 0xd9, 0x24, 0xee, 0xf5, 0x6e, 0x26, 0xf3, 0x86, 0x25, 0x0c,
+	0x01, 0x22, 0x67, 0xf1, 0x88, 0xa0, 0x20, 0x58, 0x21, 0x21, 0x11, 0x56, 0x0b, 0x52, 0x88, 0x56,
+	0xc3, 0x2e, 0x07, 0xe0, 0xd2, 0x9e, 0x14, 0x66, 0xb0, 0x3d, 0x3d, 0x74, 0xb7, 0x2d, 0x2c, 0xc4,
+	0x85, 0x3b, 0x12, 0x12, 0x67, 0x24, 0xae, 0xfc, 0x09, 0xfe, 0x04, 0x7f, 0x01, 0xfe, 0x07, 0xea,
+	0x8f, 0xb1, 0xe7, 0xcb, 0x20, 0x4e, 0x76, 0x3f, 0x55, 0x5d, 0x55, 0xfd, 0xf4, 0x53, 0x5d, 0x03,
+	0x2f, 0xa5, 0xd3, 0x49, 0x30, 0xe1, 0x69, 0x14, 0xa4, 0x9c, 0x49, 0x16, 0xd0, 0x34, 0x16, 0x81,
+	0x40, 0xbe, 0x8c, 0x23, 0x1c, 0x69, 0x88, 0xb4, 0x14, 0xe6, 0x9d, 0x96, 0x1c, 0xa3, 0xd9, 0x42,
+	0x48, 0xe4, 0xd9, 0xaf, 0xf1, 0xf5, 0x8e, 0x27, 0x8c, 0x4d, 0x66, 0xa8, 0xc2, 0x04, 0x34, 0x49,
+	0x98, 0xa4, 0x32, 0x66, 0x89, 0x30, 0x56, 0xff, 0x5d, 0x70, 0x9f, 0x27, 0x1c, 0x27, 0xb1, 0xda,
+	0x71, 0x65, 0x36, 0x86, 0xf8, 0xcd, 0x02, 0x85, 0x24, 0x27, 0xd0, 0xb3, 0xa1, 0x6e, 0xe8, 0x1c,
+	0x5d, 0xe7, 0xc4, 0x39, 0xeb, 0x86, 0x79, 0xc8, 0xff, 0x3f, 0x1c, 0xd5, 0xec, 0x16, 0x29, 0x4b,
+	0x04, 0xfa, 0xbf, 0x37, 0xa0, 0x67, 0xb1, 0x8f, 0x92, 0x2f, 0x19, 0x21, 0xd0, 0x4a, 0x36, 0x71,
+	0xf4, 0x7f, 0x72, 0x09, 0x9d, 0x19, 0x1d, 0xe3, 0x4c, 0xb8, 0x8d, 0x93, 0xe6, 0x59, 0xef, 0xe2,
+	0x85, 0x91, 0x3a, 0xd9, 0x28, 0xb7, 0x6d, 0x74, 0xad, 0xed, 0x8f, 0x13, 0xc9, 0x57, 0xa1, 0x75,
+	0x26, 0xa7, 0xd0, 0x12, 0x29, 0x46, 0x6e, 0xf3, 0xc4, 0x39, 0xeb, 0x5d, 0x1c, 0x8c, 0xb2, 0x13,
+	0x67, 0x25, 0x68, 0x2b, 0x39, 0x87, 0x8e, 0x90, 0x54, 0x2e, 0x84, 0xdb, 0xd2, 0x7e, 0x77, 0x0b,
+	0xc1, 0x3f, 0xd1, 0xa6, 0xd0, 0xba, 0x90, 0x87, 0xd0, 0x8f, 0x38, 0x6a, 0x6e, 0x9e, 0xc5, 0x73,
+	0x14, 0x92, 0xce, 0x53, 0xb7, 0xad, 0x4b, 0xad, 0x1a, 0xc8, 0x19, 0xec, 0x73, 0x14, 0x6c, 0xc1,
+	0x23, 0xfc, 0x14, 0xb9, 0x88, 0x59, 0xe2, 0x76, 0xb4, 0x6f, 0x19, 0xf6, 0xde, 0x81, 0x5e, 0xee,
+	0x04, 0xe4, 0x00, 0x9a, 0x53, 0x5c, 0x59, 0x0e, 0xd4, 0x5f, 0x32, 0x80, 0xf6, 0x92, 0xce, 0x16,
+	0xe8, 0x36, 0x34, 0x66, 0x16, 0x8f, 0x1a, 0x6f, 0x3b, 0xfe, 0x5f, 0x2d, 0xb8, 0x53, 0x28, 0x56,
+	0xf9, 0xaa, 0x72, 0x33, 0x0e, 0xcd, 0x82, 0x3c, 0x00, 0xe0, 0x28, 0xf9, 0xea, 0x8a, 0x2d, 0x12,
+	0xa9, 0xc3, 0xb4, 0xc3, 0x1c, 0x42, 0x86, 0x70, 0x80, 0x9c, 0x33, 0xfe, 0x01, 0x8a, 0x88, 0xc7,
+	0xa9, 0x3a, 0x89, 0x66, 0xae, 0x1b, 0x56, 0x70, 0xf2, 0x2a, 0xec, 0xa9, 0x8b, 0x11, 0x29, 0x8d,
+	0xd0, 0xc4, 0x6b, 0xe9, 0x78, 0x25, 0x94, 0x50, 0x18, 0xac, 0x11, 0x55, 0x9c, 0x81, 0x85, 0xdb,
+	0xd6, 0xd7, 0xf8, 0x7a, 0x0d, 0xd3, 0xa3, 0x9b, 0x1a, 0x7f, 0x73, 0xad, 0xb5, 0xa1, 0xd4, 0x8d,
+	0x4c, 0x17, 0x63, 0xe4, 0x09, 0x4a, 0x14, 0x45, 0x96, 0xab, 0x06, 0x72, 0x0c, 0xdd, 0x84, 0xdd,
+	0xda, 0x9a, 0x77, 0x74, 0xcd, 0x1b, 0x40, 0x1d, 0x8b, 0xce, 0x66, 0x2c, 0xa2, 0x92, 0x8e, 0x67,
+	0x78, 0xf5, 0xf4, 0xb9, 0xfb, 0x3f, 0x1d, 0xa8, 0x84, 0xaa, 0x9c, 0x39, 0xe4, 0x63, 0x9c, 0x33,
+	0xbe, 0x72, 0xbb, 0x26, 0x67, 0xc5, 0xa0, 0x88, 0xa7, 0x69, 0x7c, 0x4d, 0x25, 0x26, 0xd1, 0xca,
+	0x05, 0xed, 0x96, 0x43, 0xc8, 0x29, 0xdc, 0x99, 0x51, 0x21, 0x9f, 0x72, 0x36, 0x46, 0xa5, 0x1d,
+	0xb7, 0xa7, 0x5d, 0x8a, 0x20, 0x79, 0x0b, 0x20, 0x62, 0xc9, 0x6d, 0xac, 0xdb, 0xd2, 0xdd, 0xd5,
+	0x04, 0xde, 0x2f, 0x10, 0x78, 0x95, 0x99, 0xc3, 0x9c, 0xa7, 0xf7, 0x04, 0x8e, 0xb6, 0x52, 0xfa,
+	0x6f, 0x3a, 0x6b, 0xe7, 0x75, 0xf6, 0xab, 0x03, 0x07, 0xe5, 0x4c, 0xaa, 0x5b, 0xe5, 0x2a, 0x5d,
+	0x77, 0xab, 0xfa, 0x4f, 0xee, 0xaf, 0x1b, 0xca, 0x68, 0xd5, 0xae, 0x14, 0xce, 0x91, 0x8a, 0xb5,
+	0xac, 0xec, 0x8a, 0xb8, 0xb0, 0x33, 0x47, 0x21, 0xe8, 0x04, 0xb5, 0x8a, 0xba, 0x61, 0xb6, 0x24,
+	0x23, 0x20, 0x8a, 0x84, 0x67, 0x9c, 0x26, 0x22, 0xce, 0x5a, 0xcb, 0xb6, 0x5b, 0x8d, 0xc5, 0xff,
+	0xc5, 0x81, 0xfd, 0x0f, 0x99, 0x90, 0x78, 0xbb, 0x3e, 0xb2, 0x92, 0xf5, 0x9c, 0x26, 0x74, 0x92,
+	0xc3, 0x6c, 0xb5, 0x15, 0x5c, 0xab, 0x63, 0xed, 0x64, 0x8a, 0xdf, 0x00, 0x9b, 0xb6, 0x6a, 0xe6,
+	0xdb, 0xaa, 0xae, 0x6d, 0x5a, 0xf5, 0x6d, 0xe3, 0xff, 0xe4, 0xc0, 0xae, 0xa5, 0xf0, 0xf1, 0x12,
+	0x13, 0xb9, 0x8d, 0x3e, 0x4b, 0x53, 0x63, 0x1b, 0x4d, 0xcd, 0x22, 0x4d, 0x03, 0x68, 0x47, 0xb9,
+	0x26, 0x34, 0x8b, 0x4c, 0x56, 0xe5, 0x67, 0xaa, 0x08, 0xfa, 0xf7, 0xe0, 0xee, 0x75, 0x2c, 0xa4,
+	0xad, 0x4a, 0xd8, 0x47, 0xdd, 0x7f, 0x0f, 0x06, 0x45, 0xd8, 0xbc, 0xd6, 0xe4, 0x35, 0x68, 0xc7,
+	0x12, 0xe7, 0xc2, 0x75, 0xb4, 0x00, 0xfb, 0x95, 0x87, 0x38, 0x34, 0x76, 0xff, 0x12, 0xfa, 0x4f,
+	0x50, 0xfe, 0xe7, 0x51, 0xf1, 0x9b, 0x03, 0x87, 0x86, 0xb1, 0x31, 0x96, 0x26, 0x05, 0x39, 0x87,
+	0x1d, 0xeb, 0xaa, 0x77, 0xd6, 0x66, 0xcf, 0x3c, 0xc8, 0x25, 0xc0, 0xfa, 0xe6, 0xb2, 0xb1, 0x71,
+	0xcf, 0xf8, 0x97, 0x14, 0x12, 0xe6, 0x1c, 0xc9, 0x10, 0x3a, 0xa8, 0x6e, 0x46, 0xb8, 0x4d, 0xbd,
+	0x85, 0x14, 0x52, 0xe8, 0x4b, 0x0b, 0xad, 0xc7, 0xc5, 0x8f, 0x2d, 0xd8, 0xcb, 0xde, 0x2e, 0x33,
+	0x77, 0xc9, 0x0d, 0xec, 0x87, 0xc5, 0x39, 0x47, 0x2a, 0x63, 0xc7, 0xab, 0x20, 0xfe, 0xe1, 0x0f,
+	0x7f, 0xfc, 0xf9, 0x73, 0xa3, 0xef, 0xef, 0x06, 0xcb, 0x37, 0xb2, 0xb9, 0x2c, 0x1e, 0x39, 0x43,
+	0xf2, 0x2d, 0xf4, 0x2b, 0x93, 0x93, 0x3c, 0x30, 0x35, 0x6d, 0x1b, 0xc8, 0xde, 0x8b, 0x5b, 0xed,
+	0x76, 0xe4, 0xfa, 0x3a, 0xdd, 0xf1, 0xd0, 0xcb, 0xa7, 0x0b, 0xbe, 0xcb, 0xdd, 0xc3, 0xf7, 0xe4,
+	0x73, 0xd8, 0xcd, 0x0b, 0x80, 0x1c, 0x99, 0xa0, 0x35, 0x5a, 0xf1, 0xbc, 0x3a, 0x93, 0x4d, 0x35,
+	0xd0, 0xa9, 0xf6, 0x48, 0xe1, 0x64, 0xe4, 0x0b, 0x80, 0x8d, 0x38, 0xc8, 0xa1, 0xd9, 0x5f, 0x91,
+	0x8b, 0x57, 0xbd, 0xdf, 0xac, 0x74, 0xf2, 0x4f, 0xa5, 0x2f, 0x61, 0xbf, 0x24, 0xa1, 0xed, 0x29,
+	0xec, 0x97, 0xc4, 0x16, 0xc9, 0xf9, 0xe7, 0x3a, 0xdd, 0x2b, 0xe4, 0xe5, 0xed, 0xe9, 0x82, 0x5b,
+	0xbb, 0xf7, 0xfd, 0x87, 0x9f, 0x0d, 0x27, 0xb1, 0xfc, 0x6a, 0x31, 0x1e, 0x45, 0x6c, 0x1e, 0x4c,
+	0x31, 0x9e, 0xb2, 0x94, 0xb3, 0xaf, 0x03, 0xf3, 0xcc, 0xf0, 0x60, 0xfd, 0x1d, 0xa6, 0x52, 0x8e,
+	0x3b, 0xfa, 0xcb, 0xea, 0xcd, 0xbf, 0x07, 0x00, 0x38, 0x21, 0xd5, 0xdb, 0xc8, 0x09, 0x00, 0x00,
 }
 
 // Reference imports to suppress errors if they are not otherwise used.
@@ -136,6 +780,10 @@ const _ = grpc.SupportPackageIsVersion6
 type ClusterServiceClient interface {
 	RegisterCluster(ctx context.Context, in *cluster.Cluster, opts ...grpc.CallOption) (*cluster.Cluster, error)
 	UnregisterCluster(ctx context.Context, in *UnregisterClusterRequest, opts ...grpc.CallOption) (*UnregisterClusterResponse, error)
+	ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error)
+	GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*ClusterInfo, error)
+	//DescribeCluster returns the cluster along with the hosted namespaces and the recent events
+	DescribeCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error)
 }
 
 type clusterServiceClient struct {
@@ -164,10 +812,41 @@ func (c *clusterServiceClient) UnregisterCluster(ctx context.Context, in *Unregi
 	return out, nil
 }
 
+func (c *clusterServiceClient) ListClusters(ctx context.Context, in *ListClustersRequest, opts ...grpc.CallOption) (*ListClustersResponse, error) {
+	out := new(ListClustersResponse)
+	err := c.cc.Invoke(ctx, "/apis.ClusterService/ListClusters", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) GetCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*ClusterInfo, error) {
+	out := new(ClusterInfo)
+	err := c.cc.Invoke(ctx, "/apis.ClusterService/GetCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *clusterServiceClient) DescribeCluster(ctx context.Context, in *GetClusterRequest, opts ...grpc.CallOption) (*DescribeClusterResponse, error) {
+	out := new(DescribeClusterResponse)
+	err := c.cc.Invoke(ctx, "/apis.ClusterService/DescribeCluster", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
 // ClusterServiceServer is the server API for ClusterService service.
 type ClusterServiceServer interface {
 	RegisterCluster(context.Context, *cluster.Cluster) (*cluster.Cluster, error)
 	UnregisterCluster(context.Context, *UnregisterClusterRequest) (*UnregisterClusterResponse, error)
+	ListClusters(context.Context, *ListClustersRequest) (*ListClustersResponse, error)
+	GetCluster(context.Context, *GetClusterRequest) (*ClusterInfo, error)
+	//DescribeCluster returns the cluster along with the hosted namespaces and the recent events
+	DescribeCluster(context.Context, *GetClusterRequest) (*DescribeClusterResponse, error)
 }
 
 // UnimplementedClusterServiceServer can be embedded to have forward compatible implementations.
@@ -180,6 +859,15 @@ func (*UnimplementedClusterServiceServer) RegisterCluster(ctx context.Context, r
 func (*UnimplementedClusterServiceServer) UnregisterCluster(ctx context.Context, req *UnregisterClusterRequest) (*UnregisterClusterResponse, error) {
 	return nil, status.Errorf(codes.Unimplemented, "method UnregisterCluster not implemented")
 }
+func (*UnimplementedClusterServiceServer) ListClusters(ctx context.Context, req *ListClustersRequest) (*ListClustersResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method ListClusters not implemented")
+}
+func (*UnimplementedClusterServiceServer) GetCluster(ctx context.Context, req *GetClusterRequest) (*ClusterInfo, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method GetCluster not implemented")
+}
+func (*UnimplementedClusterServiceServer) DescribeCluster(ctx context.Context, req *GetClusterRequest) (*DescribeClusterResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method DescribeCluster not implemented")
+}
 
 func RegisterClusterServiceServer(s *grpc.Server, srv ClusterServiceServer) {
 	s.RegisterService(&_ClusterService_serviceDesc, srv)
@@ -221,6 +909,60 @@ func _ClusterService_UnregisterCluster_Handler(srv interface{}, ctx context.Cont
 	return interceptor(ctx, in, info, handler)
 }
 
+func _ClusterService_ListClusters_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListClustersRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).ListClusters(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ClusterService/ListClusters",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).ListClusters(ctx, req.(*ListClustersRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_GetCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).GetCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ClusterService/GetCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).GetCluster(ctx, req.(*GetClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _ClusterService_DescribeCluster_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetClusterRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(ClusterServiceServer).DescribeCluster(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.ClusterService/DescribeCluster",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(ClusterServiceServer).DescribeCluster(ctx, req.(*GetClusterRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
 var _ClusterService_serviceDesc = grpc.ServiceDesc{
 	ServiceName: "apis.ClusterService",
 	HandlerType: (*ClusterServiceServer)(nil),
@@ -233,6 +975,18 @@ var _ClusterService_serviceDesc = grpc.ServiceDesc{
 			MethodName: "UnregisterCluster",
 			Handler:    _ClusterService_UnregisterCluster_Handler,
 		},
+		{
+			MethodName: "ListClusters",
+			Handler:    _ClusterService_ListClusters_Handler,
+		},
+		{
+			MethodName: "GetCluster",
+			Handler:    _ClusterService_GetCluster_Handler,
+		},
+		{
+			MethodName: "DescribeCluster",
+			Handler:    _ClusterService_DescribeCluster_Handler,
+		},
 	},
 	Streams:  []grpc.StreamDesc{},
 	Metadata: "pkg/grpc/proto/apis/service.proto",
@@ -0,0 +1,783 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pkg/grpc/proto/apis/template_service.proto
+
+package apis
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	namespace "github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+// Template represents the NamespaceTemplate custom resource
+type Template struct {
+	//name of the namespace template
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//labels of the namespace template
+	Labels map[string]string `protobuf:"bytes,2,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//spec of the namespace template
+	Spec *namespace.NamespaceTemplate `protobuf:"bytes,3,opt,name=spec,proto3" json:"spec,omitempty"`
+	//resourceVersion must be provided to update only if the template is not modified in the meantime
+	ResourceVersion string `protobuf:"bytes,4,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	//creationTimestamp in RFC3339 format
+	CreationTimestamp    string   `protobuf:"bytes,5,opt,name=creationTimestamp,proto3" json:"creationTimestamp,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Template) Reset()         { *m = Template{} }
+func (m *Template) String() string { return proto.CompactTextString(m) }
+func (*Template) ProtoMessage()    {}
+func (*Template) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{0}
+}
+
+func (m *Template) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Template.Unmarshal(m, b)
+}
+func (m *Template) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Template.Marshal(b, m, deterministic)
+}
+func (m *Template) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Template.Merge(m, src)
+}
+func (m *Template) XXX_Size() int {
+	return xxx_messageInfo_Template.Size(m)
+}
+func (m *Template) XXX_DiscardUnknown() {
+	xxx_messageInfo_Template.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Template proto.InternalMessageInfo
+
+func (m *Template) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Template) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Template) GetSpec() *namespace.NamespaceTemplate {
+	if m != nil {
+		return m.Spec
+	}
+	return nil
+}
+
+func (m *Template) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *Template) GetCreationTimestamp() string {
+	if m != nil {
+		return m.CreationTimestamp
+	}
+	return ""
+}
+
+// CatalogEntry describes the template and its usage
+type CatalogEntry struct {
+	//name of the namespace template
+	Name        string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	//params contains all the exported params. Params without documentation in the template are included with name only
+	Params []*namespace.Param `protobuf:"bytes,3,rep,name=params,proto3" json:"params,omitempty"`
+	//namespaces contains the managed namespaces using this template
+	Namespaces           []string `protobuf:"bytes,4,rep,name=namespaces,proto3" json:"namespaces,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogEntry) Reset()         { *m = CatalogEntry{} }
+func (m *CatalogEntry) String() string { return proto.CompactTextString(m) }
+func (*CatalogEntry) ProtoMessage()    {}
+func (*CatalogEntry) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{1}
+}
+
+func (m *CatalogEntry) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogEntry.Unmarshal(m, b)
+}
+func (m *CatalogEntry) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogEntry.Marshal(b, m, deterministic)
+}
+func (m *CatalogEntry) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogEntry.Merge(m, src)
+}
+func (m *CatalogEntry) XXX_Size() int {
+	return xxx_messageInfo_CatalogEntry.Size(m)
+}
+func (m *CatalogEntry) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogEntry.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogEntry proto.InternalMessageInfo
+
+func (m *CatalogEntry) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *CatalogEntry) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *CatalogEntry) GetParams() []*namespace.Param {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+func (m *CatalogEntry) GetNamespaces() []string {
+	if m != nil {
+		return m.Namespaces
+	}
+	return nil
+}
+
+type GetTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *GetTemplateRequest) Reset()         { *m = GetTemplateRequest{} }
+func (m *GetTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*GetTemplateRequest) ProtoMessage()    {}
+func (*GetTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{2}
+}
+
+func (m *GetTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_GetTemplateRequest.Unmarshal(m, b)
+}
+func (m *GetTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_GetTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *GetTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_GetTemplateRequest.Merge(m, src)
+}
+func (m *GetTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_GetTemplateRequest.Size(m)
+}
+func (m *GetTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_GetTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_GetTemplateRequest proto.InternalMessageInfo
+
+func (m *GetTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type ListTemplatesRequest struct {
+	//pageSize is the maximum number of templates to be returned. Default is 100
+	PageSize int32 `protobuf:"varint,1,opt,name=pageSize,proto3" json:"pageSize,omitempty"`
+	//pageToken is the nextPageToken returned by the previous request
+	PageToken            string   `protobuf:"bytes,2,opt,name=pageToken,proto3" json:"pageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTemplatesRequest) Reset()         { *m = ListTemplatesRequest{} }
+func (m *ListTemplatesRequest) String() string { return proto.CompactTextString(m) }
+func (*ListTemplatesRequest) ProtoMessage()    {}
+func (*ListTemplatesRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{3}
+}
+
+func (m *ListTemplatesRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTemplatesRequest.Unmarshal(m, b)
+}
+func (m *ListTemplatesRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTemplatesRequest.Marshal(b, m, deterministic)
+}
+func (m *ListTemplatesRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTemplatesRequest.Merge(m, src)
+}
+func (m *ListTemplatesRequest) XXX_Size() int {
+	return xxx_messageInfo_ListTemplatesRequest.Size(m)
+}
+func (m *ListTemplatesRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTemplatesRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTemplatesRequest proto.InternalMessageInfo
+
+func (m *ListTemplatesRequest) GetPageSize() int32 {
+	if m != nil {
+		return m.PageSize
+	}
+	return 0
+}
+
+func (m *ListTemplatesRequest) GetPageToken() string {
+	if m != nil {
+		return m.PageToken
+	}
+	return ""
+}
+
+type ListTemplatesResponse struct {
+	Items []*Template `protobuf:"bytes,1,rep,name=items,proto3" json:"items,omitempty"`
+	//nextPageToken is empty if there are no more templates
+	NextPageToken        string   `protobuf:"bytes,2,opt,name=nextPageToken,proto3" json:"nextPageToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *ListTemplatesResponse) Reset()         { *m = ListTemplatesResponse{} }
+func (m *ListTemplatesResponse) String() string { return proto.CompactTextString(m) }
+func (*ListTemplatesResponse) ProtoMessage()    {}
+func (*ListTemplatesResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{4}
+}
+
+func (m *ListTemplatesResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_ListTemplatesResponse.Unmarshal(m, b)
+}
+func (m *ListTemplatesResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_ListTemplatesResponse.Marshal(b, m, deterministic)
+}
+func (m *ListTemplatesResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_ListTemplatesResponse.Merge(m, src)
+}
+func (m *ListTemplatesResponse) XXX_Size() int {
+	return xxx_messageInfo_ListTemplatesResponse.Size(m)
+}
+func (m *ListTemplatesResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_ListTemplatesResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_ListTemplatesResponse proto.InternalMessageInfo
+
+func (m *ListTemplatesResponse) GetItems() []*Template {
+	if m != nil {
+		return m.Items
+	}
+	return nil
+}
+
+func (m *ListTemplatesResponse) GetNextPageToken() string {
+	if m != nil {
+		return m.NextPageToken
+	}
+	return ""
+}
+
+type DeleteTemplateRequest struct {
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplateRequest) Reset()         { *m = DeleteTemplateRequest{} }
+func (m *DeleteTemplateRequest) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplateRequest) ProtoMessage()    {}
+func (*DeleteTemplateRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{5}
+}
+
+func (m *DeleteTemplateRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplateRequest.Unmarshal(m, b)
+}
+func (m *DeleteTemplateRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplateRequest.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplateRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplateRequest.Merge(m, src)
+}
+func (m *DeleteTemplateRequest) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplateRequest.Size(m)
+}
+func (m *DeleteTemplateRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplateRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplateRequest proto.InternalMessageInfo
+
+func (m *DeleteTemplateRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type DeleteTemplateResponse struct {
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *DeleteTemplateResponse) Reset()         { *m = DeleteTemplateResponse{} }
+func (m *DeleteTemplateResponse) String() string { return proto.CompactTextString(m) }
+func (*DeleteTemplateResponse) ProtoMessage()    {}
+func (*DeleteTemplateResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{6}
+}
+
+func (m *DeleteTemplateResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_DeleteTemplateResponse.Unmarshal(m, b)
+}
+func (m *DeleteTemplateResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_DeleteTemplateResponse.Marshal(b, m, deterministic)
+}
+func (m *DeleteTemplateResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_DeleteTemplateResponse.Merge(m, src)
+}
+func (m *DeleteTemplateResponse) XXX_Size() int {
+	return xxx_messageInfo_DeleteTemplateResponse.Size(m)
+}
+func (m *DeleteTemplateResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_DeleteTemplateResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_DeleteTemplateResponse proto.InternalMessageInfo
+
+type CatalogRequest struct {
+	//name of the template to be described. All the templates are returned if empty
+	Name                 string   `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *CatalogRequest) Reset()         { *m = CatalogRequest{} }
+func (m *CatalogRequest) String() string { return proto.CompactTextString(m) }
+func (*CatalogRequest) ProtoMessage()    {}
+func (*CatalogRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{7}
+}
+
+func (m *CatalogRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogRequest.Unmarshal(m, b)
+}
+func (m *CatalogRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogRequest.Marshal(b, m, deterministic)
+}
+func (m *CatalogRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogRequest.Merge(m, src)
+}
+func (m *CatalogRequest) XXX_Size() int {
+	return xxx_messageInfo_CatalogRequest.Size(m)
+}
+func (m *CatalogRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogRequest proto.InternalMessageInfo
+
+func (m *CatalogRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+type CatalogResponse struct {
+	Entries              []*CatalogEntry `protobuf:"bytes,1,rep,name=entries,proto3" json:"entries,omitempty"`
+	XXX_NoUnkeyedLiteral struct{}        `json:"-"`
+	XXX_unrecognized     []byte          `json:"-"`
+	XXX_sizecache        int32           `json:"-"`
+}
+
+func (m *CatalogResponse) Reset()         { *m = CatalogResponse{} }
+func (m *CatalogResponse) String() string { return proto.CompactTextString(m) }
+func (*CatalogResponse) ProtoMessage()    {}
+func (*CatalogResponse) Descriptor() ([]byte, []int) {
+	return fileDescriptor_0ba141fd2705dd39, []int{8}
+}
+
+func (m *CatalogResponse) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_CatalogResponse.Unmarshal(m, b)
+}
+func (m *CatalogResponse) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_CatalogResponse.Marshal(b, m, deterministic)
+}
+func (m *CatalogResponse) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_CatalogResponse.Merge(m, src)
+}
+func (m *CatalogResponse) XXX_Size() int {
+	return xxx_messageInfo_CatalogResponse.Size(m)
+}
+func (m *CatalogResponse) XXX_DiscardUnknown() {
+	xxx_messageInfo_CatalogResponse.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_CatalogResponse proto.InternalMessageInfo
+
+func (m *CatalogResponse) GetEntries() []*CatalogEntry {
+	if m != nil {
+		return m.Entries
+	}
+	return nil
+}
+
+func init() {
+	proto.RegisterType((*Template)(nil), "apis.Template")
+	proto.RegisterMapType((map[string]string)(nil), "apis.Template.LabelsEntry")
+	proto.RegisterType((*CatalogEntry)(nil), "apis.CatalogEntry")
+	proto.RegisterType((*GetTemplateRequest)(nil), "apis.GetTemplateRequest")
+	proto.RegisterType((*ListTemplatesRequest)(nil), "apis.ListTemplatesRequest")
+	proto.RegisterType((*ListTemplatesResponse)(nil), "apis.ListTemplatesResponse")
+	proto.RegisterType((*DeleteTemplateRequest)(nil), "apis.DeleteTemplateRequest")
+	proto.RegisterType((*DeleteTemplateResponse)(nil), "apis.DeleteTemplateResponse")
+	proto.RegisterType((*CatalogRequest)(nil), "apis.CatalogRequest")
+	proto.RegisterType((*CatalogResponse)(nil), "apis.CatalogResponse")
+}
+
+func init() {
+	proto.RegisterFile("pkg/grpc/proto/apis/template_service.proto", fileDescriptor_0ba141fd2705dd39)
+}
+
+var fileDescriptor_0ba141fd2705dd39 = []byte{
+	// 668 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x56, 0x3e, 0xdb, 0x4e, 0xde, 0x36, 0x7d, 0x47, 0x49, 0xe5, 0xd7, 0x6f, 0x04, 0x91, 0xa9,
+	0x44, 0x08, 0x55, 0x0c, 0xe1, 0x02, 0xbd, 0x20, 0xb5, 0xa0, 0x0a, 0xa9, 0xa0, 0xca, 0x2d, 0x3d,
+	0xf4, 0x82, 0xb6, 0xee, 0xc8, 0x98, 0xf8, 0x0b, 0xef, 0xa6, 0xa2, 0x20, 0x2e, 0xdc, 0xe1, 0xc2,
+	0x81, 0x1f, 0xc6, 0x5f, 0xe0, 0x87, 0xa0, 0x5d, 0xaf, 0x4d, 0xe2, 0x5a, 0xa8, 0xb7, 0xdd, 0x79,
+	0x9e, 0x79, 0x66, 0x9e, 0x99, 0xb5, 0x61, 0x9c, 0xcc, 0x3c, 0xdb, 0x4b, 0x13, 0xd7, 0x4e, 0xd2,
+	0x58, 0xc4, 0x36, 0x4b, 0x7c, 0x6e, 0x0b, 0x0a, 0x93, 0x80, 0x09, 0x7a, 0xc3, 0x29, 0xbd, 0xf4,
+	0x5d, 0x9a, 0x28, 0x0c, 0x9b, 0x12, 0x34, 0xef, 0x96, 0x32, 0x22, 0x16, 0x12, 0x4f, 0x98, 0x4b,
+	0x45, 0x5a, 0x46, 0x37, 0x07, 0x5e, 0x1c, 0x7b, 0x01, 0x49, 0x49, 0x9b, 0x45, 0x51, 0x2c, 0x98,
+	0xf0, 0xe3, 0x88, 0x67, 0xa8, 0xf5, 0xa3, 0x0e, 0xab, 0x27, 0x3a, 0x01, 0x11, 0x9a, 0x52, 0xc6,
+	0xa8, 0x0d, 0x6b, 0xa3, 0x35, 0x47, 0x9d, 0x71, 0x0a, 0xed, 0x80, 0x9d, 0x53, 0xc0, 0x8d, 0xfa,
+	0xb0, 0x31, 0xea, 0x4c, 0xcd, 0x89, 0x2c, 0x3f, 0xc9, 0x73, 0x26, 0x87, 0x0a, 0x7c, 0x1e, 0x89,
+	0xf4, 0xca, 0xd1, 0x4c, 0x7c, 0x00, 0x4d, 0x9e, 0x90, 0x6b, 0x34, 0x86, 0xb5, 0x51, 0x67, 0x3a,
+	0x98, 0x14, 0xbd, 0x4d, 0x5e, 0xe5, 0xa7, 0x3c, 0xdf, 0x51, 0x4c, 0x1c, 0x41, 0x37, 0x25, 0x1e,
+	0xcf, 0x53, 0x97, 0x4e, 0x29, 0xe5, 0x7e, 0x1c, 0x19, 0x4d, 0xd5, 0x44, 0x39, 0x8c, 0x3b, 0xf0,
+	0xaf, 0x9b, 0x92, 0xf2, 0x70, 0xe2, 0x87, 0xc4, 0x05, 0x0b, 0x13, 0xa3, 0xa5, 0xb8, 0xd7, 0x01,
+	0xf3, 0x09, 0x74, 0x16, 0x1a, 0xc4, 0x4d, 0x68, 0xcc, 0xe8, 0x4a, 0xfb, 0x93, 0x47, 0xec, 0x41,
+	0xeb, 0x92, 0x05, 0x73, 0x32, 0xea, 0x2a, 0x96, 0x5d, 0x76, 0xeb, 0x8f, 0x6b, 0xd6, 0xb7, 0x1a,
+	0xfc, 0xb3, 0xcf, 0x04, 0x0b, 0x62, 0x2f, 0x4b, 0xae, 0x9a, 0xce, 0x10, 0x3a, 0x17, 0xc4, 0xdd,
+	0xd4, 0x4f, 0x64, 0x5d, 0x2d, 0xb2, 0x18, 0xc2, 0x11, 0xb4, 0x13, 0x96, 0xb2, 0x90, 0x1b, 0x0d,
+	0x35, 0xbf, 0xcd, 0x85, 0x69, 0x1c, 0x49, 0xc0, 0xd1, 0x38, 0xde, 0x02, 0x28, 0x20, 0x6e, 0x34,
+	0x87, 0x8d, 0xd1, 0x9a, 0xb3, 0x10, 0xb1, 0x46, 0x80, 0x07, 0x24, 0x8a, 0xc1, 0xd1, 0xfb, 0x39,
+	0x71, 0x51, 0xd5, 0x95, 0x75, 0x04, 0xbd, 0x43, 0x9f, 0x17, 0x54, 0x9e, 0x73, 0x4d, 0x58, 0x4d,
+	0x98, 0x47, 0xc7, 0xfe, 0xc7, 0x8c, 0xdf, 0x72, 0x8a, 0x3b, 0x0e, 0x60, 0x4d, 0x9e, 0x4f, 0xe2,
+	0x19, 0xe5, 0x3e, 0xfe, 0x04, 0x2c, 0x17, 0xfa, 0x25, 0x45, 0x9e, 0xc4, 0x11, 0x27, 0xdc, 0x86,
+	0x96, 0x2f, 0x28, 0xe4, 0x46, 0x4d, 0xb9, 0xdb, 0x58, 0x7e, 0x1d, 0x4e, 0x06, 0xe2, 0x36, 0xac,
+	0x47, 0xf4, 0x41, 0x1c, 0x95, 0x0a, 0x2c, 0x07, 0xad, 0xfb, 0xd0, 0x7f, 0x46, 0x01, 0x09, 0xba,
+	0x89, 0x47, 0x03, 0xb6, 0xca, 0xe4, 0xac, 0x25, 0x6b, 0x1b, 0x36, 0xf4, 0xde, 0xfe, 0x96, 0xff,
+	0x14, 0xba, 0x05, 0x4b, 0x7b, 0xd9, 0x81, 0x15, 0x8a, 0x44, 0xea, 0x53, 0xee, 0x06, 0x33, 0x37,
+	0x8b, 0xaf, 0xc0, 0xc9, 0x29, 0xd3, 0xaf, 0x4d, 0xe8, 0xe6, 0xb5, 0x8f, 0xb3, 0x0f, 0x14, 0xf7,
+	0xa0, 0xbd, 0x2f, 0xdf, 0x20, 0x61, 0x69, 0x10, 0x66, 0xe9, 0x6e, 0x19, 0x5f, 0x7e, 0xfe, 0xfa,
+	0x5e, 0x47, 0x6b, 0xdd, 0xbe, 0x7c, 0x58, 0x7c, 0xb1, 0x7c, 0xb7, 0x36, 0xc6, 0x97, 0xd0, 0x38,
+	0x20, 0x81, 0x46, 0x96, 0x70, 0x7d, 0xe3, 0xd7, 0xa4, 0x06, 0x4a, 0x6a, 0x0b, 0x7b, 0x4b, 0x52,
+	0xf6, 0x27, 0x69, 0xf3, 0x33, 0x9e, 0x42, 0x53, 0x6e, 0x0e, 0xf5, 0x77, 0x5b, 0xf5, 0x2e, 0xcc,
+	0xff, 0x2b, 0x31, 0x3d, 0xce, 0xbe, 0x92, 0xef, 0xe2, 0x72, 0xa7, 0xf8, 0x02, 0xda, 0xaf, 0x93,
+	0x8b, 0x9b, 0x58, 0xbd, 0xad, 0x04, 0xfe, 0x33, 0x2b, 0xfb, 0x93, 0x8e, 0x19, 0xb4, 0xb3, 0x55,
+	0xa2, 0x6e, 0xa4, 0xf2, 0x15, 0x98, 0x83, 0x6a, 0x50, 0xb7, 0xa9, 0xa7, 0x30, 0xae, 0x9e, 0x02,
+	0x83, 0x15, 0xbd, 0x45, 0xec, 0x2d, 0x2d, 0x35, 0x17, 0xef, 0x97, 0xa2, 0x5a, 0xf5, 0x9e, 0x52,
+	0xbd, 0x83, 0x1d, 0xa9, 0xea, 0x66, 0xe0, 0x59, 0x0f, 0x71, 0xe1, 0xaa, 0x4b, 0xec, 0xed, 0x9c,
+	0x8d, 0x3d, 0x5f, 0xbc, 0x9d, 0x9f, 0x4f, 0xdc, 0x38, 0xb4, 0x67, 0xe4, 0xcf, 0xe2, 0x24, 0x8d,
+	0xdf, 0xd9, 0x21, 0x8b, 0x98, 0x47, 0xa9, 0x5d, 0xfc, 0xaf, 0x65, 0xa1, 0xf3, 0xb6, 0xfa, 0xfd,
+	0x3e, 0xfa, 0x3d, 0x00, 0x8f, 0x1e, 0xd6, 0x7f, 0xf9, 0x05, 0x00, 0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// TemplateServiceClient is the client API for TemplateService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type TemplateServiceClient interface {
+	Create(ctx context.Context, in *Template, opts ...grpc.CallOption) (*Template, error)
+	Get(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*Template, error)
+	List(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error)
+	Update(ctx context.Context, in *Template, opts ...grpc.CallOption) (*Template, error)
+	//Delete fails if the template is still used by the managed namespaces
+	Delete(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error)
+	//Catalog returns the description, param documentation and the usage of the templates
+	Catalog(ctx context.Context, in *CatalogRequest, opts ...grpc.CallOption) (*CatalogResponse, error)
+}
+
+type templateServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewTemplateServiceClient(cc grpc.ClientConnInterface) TemplateServiceClient {
+	return &templateServiceClient{cc}
+}
+
+func (c *templateServiceClient) Create(ctx context.Context, in *Template, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/Create", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) Get(ctx context.Context, in *GetTemplateRequest, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/Get", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) List(ctx context.Context, in *ListTemplatesRequest, opts ...grpc.CallOption) (*ListTemplatesResponse, error) {
+	out := new(ListTemplatesResponse)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/List", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) Update(ctx context.Context, in *Template, opts ...grpc.CallOption) (*Template, error) {
+	out := new(Template)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/Update", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) Delete(ctx context.Context, in *DeleteTemplateRequest, opts ...grpc.CallOption) (*DeleteTemplateResponse, error) {
+	out := new(DeleteTemplateResponse)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/Delete", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *templateServiceClient) Catalog(ctx context.Context, in *CatalogRequest, opts ...grpc.CallOption) (*CatalogResponse, error) {
+	out := new(CatalogResponse)
+	err := c.cc.Invoke(ctx, "/apis.TemplateService/Catalog", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// TemplateServiceServer is the server API for TemplateService service.
+type TemplateServiceServer interface {
+	Create(context.Context, *Template) (*Template, error)
+	Get(context.Context, *GetTemplateRequest) (*Template, error)
+	List(context.Context, *ListTemplatesRequest) (*ListTemplatesResponse, error)
+	Update(context.Context, *Template) (*Template, error)
+	//Delete fails if the template is still used by the managed namespaces
+	Delete(context.Context, *DeleteTemplateRequest) (*DeleteTemplateResponse, error)
+	//Catalog returns the description, param documentation and the usage of the templates
+	Catalog(context.Context, *CatalogRequest) (*CatalogResponse, error)
+}
+
+// UnimplementedTemplateServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedTemplateServiceServer struct {
+}
+
+func (*UnimplementedTemplateServiceServer) Create(ctx context.Context, req *Template) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Create not implemented")
+}
+func (*UnimplementedTemplateServiceServer) Get(ctx context.Context, req *GetTemplateRequest) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Get not implemented")
+}
+func (*UnimplementedTemplateServiceServer) List(ctx context.Context, req *ListTemplatesRequest) (*ListTemplatesResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method List not implemented")
+}
+func (*UnimplementedTemplateServiceServer) Update(ctx context.Context, req *Template) (*Template, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Update not implemented")
+}
+func (*UnimplementedTemplateServiceServer) Delete(ctx context.Context, req *DeleteTemplateRequest) (*DeleteTemplateResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Delete not implemented")
+}
+func (*UnimplementedTemplateServiceServer) Catalog(ctx context.Context, req *CatalogRequest) (*CatalogResponse, error) {
+	return nil, status.Errorf(codes.Unimplemented, "method Catalog not implemented")
+}
+
+func RegisterTemplateServiceServer(s *grpc.Server, srv TemplateServiceServer) {
+	s.RegisterService(&_TemplateService_serviceDesc, srv)
+}
+
+func _TemplateService_Create_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Template)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).Create(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/Create",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).Create(ctx, req.(*Template))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_Get_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(GetTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).Get(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/Get",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).Get(ctx, req.(*GetTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_List_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(ListTemplatesRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).List(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/List",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).List(ctx, req.(*ListTemplatesRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_Update_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(Template)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).Update(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/Update",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).Update(ctx, req.(*Template))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_Delete_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(DeleteTemplateRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).Delete(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/Delete",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).Delete(ctx, req.(*DeleteTemplateRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+func _TemplateService_Catalog_Handler(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+	in := new(CatalogRequest)
+	if err := dec(in); err != nil {
+		return nil, err
+	}
+	if interceptor == nil {
+		return srv.(TemplateServiceServer).Catalog(ctx, in)
+	}
+	info := &grpc.UnaryServerInfo{
+		Server:     srv,
+		FullMethod: "/apis.TemplateService/Catalog",
+	}
+	handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+		return srv.(TemplateServiceServer).Catalog(ctx, req.(*CatalogRequest))
+	}
+	return interceptor(ctx, in, info, handler)
+}
+
+var _TemplateService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apis.TemplateService",
+	HandlerType: (*TemplateServiceServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Create",
+			Handler:    _TemplateService_Create_Handler,
+		},
+		{
+			MethodName: "Get",
+			Handler:    _TemplateService_Get_Handler,
+		},
+		{
+			MethodName: "List",
+			Handler:    _TemplateService_List_Handler,
+		},
+		{
+			MethodName: "Update",
+			Handler:    _TemplateService_Update_Handler,
+		},
+		{
+			MethodName: "Delete",
+			Handler:    _TemplateService_Delete_Handler,
+		},
+		{
+			MethodName: "Catalog",
+			Handler:    _TemplateService_Catalog_Handler,
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "pkg/grpc/proto/apis/template_service.proto",
+}
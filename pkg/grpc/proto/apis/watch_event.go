@@ -0,0 +1,36 @@
+package apis
+
+import "encoding/json"
+
+//MarshalJSON flattens the object oneof so the json field names are the same as in the proto (cluster, managedNamespace or application)
+func (m *WatchEvent) MarshalJSON() ([]byte, error) {
+	type event WatchEvent
+	e := event(*m)
+	e.Object = nil
+	b, err := json.Marshal(&e)
+	if err != nil {
+		return nil, err
+	}
+	fields := make(map[string]json.RawMessage)
+	if err := json.Unmarshal(b, &fields); err != nil {
+		return nil, err
+	}
+	delete(fields, "Object")
+
+	var key string
+	var object interface{}
+	switch o := m.Object.(type) {
+	case *WatchEvent_Cluster:
+		key, object = "cluster", o.Cluster
+	case *WatchEvent_ManagedNamespace:
+		key, object = "managedNamespace", o.ManagedNamespace
+	case *WatchEvent_Application:
+		key, object = "application", o.Application
+	}
+	if key != "" {
+		if fields[key], err = json.Marshal(object); err != nil {
+			return nil, err
+		}
+	}
+	return json.Marshal(fields)
+}
@@ -0,0 +1,418 @@
+// Code generated by protoc-gen-go. DO NOT EDIT.
+// source: pkg/grpc/proto/apis/watch_service.proto
+
+package apis
+
+import (
+	context "context"
+	fmt "fmt"
+	proto "github.com/golang/protobuf/proto"
+	_ "google.golang.org/genproto/googleapis/api/annotations"
+	grpc "google.golang.org/grpc"
+	codes "google.golang.org/grpc/codes"
+	status "google.golang.org/grpc/status"
+	math "math"
+)
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ = proto.Marshal
+var _ = fmt.Errorf
+var _ = math.Inf
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the proto package it is being compiled against.
+// A compilation error at this line likely means your copy of the
+// proto package needs to be updated.
+const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
+
+type WatchRequest struct {
+	//kinds to be watched. Allowed values are Cluster, ManagedNamespace and Application. All the kinds are watched if empty
+	Kinds []string `protobuf:"bytes,1,rep,name=kinds,proto3" json:"kinds,omitempty"`
+	//name of the resource to be watched. All the resources are watched if empty
+	Name string `protobuf:"bytes,2,opt,name=name,proto3" json:"name,omitempty"`
+	//labelSelector filters the resources by labels. ex: env=prod,team in (a,b)
+	LabelSelector string `protobuf:"bytes,3,opt,name=labelSelector,proto3" json:"labelSelector,omitempty"`
+	//resourceVersion of the last event received. Watch resumes after this event
+	//If empty, current state of all the matching resources is sent as ADDED events first
+	ResourceVersion      string   `protobuf:"bytes,4,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *WatchRequest) Reset()         { *m = WatchRequest{} }
+func (m *WatchRequest) String() string { return proto.CompactTextString(m) }
+func (*WatchRequest) ProtoMessage()    {}
+func (*WatchRequest) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6252e66abf3108c5, []int{0}
+}
+
+func (m *WatchRequest) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchRequest.Unmarshal(m, b)
+}
+func (m *WatchRequest) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchRequest.Marshal(b, m, deterministic)
+}
+func (m *WatchRequest) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchRequest.Merge(m, src)
+}
+func (m *WatchRequest) XXX_Size() int {
+	return xxx_messageInfo_WatchRequest.Size(m)
+}
+func (m *WatchRequest) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchRequest.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchRequest proto.InternalMessageInfo
+
+func (m *WatchRequest) GetKinds() []string {
+	if m != nil {
+		return m.Kinds
+	}
+	return nil
+}
+
+func (m *WatchRequest) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetLabelSelector() string {
+	if m != nil {
+		return m.LabelSelector
+	}
+	return ""
+}
+
+func (m *WatchRequest) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+type WatchEvent struct {
+	//type of the event. One of ADDED, MODIFIED or DELETED
+	Type string `protobuf:"bytes,1,opt,name=type,proto3" json:"type,omitempty"`
+	//kind of the resource. One of Cluster, ManagedNamespace or Application
+	Kind string `protobuf:"bytes,2,opt,name=kind,proto3" json:"kind,omitempty"`
+	Name string `protobuf:"bytes,3,opt,name=name,proto3" json:"name,omitempty"`
+	//resourceVersion of the resource. Can be used to resume the watch
+	ResourceVersion string            `protobuf:"bytes,4,opt,name=resourceVersion,proto3" json:"resourceVersion,omitempty"`
+	Labels          map[string]string `protobuf:"bytes,5,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//state of the resource
+	State string `protobuf:"bytes,6,opt,name=state,proto3" json:"state,omitempty"`
+	//previousState is the state of the resource in the previous event. Different from state in case of a status transition
+	PreviousState    string `protobuf:"bytes,7,opt,name=previousState,proto3" json:"previousState,omitempty"`
+	ErrorDescription string `protobuf:"bytes,8,opt,name=errorDescription,proto3" json:"errorDescription,omitempty"`
+	// Types that are valid to be assigned to Object:
+	//	*WatchEvent_Cluster
+	//	*WatchEvent_ManagedNamespace
+	//	*WatchEvent_Application
+	Object               isWatchEvent_Object `protobuf_oneof:"object"`
+	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
+	XXX_unrecognized     []byte              `json:"-"`
+	XXX_sizecache        int32               `json:"-"`
+}
+
+func (m *WatchEvent) Reset()         { *m = WatchEvent{} }
+func (m *WatchEvent) String() string { return proto.CompactTextString(m) }
+func (*WatchEvent) ProtoMessage()    {}
+func (*WatchEvent) Descriptor() ([]byte, []int) {
+	return fileDescriptor_6252e66abf3108c5, []int{1}
+}
+
+func (m *WatchEvent) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_WatchEvent.Unmarshal(m, b)
+}
+func (m *WatchEvent) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_WatchEvent.Marshal(b, m, deterministic)
+}
+func (m *WatchEvent) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_WatchEvent.Merge(m, src)
+}
+func (m *WatchEvent) XXX_Size() int {
+	return xxx_messageInfo_WatchEvent.Size(m)
+}
+func (m *WatchEvent) XXX_DiscardUnknown() {
+	xxx_messageInfo_WatchEvent.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_WatchEvent proto.InternalMessageInfo
+
+func (m *WatchEvent) GetType() string {
+	if m != nil {
+		return m.Type
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetKind() string {
+	if m != nil {
+		return m.Kind
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetResourceVersion() string {
+	if m != nil {
+		return m.ResourceVersion
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetState() string {
+	if m != nil {
+		return m.State
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetPreviousState() string {
+	if m != nil {
+		return m.PreviousState
+	}
+	return ""
+}
+
+func (m *WatchEvent) GetErrorDescription() string {
+	if m != nil {
+		return m.ErrorDescription
+	}
+	return ""
+}
+
+type isWatchEvent_Object interface {
+	isWatchEvent_Object()
+}
+
+type WatchEvent_Cluster struct {
+	Cluster *ClusterInfo `protobuf:"bytes,10,opt,name=cluster,proto3,oneof"`
+}
+
+type WatchEvent_ManagedNamespace struct {
+	ManagedNamespace *ManagedNamespace `protobuf:"bytes,11,opt,name=managedNamespace,proto3,oneof"`
+}
+
+type WatchEvent_Application struct {
+	Application *Application `protobuf:"bytes,12,opt,name=application,proto3,oneof"`
+}
+
+func (*WatchEvent_Cluster) isWatchEvent_Object() {}
+
+func (*WatchEvent_ManagedNamespace) isWatchEvent_Object() {}
+
+func (*WatchEvent_Application) isWatchEvent_Object() {}
+
+func (m *WatchEvent) GetObject() isWatchEvent_Object {
+	if m != nil {
+		return m.Object
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetCluster() *ClusterInfo {
+	if x, ok := m.GetObject().(*WatchEvent_Cluster); ok {
+		return x.Cluster
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetManagedNamespace() *ManagedNamespace {
+	if x, ok := m.GetObject().(*WatchEvent_ManagedNamespace); ok {
+		return x.ManagedNamespace
+	}
+	return nil
+}
+
+func (m *WatchEvent) GetApplication() *Application {
+	if x, ok := m.GetObject().(*WatchEvent_Application); ok {
+		return x.Application
+	}
+	return nil
+}
+
+// XXX_OneofWrappers is for the internal use of the proto package.
+func (*WatchEvent) XXX_OneofWrappers() []interface{} {
+	return []interface{}{
+		(*WatchEvent_Cluster)(nil),
+		(*WatchEvent_ManagedNamespace)(nil),
+		(*WatchEvent_Application)(nil),
+	}
+}
+
+func init() {
+	proto.RegisterType((*WatchRequest)(nil), "apis.WatchRequest")
+	proto.RegisterType((*WatchEvent)(nil), "apis.WatchEvent")
+	proto.RegisterMapType((map[string]string)(nil), "apis.WatchEvent.LabelsEntry")
+}
+
+func init() {
+	proto.RegisterFile("pkg/grpc/proto/apis/watch_service.proto", fileDescriptor_6252e66abf3108c5)
+}
+
+var fileDescriptor_6252e66abf3108c5 = []byte{
+	// 513 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x8c, 0x53, 0x4d, 0x6f, 0x13, 0x31,
+	0x10, 0xcd, 0xe6, 0xab, 0x8d, 0xb7, 0x88, 0xd4, 0x42, 0xc8, 0x8a, 0x7a, 0x08, 0x11, 0x12, 0x51,
+	0xa1, 0x59, 0x08, 0x20, 0x01, 0x37, 0x42, 0x2b, 0x05, 0x09, 0x38, 0x6c, 0x24, 0x90, 0xb8, 0x20,
+	0xc7, 0x1d, 0xb6, 0x6e, 0x36, 0xb6, 0xb1, 0xbd, 0x41, 0x39, 0xc2, 0x89, 0x3b, 0x3f, 0x8d, 0xbf,
+	0xc0, 0x0f, 0x41, 0xb6, 0x93, 0x76, 0x93, 0xe6, 0xc0, 0x6d, 0x66, 0xde, 0xb3, 0xe7, 0x79, 0xe6,
+	0x19, 0x3d, 0x50, 0xb3, 0x2c, 0xc9, 0xb4, 0x62, 0x89, 0xd2, 0xd2, 0xca, 0x84, 0x2a, 0x6e, 0x92,
+	0xef, 0xd4, 0xb2, 0x8b, 0x2f, 0x06, 0xf4, 0x82, 0x33, 0x18, 0x78, 0x00, 0xd7, 0x1d, 0xd2, 0xb9,
+	0xb7, 0x8b, 0xbe, 0x41, 0xec, 0x3c, 0xdc, 0x45, 0x11, 0x74, 0x0e, 0x46, 0x51, 0x06, 0x9b, 0xb7,
+	0x76, 0x4e, 0x76, 0x91, 0xa9, 0x52, 0x39, 0x67, 0xd4, 0x72, 0x29, 0xb6, 0xe8, 0x47, 0x99, 0x94,
+	0x59, 0x0e, 0x8e, 0x96, 0x50, 0x21, 0xa4, 0xf5, 0x24, 0x13, 0xd0, 0xde, 0xaf, 0x08, 0x1d, 0x7c,
+	0x72, 0xd2, 0x53, 0xf8, 0x56, 0x80, 0xb1, 0xf8, 0x0e, 0x6a, 0xcc, 0xb8, 0x38, 0x37, 0x24, 0xea,
+	0xd6, 0xfa, 0xad, 0x34, 0x24, 0x18, 0xa3, 0xba, 0x93, 0x43, 0xaa, 0xdd, 0xa8, 0xdf, 0x4a, 0x7d,
+	0x8c, 0xef, 0xa3, 0x5b, 0x39, 0x9d, 0x42, 0x3e, 0x81, 0x1c, 0x98, 0x95, 0x9a, 0xd4, 0x3c, 0xb8,
+	0x59, 0xc4, 0x7d, 0x74, 0x5b, 0x83, 0x91, 0x85, 0x66, 0xf0, 0x11, 0xb4, 0xe1, 0x52, 0x90, 0xba,
+	0xe7, 0x6d, 0x97, 0x7b, 0x3f, 0xea, 0x08, 0x79, 0x29, 0x67, 0x0b, 0x10, 0xd6, 0xb5, 0xb4, 0x4b,
+	0x05, 0x24, 0x0a, 0x2d, 0x5d, 0xec, 0x6a, 0x4e, 0xcf, 0x5a, 0x86, 0x8b, 0xaf, 0xa4, 0xd5, 0x4a,
+	0xd2, 0xfe, 0xbb, 0x29, 0x7e, 0x86, 0x9a, 0x5e, 0xaf, 0x21, 0x8d, 0x6e, 0xad, 0x1f, 0x0f, 0x8f,
+	0x06, 0x6e, 0x9c, 0x83, 0x6b, 0x1d, 0x83, 0x77, 0x1e, 0x3e, 0x13, 0x56, 0x2f, 0xd3, 0x15, 0xd7,
+	0x0d, 0xc9, 0x58, 0x6a, 0x81, 0x34, 0xfd, 0xad, 0x21, 0x71, 0x03, 0x51, 0x1a, 0x16, 0x5c, 0x16,
+	0x66, 0xe2, 0xd1, 0xbd, 0x30, 0x90, 0x8d, 0x22, 0x3e, 0x46, 0x6d, 0xd0, 0x5a, 0xea, 0x53, 0x30,
+	0x4c, 0x73, 0xe5, 0x96, 0x41, 0xf6, 0x3d, 0xf1, 0x46, 0x1d, 0x9f, 0xa0, 0x3d, 0x96, 0x17, 0xc6,
+	0x82, 0x26, 0xa8, 0x1b, 0xf5, 0xe3, 0xe1, 0x61, 0x90, 0xf7, 0x26, 0x14, 0xdf, 0x8a, 0xaf, 0x72,
+	0x5c, 0x49, 0xd7, 0x1c, 0x7c, 0x8a, 0xda, 0x73, 0x2a, 0x68, 0x06, 0xe7, 0x1f, 0xd6, 0xde, 0x21,
+	0xb1, 0x3f, 0x77, 0x37, 0x9c, 0x7b, 0xbf, 0x85, 0x8e, 0x2b, 0xe9, 0x8d, 0x13, 0xf8, 0x39, 0x8a,
+	0x4b, 0x6e, 0x22, 0x07, 0xe5, 0xc6, 0xaf, 0xaf, 0x81, 0x71, 0x25, 0x2d, 0xf3, 0x3a, 0x2f, 0x51,
+	0x5c, 0x1a, 0x15, 0x6e, 0xa3, 0xda, 0x0c, 0x96, 0xab, 0xed, 0xb9, 0xd0, 0x0d, 0x6d, 0x41, 0xf3,
+	0x62, 0x6d, 0xa2, 0x90, 0xbc, 0xaa, 0xbe, 0x88, 0x46, 0xfb, 0xa8, 0x29, 0xa7, 0x97, 0xc0, 0xec,
+	0x30, 0x5d, 0xb9, 0x71, 0x12, 0x2c, 0x8c, 0x47, 0xa8, 0xe1, 0x73, 0x8c, 0x4b, 0x7b, 0x59, 0x59,
+	0xb5, 0xd3, 0xde, 0xde, 0x55, 0xef, 0xf0, 0xe7, 0x9f, 0xbf, 0xbf, 0xab, 0x31, 0x6e, 0x25, 0x8b,
+	0x27, 0xe1, 0x47, 0x3e, 0x8e, 0x46, 0x8f, 0x3e, 0x1f, 0x67, 0xdc, 0x5e, 0x14, 0xd3, 0x01, 0x93,
+	0xf3, 0x64, 0x06, 0x7c, 0x26, 0x95, 0x96, 0x97, 0x49, 0x78, 0xb8, 0x4e, 0xae, 0xbe, 0x93, 0xbb,
+	0x6d, 0xda, 0xf4, 0xff, 0xe2, 0xe9, 0xbf, 0x01, 0x00, 0x3e, 0xa7, 0xb1, 0x49, 0xe5, 0x03, 0x00,
+	0x00,
+}
+
+// Reference imports to suppress errors if they are not otherwise used.
+var _ context.Context
+var _ grpc.ClientConnInterface
+
+// This is a compile-time assertion to ensure that this generated file
+// is compatible with the grpc package it is being compiled against.
+const _ = grpc.SupportPackageIsVersion6
+
+// WatchServiceClient is the client API for WatchService service.
+//
+// For semantics around ctx use and closing/ending streaming RPCs, please refer to https://godoc.org/google.golang.org/grpc#ClientConn.NewStream.
+type WatchServiceClient interface {
+	//Watch streams the events until the client cancels the request
+	//Watch fails with OUT_OF_RANGE if the resourceVersion is too old to resume and with RESOURCE_EXHAUSTED if the client is too slow
+	//In both the cases, client should watch again without resourceVersion
+	Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (WatchService_WatchClient, error)
+}
+
+type watchServiceClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewWatchServiceClient(cc grpc.ClientConnInterface) WatchServiceClient {
+	return &watchServiceClient{cc}
+}
+
+func (c *watchServiceClient) Watch(ctx context.Context, in *WatchRequest, opts ...grpc.CallOption) (WatchService_WatchClient, error) {
+	stream, err := c.cc.NewStream(ctx, &_WatchService_serviceDesc.Streams[0], "/apis.WatchService/Watch", opts...)
+	if err != nil {
+		return nil, err
+	}
+	x := &watchServiceWatchClient{stream}
+	if err := x.ClientStream.SendMsg(in); err != nil {
+		return nil, err
+	}
+	if err := x.ClientStream.CloseSend(); err != nil {
+		return nil, err
+	}
+	return x, nil
+}
+
+type WatchService_WatchClient interface {
+	Recv() (*WatchEvent, error)
+	grpc.ClientStream
+}
+
+type watchServiceWatchClient struct {
+	grpc.ClientStream
+}
+
+func (x *watchServiceWatchClient) Recv() (*WatchEvent, error) {
+	m := new(WatchEvent)
+	if err := x.ClientStream.RecvMsg(m); err != nil {
+		return nil, err
+	}
+	return m, nil
+}
+
+// WatchServiceServer is the server API for WatchService service.
+type WatchServiceServer interface {
+	//Watch streams the events until the client cancels the request
+	//Watch fails with OUT_OF_RANGE if the resourceVersion is too old to resume and with RESOURCE_EXHAUSTED if the client is too slow
+	//In both the cases, client should watch again without resourceVersion
+	Watch(*WatchRequest, WatchService_WatchServer) error
+}
+
+// UnimplementedWatchServiceServer can be embedded to have forward compatible implementations.
+type UnimplementedWatchServiceServer struct {
+}
+
+func (*UnimplementedWatchServiceServer) Watch(req *WatchRequest, srv WatchService_WatchServer) error {
+	return status.Errorf(codes.Unimplemented, "method Watch not implemented")
+}
+
+func RegisterWatchServiceServer(s *grpc.Server, srv WatchServiceServer) {
+	s.RegisterService(&_WatchService_serviceDesc, srv)
+}
+
+func _WatchService_Watch_Handler(srv interface{}, stream grpc.ServerStream) error {
+	m := new(WatchRequest)
+	if err := stream.RecvMsg(m); err != nil {
+		return err
+	}
+	return srv.(WatchServiceServer).Watch(m, &watchServiceWatchServer{stream})
+}
+
+type WatchService_WatchServer interface {
+	Send(*WatchEvent) error
+	grpc.ServerStream
+}
+
+type watchServiceWatchServer struct {
+	grpc.ServerStream
+}
+
+func (x *watchServiceWatchServer) Send(m *WatchEvent) error {
+	return x.ServerStream.SendMsg(m)
+}
+
+var _WatchService_serviceDesc = grpc.ServiceDesc{
+	ServiceName: "apis.WatchService",
+	HandlerType: (*WatchServiceServer)(nil),
+	Methods:     []grpc.MethodDesc{},
+	Streams: []grpc.StreamDesc{
+		{
+			StreamName:    "Watch",
+			Handler:       _WatchService_Watch_Handler,
+			ServerStreams: true,
+		},
+	},
+	Metadata: "pkg/grpc/proto/apis/watch_service.proto",
+}
@@ -37,6 +37,19 @@ type Application struct {
 	//If the same entry is provided in namespace params too then it will be overwritten by namespace param value
 	// +optional
 	AppParams map[string]string `protobuf:"bytes,2,rep,name=appParams,proto3" json:"appParams,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//prunePolicy decides what happens to the managed namespace of the environment removed from the application
+	//Prune deletes the managed namespace and Retain leaves it without the application as the owner. Default is Prune
+	// +kubebuilder:validation:Enum=Prune;Retain
+	// +optional
+	PrunePolicy string `protobuf:"bytes,3,opt,name=prunePolicy,proto3" json:"prunePolicy,omitempty"`
+	//team owning the application. It can be used in the namespace naming pattern
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9-]*$
+	// +optional
+	Team string `protobuf:"bytes,4,opt,name=team,proto3" json:"team,omitempty"`
+	//rollout decides how the changes are rolled out to the environments
+	// +optional
+	Rollout *Rollout `protobuf:"bytes,5,opt,name=rollout,proto3" json:"rollout,omitempty"`
 	//List of environments to be created for this application
 	// +kubebuilder:validation:MinItems=1
 	Environments         []*Environment `protobuf:"bytes,11,rep,name=environments,proto3" json:"environments,omitempty"`
@@ -84,6 +97,27 @@ func (m *Application) GetAppParams() map[string]string {
 	return nil
 }
 
+func (m *Application) GetPrunePolicy() string {
+	if m != nil {
+		return m.PrunePolicy
+	}
+	return ""
+}
+
+func (m *Application) GetTeam() string {
+	if m != nil {
+		return m.Team
+	}
+	return ""
+}
+
+func (m *Application) GetRollout() *Rollout {
+	if m != nil {
+		return m.Rollout
+	}
+	return nil
+}
+
 func (m *Application) GetEnvironments() []*Environment {
 	if m != nil {
 		return m.Environments
@@ -91,12 +125,84 @@ func (m *Application) GetEnvironments() []*Environment {
 	return nil
 }
 
+type Rollout struct {
+	//strategy AllAtOnce updates all the environments together. Progressive updates the environments in the listed order
+	//Each environment is updated only after the previous one is ready for the soak time. Default is AllAtOnce
+	// +kubebuilder:validation:Enum=AllAtOnce;Progressive
+	// +optional
+	Strategy string `protobuf:"bytes,1,opt,name=strategy,proto3" json:"strategy,omitempty"`
+	//soakTimeSeconds is the time previous environment must be ready before the next environment is updated
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	SoakTimeSeconds int32 `protobuf:"varint,2,opt,name=soakTimeSeconds,proto3" json:"soakTimeSeconds,omitempty"`
+	//manualPromotion holds each next environment until it is promoted with the manager.keikoproj.io/promote annotation
+	//set to the environment name
+	// +optional
+	ManualPromotion      bool     `protobuf:"varint,3,opt,name=manualPromotion,proto3" json:"manualPromotion,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Rollout) Reset()         { *m = Rollout{} }
+func (m *Rollout) String() string { return proto.CompactTextString(m) }
+func (*Rollout) ProtoMessage()    {}
+func (*Rollout) Descriptor() ([]byte, []int) {
+	return fileDescriptor_4532862135811ae9, []int{1}
+}
+
+func (m *Rollout) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Rollout.Unmarshal(m, b)
+}
+func (m *Rollout) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Rollout.Marshal(b, m, deterministic)
+}
+func (m *Rollout) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Rollout.Merge(m, src)
+}
+func (m *Rollout) XXX_Size() int {
+	return xxx_messageInfo_Rollout.Size(m)
+}
+func (m *Rollout) XXX_DiscardUnknown() {
+	xxx_messageInfo_Rollout.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Rollout proto.InternalMessageInfo
+
+func (m *Rollout) GetStrategy() string {
+	if m != nil {
+		return m.Strategy
+	}
+	return ""
+}
+
+func (m *Rollout) GetSoakTimeSeconds() int32 {
+	if m != nil {
+		return m.SoakTimeSeconds
+	}
+	return 0
+}
+
+func (m *Rollout) GetManualPromotion() bool {
+	if m != nil {
+		return m.ManualPromotion
+	}
+	return false
+}
+
 type Environment struct {
 	//Application environment
 	// +kubebuilder:validation:MinLength=1
 	// +kubebuilder:validation:Pattern=^[a-z0-9-]+$
 	// +required
 	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//namespaceName overrides the managed namespace name derived from the naming pattern configured in the manager
+	//Default pattern is {app}-{env}. Placeholders {app}, {env}, {cluster} and {team} are supported
+	//The name is passed to the template as the name param unless the param is provided already
+	// +kubebuilder:validation:MaxLength=63
+	// +kubebuilder:validation:Pattern=^[a-z0-9-]*$
+	// +optional
+	NamespaceName string `protobuf:"bytes,2,opt,name=namespaceName,proto3" json:"namespaceName,omitempty"`
 	//Each environment must have one namespace
 	// +required
 	Namespace            *namespace.Namespace `protobuf:"bytes,6,opt,name=namespace,proto3" json:"namespace,omitempty"`
@@ -109,7 +215,7 @@ func (m *Environment) Reset()         { *m = Environment{} }
 func (m *Environment) String() string { return proto.CompactTextString(m) }
 func (*Environment) ProtoMessage()    {}
 func (*Environment) Descriptor() ([]byte, []int) {
-	return fileDescriptor_4532862135811ae9, []int{1}
+	return fileDescriptor_4532862135811ae9, []int{2}
 }
 
 func (m *Environment) XXX_Unmarshal(b []byte) error {
@@ -137,6 +243,13 @@ func (m *Environment) GetName() string {
 	return ""
 }
 
+func (m *Environment) GetNamespaceName() string {
+	if m != nil {
+		return m.NamespaceName
+	}
+	return ""
+}
+
 func (m *Environment) GetNamespace() *namespace.Namespace {
 	if m != nil {
 		return m.Namespace
@@ -147,6 +260,7 @@ func (m *Environment) GetNamespace() *namespace.Namespace {
 func init() {
 	proto.RegisterType((*Application)(nil), "application.Application")
 	proto.RegisterMapType((map[string]string)(nil), "application.Application.AppParamsEntry")
+	proto.RegisterType((*Rollout)(nil), "application.Rollout")
 	proto.RegisterType((*Environment)(nil), "application.Environment")
 }
 
@@ -155,23 +269,29 @@ func init() {
 }
 
 var fileDescriptor_4532862135811ae9 = []byte{
-	// 278 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x91, 0x4f, 0x4b, 0xc3, 0x40,
-	0x10, 0xc5, 0x49, 0xab, 0x95, 0xcc, 0x8a, 0xc8, 0xd2, 0xc3, 0xd2, 0x53, 0xe8, 0xc5, 0x1c, 0x24,
-	0x0b, 0xf1, 0xe0, 0x1f, 0x7a, 0xa9, 0x90, 0x6b, 0x91, 0x80, 0x17, 0x6f, 0xd3, 0xb0, 0xc4, 0x98,
-	0xee, 0xee, 0xb0, 0x49, 0x0b, 0xfd, 0xca, 0x7e, 0x0a, 0x31, 0xb1, 0x6e, 0x22, 0x78, 0xfb, 0xcd,
-	0xcb, 0x7b, 0x8f, 0xc9, 0x2c, 0xdc, 0x52, 0x5d, 0xca, 0xd2, 0x51, 0x21, 0xc9, 0xd9, 0xd6, 0x4a,
-	0x24, 0xda, 0x55, 0x05, 0xb6, 0x95, 0x35, 0x43, 0x4e, 0xba, 0xaf, 0x9c, 0x0d, 0xa4, 0x45, 0xfc,
-	0x27, 0x6a, 0x50, 0xab, 0x86, 0xb0, 0x50, 0x9e, 0xfa, 0xd8, 0xf2, 0x33, 0x00, 0xb6, 0xf6, 0x49,
-	0x2e, 0xe0, 0x02, 0x89, 0x36, 0xa8, 0x95, 0x08, 0xa2, 0x20, 0x0e, 0xf3, 0xd3, 0xc8, 0x33, 0x08,
-	0x91, 0xe8, 0x05, 0x1d, 0xea, 0x46, 0x4c, 0xa2, 0x69, 0xcc, 0xd2, 0x9b, 0x64, 0xb8, 0xc7, 0x7a,
-	0xcc, 0xbd, 0x33, 0x33, 0xad, 0x3b, 0xe6, 0x3e, 0xc9, 0x57, 0x70, 0xa9, 0xcc, 0xa1, 0x72, 0xd6,
-	0x68, 0x65, 0xda, 0x46, 0xb0, 0xae, 0x49, 0x8c, 0x9a, 0x32, 0x6f, 0xc8, 0x47, 0xee, 0xc5, 0x0a,
-	0xae, 0xc6, 0xd5, 0xfc, 0x1a, 0xa6, 0xb5, 0x3a, 0xfe, 0x2c, 0xfb, 0x8d, 0x7c, 0x0e, 0xe7, 0x07,
-	0xdc, 0xed, 0x95, 0x98, 0x74, 0x5a, 0x3f, 0x3c, 0x4d, 0x1e, 0x82, 0xe5, 0x2b, 0xb0, 0x41, 0x35,
-	0xe7, 0x70, 0x66, 0xfc, 0x8f, 0x76, 0xcc, 0x53, 0x08, 0x7f, 0x4f, 0x24, 0x66, 0x51, 0x10, 0xb3,
-	0x74, 0x9e, 0xf8, 0xa3, 0x6d, 0x4e, 0x94, 0x7b, 0xdb, 0xf3, 0xe3, 0xdb, 0x7d, 0x59, 0xb5, 0xef,
-	0xfb, 0x6d, 0x52, 0x58, 0x2d, 0x6b, 0x55, 0xd5, 0x96, 0x9c, 0xfd, 0x90, 0x1a, 0x0d, 0x96, 0xca,
-	0xc9, 0xff, 0xdf, 0x71, 0x3b, 0xeb, 0xa4, 0xbb, 0xaf, 0x00, 0x00, 0x00, 0xff, 0xff, 0x84, 0xf5,
-	0xed, 0x90, 0xec, 0x01, 0x00, 0x00,
+	// 384 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x52, 0x4b, 0xcb, 0xdb, 0x30,
+	0x10, 0xc4, 0xce, 0x97, 0x2f, 0xc9, 0xba, 0x2f, 0x44, 0x0e, 0x22, 0x27, 0x13, 0x0a, 0xf5, 0xa1,
+	0xd8, 0x90, 0x1e, 0xfa, 0x20, 0x97, 0x14, 0x72, 0x0d, 0x41, 0xed, 0xa9, 0xb7, 0x8d, 0x2b, 0x5c,
+	0xd7, 0xd6, 0x03, 0x59, 0x0e, 0x98, 0xfe, 0xc9, 0xfe, 0xa4, 0x62, 0x39, 0x8e, 0xed, 0x40, 0x6f,
+	0xb3, 0xb3, 0x33, 0xd2, 0x68, 0xb5, 0xf0, 0x5e, 0x17, 0x59, 0x92, 0x19, 0x9d, 0x26, 0xda, 0x28,
+	0xab, 0x12, 0xd4, 0xba, 0xcc, 0x53, 0xb4, 0xb9, 0x92, 0x63, 0x1c, 0xbb, 0x2e, 0x09, 0x46, 0xd4,
+	0x26, 0x7a, 0xb0, 0x4a, 0x14, 0xbc, 0xd2, 0x98, 0xf2, 0x01, 0x75, 0xb6, 0xed, 0x5f, 0x1f, 0x82,
+	0xc3, 0xe0, 0x24, 0x14, 0x16, 0xa8, 0xf5, 0x09, 0x05, 0xa7, 0x5e, 0xe8, 0x45, 0x2b, 0xd6, 0x97,
+	0xe4, 0x08, 0x2b, 0xd4, 0xfa, 0x8c, 0x06, 0x45, 0x45, 0xfd, 0x70, 0x16, 0x05, 0xbb, 0x77, 0xf1,
+	0x38, 0xc7, 0x61, 0x8a, 0x3b, 0xe5, 0x51, 0x5a, 0xd3, 0xb0, 0xc1, 0x49, 0x42, 0x08, 0xb4, 0xa9,
+	0x25, 0x3f, 0xab, 0x32, 0x4f, 0x1b, 0x3a, 0x73, 0x97, 0x8c, 0x29, 0x42, 0xe0, 0xc9, 0x72, 0x14,
+	0xf4, 0xc9, 0xb5, 0x1c, 0x26, 0x31, 0x2c, 0x8c, 0x2a, 0x4b, 0x55, 0x5b, 0x3a, 0x0f, 0xbd, 0x28,
+	0xd8, 0xad, 0x27, 0x57, 0xb3, 0xae, 0xc7, 0x7a, 0x11, 0xd9, 0xc3, 0x0b, 0x2e, 0xaf, 0xb9, 0x51,
+	0x52, 0x70, 0x69, 0x2b, 0x1a, 0xb8, 0xbc, 0x74, 0x62, 0x3a, 0x0e, 0x02, 0x36, 0x51, 0x6f, 0xf6,
+	0xf0, 0x6a, 0xfa, 0x00, 0xf2, 0x06, 0x66, 0x05, 0x6f, 0x6e, 0x23, 0x69, 0x21, 0x59, 0xc3, 0xfc,
+	0x8a, 0x65, 0xcd, 0xa9, 0xef, 0xb8, 0xae, 0xf8, 0xe2, 0x7f, 0xf2, 0xb6, 0x0d, 0x2c, 0x6e, 0x79,
+	0xc8, 0x06, 0x96, 0x95, 0x35, 0x68, 0x79, 0xd6, 0x7b, 0xef, 0x35, 0x89, 0xe0, 0x75, 0xa5, 0xb0,
+	0xf8, 0x9e, 0x0b, 0xfe, 0x8d, 0xa7, 0x4a, 0xfe, 0xac, 0xdc, 0x51, 0x73, 0xf6, 0x48, 0xb7, 0x4a,
+	0x81, 0xb2, 0xc6, 0xf2, 0x6c, 0x94, 0x50, 0x6d, 0x76, 0x37, 0xb6, 0x25, 0x7b, 0xa4, 0xb7, 0x7f,
+	0x20, 0x18, 0xbd, 0xaa, 0x9d, 0xa4, 0x1c, 0x7e, 0xd2, 0x61, 0xf2, 0x16, 0x5e, 0xde, 0x77, 0xc0,
+	0x7d, 0x73, 0x97, 0x7f, 0x4a, 0x92, 0x1d, 0xac, 0xee, 0x04, 0x7d, 0xbe, 0x4d, 0x7c, 0xd8, 0x9d,
+	0x53, 0x8f, 0xd8, 0x20, 0xfb, 0xfa, 0xf9, 0xc7, 0xc7, 0x2c, 0xb7, 0xbf, 0xea, 0x4b, 0x9c, 0x2a,
+	0x91, 0x14, 0x3c, 0x2f, 0x94, 0x36, 0xea, 0x77, 0x22, 0x50, 0x62, 0xc6, 0x4d, 0xf2, 0xff, 0x75,
+	0xbe, 0x3c, 0x3b, 0xea, 0xc3, 0xbf, 0x01, 0x00, 0xbd, 0x94, 0xc1, 0xb8, 0xf3, 0x02, 0x00, 0x00,
 }
@@ -33,6 +33,11 @@ func (in *Application) DeepCopyInto(out *Application) {
 			(*out)[key] = val
 		}
 	}
+	if in.Rollout != nil {
+		in, out := &in.Rollout, &out.Rollout
+		*out = new(Rollout)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Environments != nil {
 		in, out := &in.Environments, &out.Environments
 		*out = make([]*Environment, len(*in))
@@ -87,3 +92,24 @@ func (in *Environment) DeepCopy() *Environment {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Rollout) DeepCopyInto(out *Rollout) {
+	*out = *in
+	out.XXX_NoUnkeyedLiteral = in.XXX_NoUnkeyedLiteral
+	if in.XXX_unrecognized != nil {
+		in, out := &in.XXX_unrecognized, &out.XXX_unrecognized
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Rollout.
+func (in *Rollout) DeepCopy() *Rollout {
+	if in == nil {
+		return nil
+	}
+	out := new(Rollout)
+	in.DeepCopyInto(out)
+	return out
+}
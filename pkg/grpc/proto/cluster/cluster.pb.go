@@ -30,7 +30,25 @@ type Cluster struct {
 	//Config contains info to connect to the target cluster
 	//This is same as config struct in https://github.com/kubernetes/client-go/blob/master/rest/config.go
 	// but have to define it again here with whatever we need
-	Config               *Config  `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	Config *Config `protobuf:"bytes,3,opt,name=config,proto3" json:"config,omitempty"`
+	//Labels to be added to the cluster. Namespaces can use these labels to select the cluster
+	// +optional
+	Labels map[string]string `protobuf:"bytes,4,rep,name=labels,proto3" json:"labels,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	//deletionPolicy decides what happens to the managed namespaces when the cluster is deleted
+	//Allowed values are
+	// - Block: cluster can not be deleted while managed namespaces exist (default)
+	// - Cascade: namespaces get deleted in the cluster along with the managed namespaces
+	// - Orphan: managed namespaces get deleted but namespaces are left as is in the cluster
+	// +kubebuilder:validation:Enum=Block;Cascade;Orphan
+	// +optional
+	DeletionPolicy string `protobuf:"bytes,5,opt,name=deletionPolicy,proto3" json:"deletionPolicy,omitempty"`
+	//mode decides how manager reaches the cluster
+	//Allowed values are
+	// - direct: manager connects to the cluster api server using the config (default)
+	// - agent: agent running inside the cluster pulls the desired state from manager and reports the status back
+	// +kubebuilder:validation:Enum=direct;agent
+	// +optional
+	Mode                 string   `protobuf:"bytes,6,opt,name=mode,proto3" json:"mode,omitempty"`
 	XXX_NoUnkeyedLiteral struct{} `json:"-"`
 	XXX_unrecognized     []byte   `json:"-"`
 	XXX_sizecache        int32    `json:"-"`
@@ -82,6 +100,27 @@ func (m *Cluster) GetConfig() *Config {
 	return nil
 }
 
+func (m *Cluster) GetLabels() map[string]string {
+	if m != nil {
+		return m.Labels
+	}
+	return nil
+}
+
+func (m *Cluster) GetDeletionPolicy() string {
+	if m != nil {
+		return m.DeletionPolicy
+	}
+	return ""
+}
+
+func (m *Cluster) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
 // Config holds the common attributes that can be passed to a Kubernetes client on
 // initialization.
 // +optional
@@ -104,10 +143,14 @@ type Config struct {
 	BearerTokenSecret string `protobuf:"bytes,5,opt,name=bearerTokenSecret,proto3" json:"bearerTokenSecret,omitempty"`
 	// TLSClientConfig contains settings to enable transport layer security
 	// +optional
-	TlsClientConfig      *TLSClientConfig `protobuf:"bytes,6,opt,name=tlsClientConfig,proto3" json:"tlsClientConfig,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}         `json:"-"`
-	XXX_unrecognized     []byte           `json:"-"`
-	XXX_sizecache        int32            `json:"-"`
+	TlsClientConfig *TLSClientConfig `protobuf:"bytes,6,opt,name=tlsClientConfig,proto3" json:"tlsClientConfig,omitempty"`
+	// AgentToken is used by the agent to authenticate with the manager.
+	// It is returned only while registering a cluster in agent mode and never stored in the cluster resource
+	// +optional
+	AgentToken           string   `protobuf:"bytes,7,opt,name=agentToken,proto3" json:"agentToken,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Config) Reset()         { *m = Config{} }
@@ -177,6 +220,13 @@ func (m *Config) GetTlsClientConfig() *TLSClientConfig {
 	return nil
 }
 
+func (m *Config) GetAgentToken() string {
+	if m != nil {
+		return m.AgentToken
+	}
+	return ""
+}
+
 // TLSClientConfig contains settings to enable transport layer security
 type TLSClientConfig struct {
 	// Server should be accessed without verifying the TLS certificate. For testing only.
@@ -273,6 +323,7 @@ func (m *TLSClientConfig) GetNextProtos() []string {
 
 func init() {
 	proto.RegisterType((*Cluster)(nil), "cluster.Cluster")
+	proto.RegisterMapType((map[string]string)(nil), "cluster.Cluster.LabelsEntry")
 	proto.RegisterType((*Config)(nil), "cluster.Config")
 	proto.RegisterType((*TLSClientConfig)(nil), "cluster.TLSClientConfig")
 }
@@ -282,27 +333,33 @@ func init() {
 }
 
 var fileDescriptor_a3be2089bb94235a = []byte{
-	// 351 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x92, 0xcf, 0x6a, 0xea, 0x40,
-	0x14, 0xc6, 0xc9, 0x55, 0xa3, 0x1e, 0x2f, 0xc8, 0x1d, 0x2e, 0x97, 0xe1, 0x2e, 0x4a, 0x90, 0x42,
-	0x5d, 0x14, 0x03, 0x95, 0xbe, 0x80, 0x76, 0x59, 0x4a, 0x89, 0xae, 0x4a, 0x37, 0xe3, 0x78, 0x1a,
-	0xd3, 0xc4, 0x4c, 0x98, 0x99, 0xf4, 0xcf, 0xb3, 0xf5, 0x85, 0xfa, 0x18, 0x65, 0x4e, 0x4c, 0x0c,
-	0xb6, 0x2b, 0xcf, 0xef, 0xfb, 0x66, 0xce, 0xe7, 0x97, 0x04, 0xce, 0x8b, 0x34, 0x0e, 0x63, 0x5d,
-	0xc8, 0xb0, 0xd0, 0xca, 0xaa, 0x50, 0x66, 0xa5, 0xb1, 0xa8, 0xeb, 0xdf, 0x19, 0xa9, 0xac, 0x7f,
-	0xc0, 0xc9, 0x23, 0xf4, 0x97, 0xd5, 0xc8, 0x18, 0x74, 0x73, 0xb1, 0x47, 0xee, 0x05, 0xde, 0x74,
-	0x18, 0xd1, 0xcc, 0xfe, 0x42, 0x4f, 0x66, 0xaa, 0xdc, 0xf2, 0x5f, 0x24, 0x56, 0xc0, 0x2e, 0xc0,
-	0x97, 0x2a, 0x7f, 0x4a, 0x62, 0xde, 0x09, 0xbc, 0xe9, 0xe8, 0x6a, 0x3c, 0xab, 0xb7, 0x2f, 0x49,
-	0x8e, 0x0e, 0xf6, 0xe4, 0xd3, 0x03, 0xbf, 0x92, 0xdc, 0xf6, 0x9d, 0x32, 0xb6, 0xde, 0xee, 0x66,
-	0xf6, 0x1f, 0x06, 0xa5, 0x41, 0x4d, 0xa9, 0x55, 0x40, 0xc3, 0xce, 0x2b, 0x84, 0x31, 0xaf, 0x4a,
-	0x6f, 0x29, 0x65, 0x18, 0x35, 0xcc, 0x02, 0x18, 0x6d, 0x50, 0x68, 0xd4, 0x6b, 0x95, 0x62, 0xce,
-	0xbb, 0x64, 0xb7, 0x25, 0x76, 0x09, 0x7f, 0x5a, 0xb8, 0x42, 0xa9, 0xd1, 0xf2, 0x1e, 0x9d, 0xfb,
-	0x6e, 0xb0, 0x05, 0x8c, 0x6d, 0x66, 0x96, 0x59, 0x82, 0xb9, 0xad, 0xfe, 0x2e, 0xf7, 0xa9, 0x18,
-	0x6f, 0x8a, 0xad, 0x6f, 0x57, 0x6d, 0x3f, 0x3a, 0xbd, 0x30, 0xf9, 0xf0, 0x60, 0x7c, 0x72, 0xc8,
-	0x75, 0x48, 0x5c, 0x46, 0xa9, 0xab, 0xa7, 0x3a, 0x88, 0x1a, 0x66, 0x67, 0x00, 0x06, 0xf5, 0x0b,
-	0xea, 0xbb, 0x63, 0xfb, 0x96, 0xe2, 0xee, 0x4a, 0xd4, 0xf6, 0x46, 0x58, 0x41, 0xfd, 0x7f, 0x47,
-	0x0d, 0x33, 0x0e, 0xfd, 0x14, 0xdf, 0xc9, 0xea, 0x92, 0x55, 0x23, 0xfb, 0x07, 0xbe, 0x14, 0x64,
-	0xf4, 0xc8, 0x38, 0x90, 0x4b, 0xcb, 0xf1, 0xcd, 0xde, 0xbb, 0x97, 0x6f, 0xb8, 0x1f, 0x74, 0x5c,
-	0xda, 0x51, 0x59, 0x5c, 0x3f, 0xcc, 0xe3, 0xc4, 0xee, 0xca, 0xcd, 0x4c, 0xaa, 0x7d, 0x98, 0x62,
-	0x92, 0xaa, 0x42, 0xab, 0xe7, 0x70, 0x2f, 0x72, 0x11, 0xa3, 0x0e, 0x7f, 0xfe, 0xa8, 0x36, 0x3e,
-	0xe1, 0xfc, 0x2b, 0x00, 0x00, 0xff, 0xff, 0x10, 0x38, 0x6c, 0xe4, 0x75, 0x02, 0x00, 0x00,
+	// 447 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x6c, 0x53, 0xdd, 0x8e, 0xd3, 0x3c,
+	0x10, 0x55, 0xfa, 0x93, 0xee, 0x4e, 0x3e, 0x7d, 0x05, 0x0b, 0x21, 0x6b, 0x85, 0x50, 0x54, 0x21,
+	0xe8, 0x05, 0x6a, 0xa4, 0x2e, 0x48, 0xc0, 0xe5, 0x16, 0xee, 0x56, 0x68, 0x95, 0xdd, 0x2b, 0xee,
+	0xdc, 0x74, 0xc8, 0x86, 0xb8, 0x76, 0x64, 0x3b, 0x0b, 0x7d, 0x03, 0x78, 0x26, 0x5e, 0x0e, 0x79,
+	0xf2, 0xd3, 0xa8, 0x70, 0x95, 0x39, 0xe7, 0xd8, 0x33, 0x67, 0x8e, 0x1c, 0x78, 0x51, 0x95, 0x79,
+	0x92, 0x9b, 0x2a, 0x4b, 0x2a, 0xa3, 0x9d, 0x4e, 0x32, 0x59, 0x5b, 0x87, 0xa6, 0xfb, 0xae, 0x88,
+	0x65, 0xb3, 0x16, 0x2e, 0x7e, 0x8d, 0x60, 0xb6, 0x69, 0x6a, 0xc6, 0x60, 0xa2, 0xc4, 0x1e, 0x79,
+	0x10, 0x07, 0xcb, 0xf3, 0x94, 0x6a, 0xf6, 0x04, 0xa6, 0x99, 0xd4, 0xf5, 0x8e, 0x8f, 0x88, 0x6c,
+	0x00, 0x7b, 0x05, 0x61, 0xa6, 0xd5, 0xd7, 0x22, 0xe7, 0xe3, 0x38, 0x58, 0x46, 0xeb, 0xf9, 0xaa,
+	0x6b, 0xbf, 0x21, 0x3a, 0x6d, 0x65, 0xf6, 0x06, 0x42, 0x29, 0xb6, 0x28, 0x2d, 0x9f, 0xc4, 0xe3,
+	0x65, 0xb4, 0x7e, 0x76, 0x3c, 0xd8, 0x7e, 0xaf, 0x49, 0xfe, 0xa4, 0x9c, 0x39, 0xa4, 0xed, 0x59,
+	0xf6, 0x12, 0xfe, 0xdf, 0xa1, 0x44, 0x57, 0x68, 0x75, 0xa3, 0x65, 0x91, 0x1d, 0xf8, 0x94, 0xa6,
+	0x9f, 0xb0, 0xde, 0xf0, 0x5e, 0xef, 0x90, 0x87, 0x8d, 0x61, 0x5f, 0x5f, 0xbc, 0x87, 0x68, 0xd0,
+	0x92, 0x3d, 0x82, 0x71, 0x89, 0x87, 0x76, 0x25, 0x5f, 0xfa, 0x8d, 0x1e, 0x84, 0xac, 0xb1, 0xdb,
+	0x88, 0xc0, 0x87, 0xd1, 0xbb, 0x60, 0xf1, 0x73, 0x04, 0x61, 0xe3, 0xdf, 0x77, 0xbe, 0xd7, 0xd6,
+	0x75, 0x51, 0xf8, 0x9a, 0x5d, 0xc0, 0x59, 0x6d, 0xd1, 0x50, 0x44, 0xcd, 0xdd, 0x1e, 0x7b, 0xad,
+	0x12, 0xd6, 0x7e, 0xd7, 0x66, 0x47, 0x91, 0x9c, 0xa7, 0x3d, 0x66, 0x31, 0x44, 0x5b, 0x14, 0x06,
+	0xcd, 0x9d, 0x2e, 0x51, 0xf1, 0x09, 0xc9, 0x43, 0x8a, 0xbd, 0x86, 0xc7, 0x03, 0x78, 0x8b, 0x99,
+	0x41, 0xd7, 0xae, 0xfc, 0xb7, 0xc0, 0xae, 0x60, 0xee, 0xa4, 0xdd, 0xc8, 0x02, 0x95, 0x6b, 0xec,
+	0x52, 0x00, 0xd1, 0x9a, 0xf7, 0xe1, 0xde, 0x5d, 0xdf, 0x0e, 0xf5, 0xf4, 0xf4, 0x02, 0x7b, 0x0e,
+	0x20, 0x72, 0x54, 0xae, 0xb1, 0x34, 0xa3, 0x51, 0x03, 0x66, 0xf1, 0x3b, 0x80, 0xf9, 0x49, 0x13,
+	0xbf, 0x63, 0xe1, 0x3d, 0xd4, 0xa6, 0x79, 0x22, 0x67, 0x69, 0x8f, 0x7d, 0x3f, 0x8b, 0xe6, 0x01,
+	0xcd, 0xe7, 0x63, 0x3a, 0x03, 0xc6, 0xdf, 0xcd, 0xd0, 0xb8, 0x8f, 0xc2, 0x09, 0xca, 0xe7, 0xbf,
+	0xb4, 0xc7, 0x8c, 0xc3, 0xac, 0xc4, 0x03, 0x49, 0x13, 0x92, 0x3a, 0xc8, 0x9e, 0x42, 0x98, 0x09,
+	0x12, 0xa6, 0x24, 0xb4, 0xc8, 0x4f, 0x53, 0xf8, 0xc3, 0xdd, 0xf8, 0xa7, 0x6c, 0x79, 0x18, 0x8f,
+	0xfd, 0xb4, 0x23, 0x73, 0xf5, 0xf6, 0xcb, 0x65, 0x5e, 0xb8, 0xfb, 0x7a, 0xbb, 0xca, 0xf4, 0x3e,
+	0x29, 0xb1, 0x28, 0x75, 0x65, 0xf4, 0xb7, 0x64, 0x2f, 0x94, 0xc8, 0xd1, 0x24, 0xff, 0xfe, 0x45,
+	0xb6, 0x21, 0xc1, 0xcb, 0x3f, 0x03, 0x00, 0x17, 0x7b, 0x2b, 0xda, 0x43, 0x03, 0x00, 0x00,
 }
@@ -29,6 +29,13 @@ func (in *Cluster) DeepCopyInto(out *Cluster) {
 		*out = new(Config)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Labels != nil {
+		in, out := &in.Labels, &out.Labels
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
 	out.XXX_NoUnkeyedLiteral = in.XXX_NoUnkeyedLiteral
 	if in.XXX_unrecognized != nil {
 		in, out := &in.XXX_unrecognized, &out.XXX_unrecognized
@@ -6,6 +6,7 @@ package namespace
 import (
 	fmt "fmt"
 	proto "github.com/golang/protobuf/proto"
+	v1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	math "math"
 )
 
@@ -22,10 +23,10 @@ const _ = proto.ProtoPackageIsVersion3 // please upgrade the proto package
 
 type Namespace struct {
 	//Name of the cluster in which this namespace to be created
-	// +kubebuilder:validation:MinLength=1
+	//Either clusterName or clusterSelector must be provided. clusterName takes precedence over clusterSelector
 	// +kubebuilder:validation:MaxLength=63
-	// +kubebuilder:validation:Pattern=^[a-z0-9-]+$
-	// +kubebuilder:validation:Required
+	// +kubebuilder:validation:Pattern=^[a-z0-9-]*$
+	// +optional
 	ClusterName string `protobuf:"bytes,1,opt,name=clusterName,proto3" json:"clusterName,omitempty"`
 	//Name of the template to be used to create this namespace
 	//This template must be already exists in the manager
@@ -39,10 +40,34 @@ type Namespace struct {
 	//make sure to use this to add "additional resources" only apart from the template
 	// DO NOT Provide namespace in the resources section if you already provided the templateName
 	// +optional
-	NsResources          *NamespaceResources `protobuf:"bytes,4,opt,name=nsResources,proto3" json:"nsResources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	NsResources *NamespaceResources `protobuf:"bytes,4,opt,name=nsResources,proto3" json:"nsResources,omitempty"`
+	//clusterSelector selects the candidate clusters based on cluster labels when clusterName is not provided
+	//Chosen cluster is recorded in the status and namespace stays in that cluster even if the labels change later
+	// +optional
+	ClusterSelector *v1.LabelSelector `protobuf:"bytes,5,opt,name=clusterSelector,proto3" json:"clusterSelector,omitempty"`
+	//placementStrategy decides which one of the clusters matching the clusterSelector to be chosen
+	//Allowed values are
+	// - spread: cluster with the least number of managed namespaces (default)
+	// - least-loaded: cluster with the most allocatable cpu per managed namespace
+	// - pinned: first matching cluster in the alphabetical order
+	// +kubebuilder:validation:Enum=spread;least-loaded;pinned
+	// +optional
+	PlacementStrategy string `protobuf:"bytes,6,opt,name=placementStrategy,proto3" json:"placementStrategy,omitempty"`
+	//clusterNames can be used to create the same namespace in multiple clusters
+	//Namespace is reconciled in each of the clusters independently. clusterName, if provided, is added to the list
+	// +optional
+	ClusterNames []string `protobuf:"bytes,7,rep,name=clusterNames,proto3" json:"clusterNames,omitempty"`
+	//deletionPolicy decides what happens to the namespace in the clusters when the managed namespace is deleted
+	//or when the cluster is removed from the list
+	//Allowed values are
+	// - Delete: namespace gets deleted in the cluster
+	// - Orphan: namespace is left as is in the cluster (default)
+	// +kubebuilder:validation:Enum=Delete;Orphan
+	// +optional
+	DeletionPolicy       string   `protobuf:"bytes,8,opt,name=deletionPolicy,proto3" json:"deletionPolicy,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *Namespace) Reset()         { *m = Namespace{} }
@@ -98,6 +123,34 @@ func (m *Namespace) GetNsResources() *NamespaceResources {
 	return nil
 }
 
+func (m *Namespace) GetClusterSelector() *v1.LabelSelector {
+	if m != nil {
+		return m.ClusterSelector
+	}
+	return nil
+}
+
+func (m *Namespace) GetPlacementStrategy() string {
+	if m != nil {
+		return m.PlacementStrategy
+	}
+	return ""
+}
+
+func (m *Namespace) GetClusterNames() []string {
+	if m != nil {
+		return m.ClusterNames
+	}
+	return nil
+}
+
+func (m *Namespace) GetDeletionPolicy() string {
+	if m != nil {
+		return m.DeletionPolicy
+	}
+	return ""
+}
+
 func init() {
 	proto.RegisterType((*Namespace)(nil), "namespace.Namespace")
 	proto.RegisterMapType((map[string]string)(nil), "namespace.Namespace.ParamsEntry")
@@ -108,21 +161,29 @@ func init() {
 }
 
 var fileDescriptor_f1816289be543d89 = []byte{
-	// 251 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x90, 0xcf, 0x4a, 0xc4, 0x30,
-	0x10, 0x87, 0x69, 0xab, 0x0b, 0x9d, 0x78, 0x90, 0xe0, 0xa1, 0x2c, 0x08, 0x61, 0x2f, 0xf6, 0xd4,
-	0xc0, 0x8a, 0xb8, 0x7a, 0x11, 0x04, 0xaf, 0x22, 0x3d, 0x7a, 0xcb, 0x86, 0xa1, 0xae, 0x6d, 0x9a,
-	0x90, 0x3f, 0xc2, 0x3e, 0x81, 0xaf, 0x2d, 0x1b, 0xdb, 0x6e, 0x05, 0xf7, 0x36, 0xf9, 0xe6, 0xfb,
-	0x4d, 0x26, 0x81, 0xd2, 0xb4, 0x0d, 0x6f, 0xac, 0x91, 0xdc, 0x58, 0xed, 0x35, 0xef, 0x85, 0x42,
-	0x67, 0x84, 0xc4, 0x63, 0x55, 0xc5, 0x0e, 0xcd, 0x27, 0xb0, 0xbc, 0x39, 0x19, 0xf2, 0xa8, 0x4c,
-	0x27, 0xfc, 0x90, 0x59, 0x7d, 0xa7, 0x90, 0xbf, 0x8e, 0x4d, 0xca, 0x80, 0xc8, 0x2e, 0x38, 0x8f,
-	0xf6, 0xc0, 0x8a, 0x84, 0x25, 0x65, 0x5e, 0xcf, 0x11, 0x5d, 0xc1, 0xc5, 0x38, 0x21, 0x2a, 0x69,
-	0x54, 0xfe, 0x30, 0xba, 0x81, 0x85, 0x11, 0x56, 0x28, 0x57, 0x64, 0x2c, 0x2b, 0xc9, 0x9a, 0x55,
-	0xc7, 0x4d, 0xa7, 0xbb, 0xaa, 0xb7, 0xa8, 0xbc, 0xf4, 0xde, 0xee, 0xeb, 0xc1, 0xa7, 0x4f, 0x40,
-	0x7a, 0x57, 0xa3, 0xd3, 0xc1, 0x4a, 0x74, 0xc5, 0x19, 0x4b, 0x4a, 0xb2, 0xbe, 0xfe, 0x2f, 0x3e,
-	0x49, 0xf5, 0x3c, 0xb1, 0x7c, 0x00, 0x32, 0x9b, 0x4b, 0x2f, 0x21, 0x6b, 0x71, 0x3f, 0xbc, 0xe3,
-	0x50, 0xd2, 0x2b, 0x38, 0xff, 0x12, 0x5d, 0x18, 0x17, 0xff, 0x3d, 0x3c, 0xa6, 0x9b, 0xe4, 0xf9,
-	0xfe, 0xfd, 0xae, 0xd9, 0xf9, 0x8f, 0xb0, 0xad, 0xa4, 0x56, 0xbc, 0xc5, 0x5d, 0xab, 0x8d, 0xd5,
-	0x9f, 0x5c, 0x89, 0x5e, 0x34, 0x68, 0xf9, 0xa9, 0x1f, 0xdd, 0x2e, 0x22, 0xb8, 0xfd, 0x09, 0x00,
-	0x00, 0xff, 0xff, 0xe4, 0x34, 0x88, 0xbd, 0xa9, 0x01, 0x00, 0x00,
+	// 381 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x92, 0x4d, 0x8b, 0xd4, 0x30,
+	0x18, 0xc7, 0xe9, 0xd6, 0x1d, 0x9d, 0x54, 0x7c, 0x09, 0x1e, 0xca, 0x80, 0x50, 0xf6, 0xa0, 0x3d,
+	0x48, 0xc2, 0xee, 0x2a, 0x8e, 0x5e, 0x04, 0xc1, 0x9b, 0xc8, 0xd2, 0xbd, 0x09, 0x1e, 0x32, 0xd9,
+	0x87, 0x6e, 0x6c, 0xde, 0x48, 0xd2, 0x81, 0x7e, 0x5b, 0x3f, 0x8a, 0x24, 0xd3, 0xce, 0xd4, 0x71,
+	0xe7, 0x96, 0xfe, 0xf2, 0xff, 0x3f, 0x69, 0x7f, 0x29, 0xaa, 0x6d, 0xd7, 0xd2, 0xd6, 0x59, 0x4e,
+	0xad, 0x33, 0xc1, 0x50, 0xcd, 0x14, 0x78, 0xcb, 0x38, 0x1c, 0x56, 0x24, 0xed, 0xe0, 0xe5, 0x1e,
+	0xac, 0xde, 0x9e, 0x2c, 0x05, 0x50, 0x56, 0xb2, 0x30, 0x76, 0x56, 0xef, 0xbb, 0xb5, 0x27, 0xc2,
+	0x50, 0x66, 0x85, 0x62, 0xfc, 0x5e, 0x68, 0x70, 0x03, 0x8d, 0x65, 0x66, 0x85, 0xa7, 0x0a, 0x02,
+	0xa3, 0xdb, 0x4b, 0xda, 0x82, 0x06, 0xc7, 0x02, 0xdc, 0xed, 0x5a, 0x17, 0x7f, 0x72, 0xb4, 0xfc,
+	0x31, 0x8d, 0xc4, 0x15, 0x2a, 0xb8, 0xec, 0x7d, 0x00, 0x17, 0x59, 0x99, 0x55, 0x59, 0xbd, 0x6c,
+	0xe6, 0x08, 0x5f, 0xa0, 0xa7, 0xd3, 0xb9, 0x29, 0x72, 0x96, 0x22, 0xff, 0x30, 0xbc, 0x46, 0x0b,
+	0xcb, 0x1c, 0x53, 0xbe, 0xcc, 0xab, 0xbc, 0x2e, 0xae, 0x2a, 0x72, 0xf8, 0xbe, 0xfd, 0x59, 0xe4,
+	0x26, 0x45, 0xbe, 0xe9, 0xe0, 0x86, 0x66, 0xcc, 0xe3, 0x2f, 0xa8, 0xd0, 0xbe, 0x01, 0x6f, 0x7a,
+	0xc7, 0xc1, 0x97, 0x8f, 0xaa, 0xac, 0x2e, 0xae, 0x5e, 0x3f, 0x54, 0xdf, 0x87, 0x9a, 0x79, 0x03,
+	0xff, 0x42, 0xcf, 0xc7, 0xb7, 0xbd, 0x05, 0x09, 0x3c, 0x18, 0x57, 0x9e, 0xa7, 0x21, 0xd7, 0x64,
+	0xa7, 0x87, 0xcc, 0xf5, 0x10, 0xdb, 0xb5, 0x11, 0x78, 0x12, 0xf5, 0x90, 0xed, 0x25, 0xf9, 0xce,
+	0x36, 0x20, 0xa7, 0x6a, 0x73, 0x3c, 0x0b, 0xbf, 0x43, 0x2f, 0xad, 0x64, 0x1c, 0x14, 0xe8, 0x70,
+	0x1b, 0xa2, 0xc7, 0x76, 0x28, 0x17, 0x49, 0xc1, 0xff, 0x1b, 0xd1, 0xd5, 0x4c, 0x9d, 0x2f, 0x1f,
+	0x57, 0x79, 0x74, 0x35, 0x67, 0xf8, 0x0d, 0x7a, 0x76, 0x07, 0x12, 0x82, 0x30, 0xfa, 0xc6, 0x48,
+	0xc1, 0x87, 0xf2, 0x49, 0x1a, 0x77, 0x44, 0x57, 0x9f, 0x50, 0x31, 0x13, 0x86, 0x5f, 0xa0, 0xbc,
+	0x83, 0x61, 0xbc, 0xa0, 0xb8, 0xc4, 0xaf, 0xd0, 0xf9, 0x96, 0xc9, 0x7e, 0xba, 0x91, 0xdd, 0xc3,
+	0xe7, 0xb3, 0x75, 0xf6, 0xf5, 0xe3, 0xcf, 0x0f, 0xad, 0x08, 0xf7, 0xfd, 0x86, 0x70, 0xa3, 0x68,
+	0x07, 0xa2, 0x33, 0xd6, 0x99, 0xdf, 0x54, 0x31, 0xcd, 0x5a, 0x70, 0xf4, 0xd4, 0x0f, 0xb6, 0x59,
+	0x24, 0x70, 0xfd, 0x77, 0x00, 0x05, 0x71, 0x9b, 0x5c, 0xb8, 0x02, 0x00, 0x00,
 }
@@ -30,10 +30,17 @@ type NamespaceTemplate struct {
 	ExportedParamName []string `protobuf:"bytes,1,rep,name=exportedParamName,proto3" json:"exportedParamName,omitempty"`
 	//NamespaceResources consists of all the resources to be created in namespace including custom resources
 	//+required
-	NsResources          *NamespaceResources `protobuf:"bytes,2,opt,name=nsResources,proto3" json:"nsResources,omitempty"`
-	XXX_NoUnkeyedLiteral struct{}            `json:"-"`
-	XXX_unrecognized     []byte              `json:"-"`
-	XXX_sizecache        int32               `json:"-"`
+	NsResources *NamespaceResources `protobuf:"bytes,2,opt,name=nsResources,proto3" json:"nsResources,omitempty"`
+	//description of the template to be shown in the template catalog
+	//+optional
+	Description string `protobuf:"bytes,3,opt,name=description,proto3" json:"description,omitempty"`
+	//params documents the exported params and provides the default values
+	//Default value is used when the namespace doesn't provide the value for the param
+	//+optional
+	Params               []*Param `protobuf:"bytes,4,rep,name=params,proto3" json:"params,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
 }
 
 func (m *NamespaceTemplate) Reset()         { *m = NamespaceTemplate{} }
@@ -75,6 +82,91 @@ func (m *NamespaceTemplate) GetNsResources() *NamespaceResources {
 	return nil
 }
 
+func (m *NamespaceTemplate) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *NamespaceTemplate) GetParams() []*Param {
+	if m != nil {
+		return m.Params
+	}
+	return nil
+}
+
+type Param struct {
+	//name of the exported param
+	// +required
+	Name string `protobuf:"bytes,1,opt,name=name,proto3" json:"name,omitempty"`
+	//description of the param
+	// +optional
+	Description string `protobuf:"bytes,2,opt,name=description,proto3" json:"description,omitempty"`
+	//defaultValue is used when the namespace doesn't provide the value
+	// +optional
+	DefaultValue string `protobuf:"bytes,3,opt,name=defaultValue,proto3" json:"defaultValue,omitempty"`
+	//required params must be provided by the namespace when there is no default value
+	// +optional
+	Required             bool     `protobuf:"varint,4,opt,name=required,proto3" json:"required,omitempty"`
+	XXX_NoUnkeyedLiteral struct{} `json:"-"`
+	XXX_unrecognized     []byte   `json:"-"`
+	XXX_sizecache        int32    `json:"-"`
+}
+
+func (m *Param) Reset()         { *m = Param{} }
+func (m *Param) String() string { return proto.CompactTextString(m) }
+func (*Param) ProtoMessage()    {}
+func (*Param) Descriptor() ([]byte, []int) {
+	return fileDescriptor_32f02f0592f6a6cd, []int{1}
+}
+
+func (m *Param) XXX_Unmarshal(b []byte) error {
+	return xxx_messageInfo_Param.Unmarshal(m, b)
+}
+func (m *Param) XXX_Marshal(b []byte, deterministic bool) ([]byte, error) {
+	return xxx_messageInfo_Param.Marshal(b, m, deterministic)
+}
+func (m *Param) XXX_Merge(src proto.Message) {
+	xxx_messageInfo_Param.Merge(m, src)
+}
+func (m *Param) XXX_Size() int {
+	return xxx_messageInfo_Param.Size(m)
+}
+func (m *Param) XXX_DiscardUnknown() {
+	xxx_messageInfo_Param.DiscardUnknown(m)
+}
+
+var xxx_messageInfo_Param proto.InternalMessageInfo
+
+func (m *Param) GetName() string {
+	if m != nil {
+		return m.Name
+	}
+	return ""
+}
+
+func (m *Param) GetDescription() string {
+	if m != nil {
+		return m.Description
+	}
+	return ""
+}
+
+func (m *Param) GetDefaultValue() string {
+	if m != nil {
+		return m.DefaultValue
+	}
+	return ""
+}
+
+func (m *Param) GetRequired() bool {
+	if m != nil {
+		return m.Required
+	}
+	return false
+}
+
 type NamespaceResources struct {
 	//Namespace is mandatory
 	// +required
@@ -91,7 +183,7 @@ func (m *NamespaceResources) Reset()         { *m = NamespaceResources{} }
 func (m *NamespaceResources) String() string { return proto.CompactTextString(m) }
 func (*NamespaceResources) ProtoMessage()    {}
 func (*NamespaceResources) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32f02f0592f6a6cd, []int{1}
+	return fileDescriptor_32f02f0592f6a6cd, []int{2}
 }
 
 func (m *NamespaceResources) XXX_Unmarshal(b []byte) error {
@@ -180,7 +272,7 @@ func (m *Resource) Reset()         { *m = Resource{} }
 func (m *Resource) String() string { return proto.CompactTextString(m) }
 func (*Resource) ProtoMessage()    {}
 func (*Resource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32f02f0592f6a6cd, []int{2}
+	return fileDescriptor_32f02f0592f6a6cd, []int{3}
 }
 
 func (m *Resource) XXX_Unmarshal(b []byte) error {
@@ -278,7 +370,7 @@ func (m *CustomResource) Reset()         { *m = CustomResource{} }
 func (m *CustomResource) String() string { return proto.CompactTextString(m) }
 func (*CustomResource) ProtoMessage()    {}
 func (*CustomResource) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32f02f0592f6a6cd, []int{3}
+	return fileDescriptor_32f02f0592f6a6cd, []int{4}
 }
 
 func (m *CustomResource) XXX_Unmarshal(b []byte) error {
@@ -313,7 +405,7 @@ func (m *CustomResource) GetManifest() string {
 	return ""
 }
 
-//GroupVersionKind can be used to provide GVK of a custom resource
+// GroupVersionKind can be used to provide GVK of a custom resource
 type GroupVersionKind struct {
 	//group -custom resource group
 	// +required
@@ -333,7 +425,7 @@ func (m *GroupVersionKind) Reset()         { *m = GroupVersionKind{} }
 func (m *GroupVersionKind) String() string { return proto.CompactTextString(m) }
 func (*GroupVersionKind) ProtoMessage()    {}
 func (*GroupVersionKind) Descriptor() ([]byte, []int) {
-	return fileDescriptor_32f02f0592f6a6cd, []int{4}
+	return fileDescriptor_32f02f0592f6a6cd, []int{5}
 }
 
 func (m *GroupVersionKind) XXX_Unmarshal(b []byte) error {
@@ -377,6 +469,7 @@ func (m *GroupVersionKind) GetKind() string {
 
 func init() {
 	proto.RegisterType((*NamespaceTemplate)(nil), "namespace.NamespaceTemplate")
+	proto.RegisterType((*Param)(nil), "namespace.Param")
 	proto.RegisterType((*NamespaceResources)(nil), "namespace.NamespaceResources")
 	proto.RegisterType((*Resource)(nil), "namespace.Resource")
 	proto.RegisterType((*CustomResource)(nil), "namespace.CustomResource")
@@ -388,38 +481,43 @@ func init() {
 }
 
 var fileDescriptor_32f02f0592f6a6cd = []byte{
-	// 520 bytes of a gzipped FileDescriptorProto
-	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x94, 0x4f, 0x8f, 0xd3, 0x3c,
-	0x10, 0xc6, 0x95, 0xb7, 0xbb, 0x2f, 0x9b, 0xa9, 0x28, 0x5b, 0xc3, 0xc1, 0x2c, 0xff, 0x4a, 0x2e,
-	0xf4, 0xb0, 0x24, 0xea, 0x22, 0x04, 0x12, 0x07, 0xd4, 0xe5, 0x50, 0x89, 0x95, 0x58, 0x30, 0xa8,
-	0x07, 0x38, 0xb9, 0xc9, 0x10, 0x4c, 0x1b, 0xdb, 0x72, 0x9c, 0x8a, 0xbd, 0x22, 0xbe, 0x14, 0xdf,
-	0x0e, 0xd9, 0x4d, 0xda, 0xb4, 0xdb, 0x3d, 0xd5, 0x9e, 0xf9, 0x3d, 0x8f, 0x9f, 0xc9, 0x48, 0x85,
-	0x67, 0x7a, 0x9e, 0x27, 0xb9, 0xd1, 0x69, 0xa2, 0x8d, 0xb2, 0x2a, 0x91, 0xbc, 0xc0, 0x52, 0xf3,
-	0x14, 0x13, 0x8b, 0x85, 0x5e, 0x70, 0x8b, 0xb1, 0x6f, 0x90, 0x70, 0xdd, 0x39, 0x89, 0xe6, 0xaf,
-	0xcb, 0x58, 0xa8, 0x84, 0x6b, 0x91, 0xa4, 0xca, 0x60, 0xb2, 0x1c, 0x25, 0x39, 0x4a, 0x34, 0xdc,
-	0x62, 0xb6, 0xc2, 0xb7, 0x18, 0x33, 0xe3, 0xe9, 0x1e, 0x26, 0xfa, 0x1d, 0x40, 0xff, 0x43, 0xe3,
-	0xfa, 0xa5, 0x7e, 0x8e, 0x9c, 0x42, 0x1f, 0x7f, 0x69, 0x65, 0x2c, 0x66, 0x1f, 0xb9, 0xe1, 0x85,
-	0x23, 0x68, 0x30, 0xe8, 0x0c, 0x43, 0x76, 0xbd, 0x41, 0xde, 0x42, 0x57, 0x96, 0x0c, 0x4b, 0x55,
-	0x99, 0x14, 0x4b, 0xfa, 0xdf, 0x20, 0x18, 0x76, 0xcf, 0x1e, 0xc5, 0xeb, 0xb0, 0xf1, 0xfa, 0x81,
-	0x35, 0xc4, 0xda, 0x8a, 0xe8, 0x4f, 0x00, 0xe4, 0x3a, 0x43, 0xde, 0xc0, 0x66, 0x60, 0x1a, 0xd4,
-	0xae, 0xab, 0x99, 0x62, 0xae, 0x45, 0xec, 0xe6, 0x8e, 0x97, 0xa3, 0x96, 0xfd, 0x86, 0x27, 0x23,
-	0x08, 0x4d, 0x2b, 0x52, 0x67, 0xd8, 0x3d, 0xbb, 0xdb, 0x8a, 0xd4, 0xbc, 0xc2, 0x36, 0x54, 0xf4,
-	0xb7, 0x03, 0x47, 0x4d, 0x9d, 0xbc, 0x87, 0x5e, 0x89, 0x66, 0x29, 0x52, 0x1c, 0xa7, 0xa9, 0xaa,
-	0xa4, 0xad, 0x13, 0x44, 0xfb, 0x12, 0x7c, 0xde, 0x22, 0xd9, 0x8e, 0x92, 0x9c, 0xc2, 0x81, 0x51,
-	0x0b, 0xac, 0xbf, 0x0c, 0x6d, 0x3b, 0xb8, 0xbd, 0x38, 0x07, 0xa6, 0x16, 0xc8, 0x3c, 0x45, 0xc6,
-	0xd0, 0x75, 0xbf, 0xe7, 0x42, 0x66, 0x42, 0xe6, 0xb4, 0xe3, 0x45, 0x4f, 0x6e, 0x12, 0xd5, 0x18,
-	0x6b, 0x6b, 0xc8, 0x04, 0x6e, 0x37, 0x63, 0x7d, 0xaa, 0x94, 0xe5, 0xf4, 0xc0, 0x9b, 0x3c, 0xdd,
-	0x97, 0x9d, 0xb5, 0x41, 0xb6, 0xad, 0x23, 0x63, 0xe8, 0xa5, 0x55, 0x69, 0x55, 0xd1, 0x50, 0xf4,
-	0xd0, 0x3b, 0xdd, 0x6f, 0x7d, 0xca, 0x77, 0x5b, 0x00, 0xdb, 0x11, 0x10, 0x02, 0x07, 0x8e, 0xa5,
-	0x77, 0x06, 0xc1, 0x30, 0x64, 0xfe, 0xec, 0x6a, 0xf6, 0x4a, 0x23, 0x3d, 0x5e, 0xd5, 0xdc, 0x99,
-	0x3c, 0x84, 0x30, 0x43, 0x8d, 0x32, 0x2b, 0x2f, 0x25, 0xed, 0xfb, 0xc6, 0xa6, 0x40, 0x1e, 0x03,
-	0xa4, 0x06, 0xb9, 0xc5, 0x4b, 0xb9, 0xb8, 0xa2, 0xc4, 0xb7, 0x5b, 0x95, 0xe8, 0x1b, 0xf4, 0xb6,
-	0x73, 0x90, 0xe7, 0xd0, 0x99, 0x4c, 0x2f, 0xea, 0xad, 0x3d, 0x68, 0xe5, 0x9d, 0x18, 0x55, 0xe9,
-	0x29, 0x9a, 0x52, 0x28, 0x79, 0x21, 0x64, 0xc6, 0x1c, 0x47, 0x4e, 0xe0, 0xa8, 0xe0, 0x52, 0x7c,
-	0xc7, 0xd2, 0xfa, 0x3d, 0x85, 0x6c, 0x7d, 0x8f, 0xa6, 0x70, 0xbc, 0x2b, 0x22, 0xf7, 0xe0, 0x30,
-	0x77, 0x35, 0xff, 0x40, 0xc8, 0x56, 0x17, 0x42, 0xe1, 0xd6, 0x72, 0x05, 0xd5, 0x26, 0xcd, 0xd5,
-	0x8d, 0x3c, 0x17, 0x32, 0xf3, 0xeb, 0x0c, 0x99, 0x3f, 0x9f, 0xbf, 0xfa, 0xfa, 0x32, 0x17, 0xf6,
-	0x47, 0x35, 0x8b, 0x53, 0x55, 0x24, 0x73, 0x14, 0x73, 0xa5, 0x8d, 0xfa, 0x99, 0x14, 0x5c, 0xf2,
-	0x1c, 0x4d, 0x72, 0xd3, 0xff, 0xc2, 0xec, 0x7f, 0x5f, 0x78, 0xf1, 0x2f, 0x00, 0x00, 0xff, 0xff,
-	0x6b, 0x12, 0x53, 0xb7, 0x3a, 0x04, 0x00, 0x00,
+	// 599 bytes of a gzipped FileDescriptorProto
+	0x1f, 0x8b, 0x08, 0x00, 0x00, 0x00, 0x00, 0x00, 0x02, 0xff, 0x74, 0x54, 0x4d, 0x6f, 0xd3, 0x4c,
+	0x10, 0x96, 0x9b, 0xb4, 0x6f, 0x33, 0x7e, 0x29, 0xed, 0xc2, 0xc1, 0x94, 0xaf, 0xe0, 0x0b, 0x39,
+	0x14, 0x5b, 0x0d, 0x42, 0x20, 0x71, 0x40, 0x2d, 0x87, 0x4a, 0x54, 0xa2, 0xb0, 0xa0, 0x1c, 0xe0,
+	0xb4, 0xb5, 0xa7, 0x66, 0x49, 0xbc, 0xbb, 0xac, 0xd7, 0x11, 0x3d, 0x70, 0xe3, 0x4f, 0xf1, 0x27,
+	0xf8, 0x4d, 0x68, 0x9d, 0x8d, 0xb3, 0x4e, 0xdb, 0x53, 0x76, 0x9e, 0x79, 0x66, 0xe6, 0x99, 0x0f,
+	0x07, 0x9e, 0xaa, 0x69, 0x91, 0x16, 0x5a, 0x65, 0xa9, 0xd2, 0xd2, 0xc8, 0x54, 0xb0, 0x12, 0x2b,
+	0xc5, 0x32, 0x4c, 0x0d, 0x96, 0x6a, 0xc6, 0x0c, 0x26, 0x8d, 0x83, 0x0c, 0x5a, 0xcf, 0x7e, 0x3c,
+	0x7d, 0x55, 0x25, 0x5c, 0xa6, 0x4c, 0xf1, 0x34, 0x93, 0x1a, 0xd3, 0xf9, 0x61, 0x5a, 0xa0, 0x40,
+	0xcd, 0x0c, 0xe6, 0x0b, 0x7a, 0x87, 0xa3, 0xcf, 0x59, 0x76, 0x0d, 0x27, 0xfe, 0x1b, 0xc0, 0xde,
+	0xfb, 0x65, 0xd6, 0xcf, 0xae, 0x1c, 0x39, 0x80, 0x3d, 0xfc, 0xa9, 0xa4, 0x36, 0x98, 0x7f, 0x60,
+	0x9a, 0x95, 0x96, 0x11, 0x05, 0xc3, 0xde, 0x68, 0x40, 0xaf, 0x3a, 0xc8, 0x1b, 0x08, 0x45, 0x45,
+	0xb1, 0x92, 0xb5, 0xce, 0xb0, 0x8a, 0x36, 0x86, 0xc1, 0x28, 0x1c, 0x3f, 0x4c, 0x5a, 0xb1, 0x49,
+	0x5b, 0xa0, 0x25, 0x51, 0x3f, 0x82, 0x0c, 0x21, 0xcc, 0xb1, 0xca, 0x34, 0x57, 0x86, 0x4b, 0x11,
+	0xf5, 0x86, 0xc1, 0x68, 0x40, 0x7d, 0x88, 0x8c, 0x60, 0x4b, 0xd9, 0x7a, 0x55, 0xd4, 0x1f, 0xf6,
+	0x46, 0xe1, 0x78, 0xd7, 0xcb, 0xde, 0x08, 0xa1, 0xce, 0x1f, 0xff, 0x82, 0xcd, 0x06, 0x20, 0x04,
+	0xfa, 0x62, 0x21, 0xdb, 0x66, 0x6b, 0xde, 0xeb, 0x85, 0x36, 0xae, 0x16, 0x8a, 0xe1, 0xff, 0x1c,
+	0x2f, 0x58, 0x3d, 0x33, 0x13, 0x36, 0xab, 0xd1, 0x69, 0xe9, 0x60, 0x64, 0x1f, 0xb6, 0x35, 0xfe,
+	0xa8, 0xb9, 0xc6, 0x3c, 0xea, 0x0f, 0x83, 0xd1, 0x36, 0x6d, 0xed, 0xf8, 0x77, 0x00, 0xe4, 0x6a,
+	0xbb, 0xe4, 0x35, 0xac, 0x76, 0x17, 0x05, 0x6e, 0x40, 0x8b, 0xf5, 0x24, 0x4c, 0xf1, 0xc4, 0xae,
+	0x30, 0x99, 0x1f, 0x7a, 0x93, 0x5a, 0xf1, 0xc9, 0x21, 0x0c, 0xb4, 0x37, 0x5d, 0xdb, 0xff, 0x1d,
+	0xaf, 0xff, 0x65, 0x15, 0xba, 0x62, 0xc5, 0x7f, 0x7a, 0xb0, 0xbd, 0xc4, 0xc9, 0x3b, 0xd8, 0xa9,
+	0x50, 0xcf, 0x79, 0x86, 0x47, 0x59, 0x26, 0x6b, 0x61, 0x9c, 0x82, 0xf8, 0x3a, 0x05, 0x9f, 0x3a,
+	0x4c, 0xba, 0x16, 0x49, 0x0e, 0xa0, 0xaf, 0xe5, 0x0c, 0xdd, 0x92, 0x23, 0x3f, 0x83, 0x3d, 0x31,
+	0x9b, 0x81, 0xca, 0x19, 0xd2, 0x86, 0x45, 0x8e, 0x20, 0xb4, 0xbf, 0xc7, 0x5c, 0xe4, 0x5c, 0x14,
+	0xcd, 0x30, 0xc3, 0xf1, 0xe3, 0x9b, 0x82, 0x1c, 0x8d, 0xfa, 0x31, 0xe4, 0x04, 0x6e, 0x2d, 0xdb,
+	0xfa, 0x58, 0x4b, 0xc3, 0x9a, 0x89, 0x87, 0xe3, 0x27, 0xd7, 0x69, 0xa7, 0x3e, 0x91, 0x76, 0xe3,
+	0xc8, 0x11, 0xec, 0x64, 0x75, 0x65, 0x64, 0xb9, 0x64, 0x45, 0x9b, 0x4d, 0xa6, 0x7b, 0xde, 0x28,
+	0xdf, 0x76, 0x08, 0x74, 0x2d, 0xa0, 0x3d, 0xa9, 0xdb, 0xde, 0x49, 0x11, 0xe8, 0x9b, 0x4b, 0x85,
+	0xd1, 0xee, 0x02, 0xb3, 0x6f, 0xf2, 0x00, 0x06, 0x39, 0x2a, 0x14, 0x79, 0x75, 0x26, 0xa2, 0xbd,
+	0xc6, 0xb1, 0x02, 0xc8, 0x23, 0x80, 0x4c, 0x23, 0x33, 0x78, 0x26, 0x66, 0x97, 0x11, 0x69, 0xdc,
+	0x1e, 0x12, 0x7f, 0x85, 0x9d, 0xae, 0x0e, 0xf2, 0x0c, 0x7a, 0x27, 0x93, 0x53, 0xb7, 0xb5, 0xfb,
+	0x9e, 0xde, 0x13, 0x2d, 0x6b, 0x35, 0x41, 0x5d, 0x71, 0x29, 0x4e, 0xb9, 0xc8, 0xa9, 0xe5, 0xd9,
+	0xfb, 0x2c, 0x99, 0xe0, 0x17, 0x58, 0x19, 0x77, 0xe2, 0xad, 0x1d, 0x4f, 0x60, 0x77, 0x3d, 0x88,
+	0xdc, 0x85, 0xcd, 0xc2, 0x62, 0xee, 0x53, 0x59, 0x18, 0x24, 0x82, 0xff, 0xe6, 0x0b, 0x92, 0x4b,
+	0xb2, 0x34, 0x6d, 0xcb, 0x53, 0x2e, 0x72, 0xf7, 0x6d, 0x34, 0xef, 0xe3, 0x97, 0x5f, 0x5e, 0x14,
+	0xdc, 0x7c, 0xab, 0xcf, 0x93, 0x4c, 0x96, 0xe9, 0x14, 0xf9, 0x54, 0x2a, 0x2d, 0xbf, 0xa7, 0x25,
+	0x13, 0xac, 0x40, 0x9d, 0xde, 0xf4, 0x17, 0x77, 0xbe, 0xd5, 0x00, 0xcf, 0xff, 0x0d, 0x00, 0x14,
+	0x3b, 0x59, 0x56, 0x05, 0x05, 0x00, 0x00,
 }
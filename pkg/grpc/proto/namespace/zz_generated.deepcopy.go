@@ -20,8 +20,9 @@ limitations under the License.
 package namespace
 
 import (
-	"k8s.io/api/core/v1"
+	corev1 "k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
@@ -86,6 +87,16 @@ func (in *Namespace) DeepCopyInto(out *Namespace) {
 		*out = new(NamespaceResources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.ClusterSelector != nil {
+		in, out := &in.ClusterSelector, &out.ClusterSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ClusterNames != nil {
+		in, out := &in.ClusterNames, &out.ClusterNames
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	out.XXX_NoUnkeyedLiteral = in.XXX_NoUnkeyedLiteral
 	if in.XXX_unrecognized != nil {
 		in, out := &in.XXX_unrecognized, &out.XXX_unrecognized
@@ -109,7 +120,7 @@ func (in *NamespaceResources) DeepCopyInto(out *NamespaceResources) {
 	*out = *in
 	if in.Namespace != nil {
 		in, out := &in.Namespace, &out.Namespace
-		*out = new(v1.Namespace)
+		*out = new(corev1.Namespace)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Resources != nil {
@@ -154,6 +165,17 @@ func (in *NamespaceTemplate) DeepCopyInto(out *NamespaceTemplate) {
 		*out = new(NamespaceResources)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Params != nil {
+		in, out := &in.Params, &out.Params
+		*out = make([]*Param, len(*in))
+		for i := range *in {
+			if (*in)[i] != nil {
+				in, out := &(*in)[i], &(*out)[i]
+				*out = new(Param)
+				(*in).DeepCopyInto(*out)
+			}
+		}
+	}
 	out.XXX_NoUnkeyedLiteral = in.XXX_NoUnkeyedLiteral
 	if in.XXX_unrecognized != nil {
 		in, out := &in.XXX_unrecognized, &out.XXX_unrecognized
@@ -172,12 +194,33 @@ func (in *NamespaceTemplate) DeepCopy() *NamespaceTemplate {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Param) DeepCopyInto(out *Param) {
+	*out = *in
+	out.XXX_NoUnkeyedLiteral = in.XXX_NoUnkeyedLiteral
+	if in.XXX_unrecognized != nil {
+		in, out := &in.XXX_unrecognized, &out.XXX_unrecognized
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Param.
+func (in *Param) DeepCopy() *Param {
+	if in == nil {
+		return nil
+	}
+	out := new(Param)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *Resource) DeepCopyInto(out *Resource) {
 	*out = *in
 	if in.ServiceAccount != nil {
 		in, out := &in.ServiceAccount, &out.ServiceAccount
-		*out = new(v1.ServiceAccount)
+		*out = new(corev1.ServiceAccount)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.Role != nil {
@@ -192,7 +235,7 @@ func (in *Resource) DeepCopyInto(out *Resource) {
 	}
 	if in.ResourceQuota != nil {
 		in, out := &in.ResourceQuota, &out.ResourceQuota
-		*out = new(v1.ResourceQuota)
+		*out = new(corev1.ResourceQuota)
 		(*in).DeepCopyInto(*out)
 	}
 	if in.CustomResource != nil {
@@ -0,0 +1,38 @@
+package k8s
+
+import (
+	"context"
+	"reflect"
+
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+const (
+	//Kinds of the objects recorded in the audit trail which are not in the config constants
+	namespaceKind         = "Namespace"
+	secretKind            = "Secret"
+	managedNamespaceKind  = "ManagedNamespace"
+	applicationKind       = "Application"
+	namespaceTemplateKind = "NamespaceTemplate"
+)
+
+//recordChange records the change made with the client in the audit trail and marks the span failed on error
+//Changes made with the control plane client are attributed to the cluster when the object is the cluster itself
+func (c *Client) recordChange(ctx context.Context, action string, kind string, ns string, name string, before interface{}, after interface{}, err error) {
+	cluster := c.cluster
+	if cluster == "" && kind == audit.ClusterKind {
+		cluster = name
+	}
+	tracing.RecordError(ctx, err)
+	audit.RecordChange(ctx, cluster, action, audit.Object{Kind: kind, Namespace: ns, Name: name}, before, after, err)
+}
+
+//kindOf returns the kind of the object. Typed objects returned by the client don't have the type meta populated
+func kindOf(obj runtime.Object) string {
+	if kind := obj.GetObjectKind().GroupVersionKind().Kind; kind != "" {
+		return kind
+	}
+	return reflect.Indirect(reflect.ValueOf(obj)).Type().Name()
+}
@@ -3,28 +3,27 @@ package k8s
 import (
 	"fmt"
 	"github.com/keikoproj/manager/api/v1alpha1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/client-go/kubernetes"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/clientcmd"
 	"os"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
 )
 
 type Client struct {
 	cl            kubernetes.Interface
 	runtimeClient client.Client
+	mapper        meta.RESTMapper
+	//cluster is the managed cluster the client is connected to. Empty for the control plane cluster
+	cluster string
 }
 
 //NewK8sSelfClientDoOrDie gets the new k8s go client
 func NewK8sSelfClientDoOrDie() *Client {
-	config, err := rest.InClusterConfig()
-	if err != nil {
-		fmt.Println("THIS IS LOCAL")
-		// Do i need to panic here?
-		//How do i test this from local?
-		//Lets get it from local config file
-		config, err = clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
-	}
+	config := selfRestConfig()
 	cl, err := kubernetes.NewForConfig(config)
 	if err != nil {
 		panic(err)
@@ -51,26 +50,81 @@ func NewK8sSelfClientDoOrDie() *Client {
 	return k8sCl
 }
 
+//NewK8sSelfCache returns the informer cache for the manager custom resources
+//Cache must be started by the caller
+func NewK8sSelfCache() (cache.Cache, error) {
+	scheme, err := v1alpha1.SchemeBuilder.Build()
+	if err != nil {
+		return nil, err
+	}
+	return cache.New(selfRestConfig(), cache.Options{
+		Scheme: scheme,
+	})
+}
+
+//selfRestConfig returns the in cluster config or the local kube config if it is running outside of the cluster
+func selfRestConfig() *rest.Config {
+	config, err := rest.InClusterConfig()
+	if err != nil {
+		fmt.Println("THIS IS LOCAL")
+		// Do i need to panic here?
+		//How do i test this from local?
+		//Lets get it from local config file
+		config, err = clientcmd.BuildConfigFromFlags("", os.Getenv("KUBECONFIG"))
+		if err != nil {
+			panic(err)
+		}
+	}
+	return config
+}
+
 //NewK8sManagedClusterClientDoOrDie creates a client for managed cluster or config passed
 func NewK8sManagedClusterClientDoOrDie(config *rest.Config) *Client {
-	cl, err := kubernetes.NewForConfig(config)
+	k8sCl, err := NewK8sManagedClusterClient(config)
 	if err != nil {
 		panic(err)
 	}
+	return k8sCl
+}
+
+//NewK8sManagedClusterClient creates a client for managed cluster or config passed
+//REST mappings are discovered lazily and shared by all the users of the returned client
+func NewK8sManagedClusterClient(config *rest.Config) (*Client, error) {
+	cl, err := kubernetes.NewForConfig(config)
+	if err != nil {
+		return nil, err
+	}
+
+	mapper, err := apiutil.NewDynamicRESTMapper(config, apiutil.WithLazyDiscovery)
+	if err != nil {
+		return nil, err
+	}
 
 	//This is used for custom resources
 	//https://godoc.org/sigs.k8s.io/controller-runtime/pkg/client#New
-	dClient, err := client.New(config, client.Options{})
+	dClient, err := client.New(config, client.Options{Mapper: mapper})
 	if err != nil {
-		panic(err)
+		return nil, err
 	}
 
 	k8sCl := &Client{
 		cl:            cl,
 		runtimeClient: dClient,
+		mapper:        mapper,
 	}
 
-	return k8sCl
+	return k8sCl, nil
+}
+
+//NewK8sClient creates a client around the given clientset. It is used to plug in the fake clientset in the tests
+//Custom resource functions are not available with this client
+func NewK8sClient(cl kubernetes.Interface) *Client {
+	return &Client{cl: cl}
+}
+
+//NewK8sSelfClient creates a client around the given clientset and runtime client. It is used to plug in the fake clients in the tests
+func NewK8sSelfClient(cl kubernetes.Interface, runtimeClient client.Client) *Client {
+	return &Client{cl: cl, runtimeClient: runtimeClient}
 }
 
 func (c *Client) ClientInterface() kubernetes.Interface {
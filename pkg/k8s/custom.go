@@ -3,13 +3,15 @@ package k8s
 import (
 	"context"
 	"encoding/json"
-	"errors"
 	"fmt"
 	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/audit"
 	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
 	"github.com/keikoproj/manager/pkg/log"
 	apierr "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/util/retry"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -24,6 +26,8 @@ var (
 
 //CreateOrUpdateClusterCR creates cluster custom resource
 func (c *Client) CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.Cluster, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateManagedCluster")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateOrUpdateManagedCluster")
 	cr.SetNamespace(ns)
 	cr.SetGroupVersionKind(cr.TypeMeta.GroupVersionKind())
@@ -32,10 +36,12 @@ func (c *Client) CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the managed cluster")
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, audit.ClusterKind, ns, cr.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("managed cluster already exists. Trying to update")
 
+		var before *v1alpha1.Cluster
 		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 
 			temp := v1alpha1.Cluster{}
@@ -48,6 +54,7 @@ func (c *Client) CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.
 				log.Error(err, "unable to get the managed cluster")
 				return err
 			}
+			before = &temp
 			rV := temp.GetResourceVersion()
 			cr.SetResourceVersion(rV)
 
@@ -60,10 +67,13 @@ func (c *Client) CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.
 			return nil
 		})
 
+		c.recordChange(ctx, audit.Update, audit.ClusterKind, ns, cr.Name, before, cr, retryErr)
 		if retryErr != nil {
 			log.Error(retryErr, "unable to update the cluster CR")
 			return retryErr
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, audit.ClusterKind, ns, cr.Name, nil, cr, nil)
 	}
 	log.Info("Successfully created/updated managed cluster", "name", cr.Name)
 	return nil
@@ -71,6 +81,8 @@ func (c *Client) CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.
 
 //CreateCustomResource creates a custom resource
 func (c *Client) CreateOrUpdateCustomResource(ctx context.Context, cr *namespace.CustomResource, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateCustomResource")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateCustomResource")
 
 	jsonMap := make(map[string]interface{})
@@ -96,7 +108,8 @@ func (c *Client) CreateOrUpdateCustomResource(ctx context.Context, cr *namespace
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the custom resource")
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, cr.GVK.Kind, ns, name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("custom resource already exists. Trying to update")
 
@@ -109,16 +122,20 @@ func (c *Client) CreateOrUpdateCustomResource(ctx context.Context, cr *namespace
 			log.Error(err, "unable to get the custom resource")
 			return err
 		}
+		before := u.DeepCopy()
 		rV := u.GetResourceVersion()
 		u.SetUnstructuredContent(jsonMap)
 		u.SetResourceVersion(rV)
 
 		log.Info("custom resource ", "custom", u)
 		err = c.runtimeClient.Update(ctx, u)
+		c.recordChange(ctx, audit.Update, cr.GVK.Kind, ns, name, before, u, err)
 		if err != nil {
 			log.Error(err, "unable to update the custom resource")
 			return err
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, cr.GVK.Kind, ns, name, nil, u, nil)
 	}
 	log.Info("Successfully created custom resource", "name", name)
 	return nil
@@ -126,6 +143,8 @@ func (c *Client) CreateOrUpdateCustomResource(ctx context.Context, cr *namespace
 
 //CreateOrUpdateManagedNamespace creates/updates managed namespace
 func (c *Client) CreateOrUpdateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateManagedNamespace")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateOrUpdateManagedNamespace")
 	cr.SetNamespace(ns)
 	cr.SetGroupVersionKind(cr.TypeMeta.GroupVersionKind())
@@ -134,7 +153,8 @@ func (c *Client) CreateOrUpdateManagedNamespace(ctx context.Context, cr *v1alpha
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the managed namespace")
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, managedNamespaceKind, ns, cr.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("managed namespace already exists. Trying to update")
 		temp := v1alpha1.ManagedNamespace{}
@@ -151,10 +171,13 @@ func (c *Client) CreateOrUpdateManagedNamespace(ctx context.Context, cr *v1alpha
 		cr.SetResourceVersion(rV)
 
 		err = c.runtimeClient.Update(ctx, cr)
+		c.recordChange(ctx, audit.Update, managedNamespaceKind, ns, cr.Name, &temp, cr, err)
 		if err != nil {
 			log.Error(err, "unable to update the managed namespace")
 			return err
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, managedNamespaceKind, ns, cr.Name, nil, cr, nil)
 	}
 	log.Info("Successfully created/updated managed namespace", "name", cr.Name)
 	return nil
@@ -162,6 +185,8 @@ func (c *Client) CreateOrUpdateManagedNamespace(ctx context.Context, cr *v1alpha
 
 //DeleteManagedCluster deletes managed cluster with propagation policy foreground
 func (c *Client) DeleteManagedCluster(ctx context.Context, cr *v1alpha1.Cluster, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteManagedCluster")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "custom", "DeleteManagedCluster")
 
 	cr.SetNamespace(ns)
@@ -172,6 +197,7 @@ func (c *Client) DeleteManagedCluster(ctx context.Context, cr *v1alpha1.Cluster,
 	newDeleteOpts := &client.DeleteOptions{}
 	dOptions.ApplyToDelete(newDeleteOpts)
 	err := c.runtimeClient.Delete(ctx, cr, newDeleteOpts)
+	c.recordChange(ctx, audit.Delete, audit.ClusterKind, ns, cr.Name, cr, nil, err)
 	if err != nil {
 		log.Error(err, "unable to delete the managed cluster")
 		return err
@@ -179,3 +205,367 @@ func (c *Client) DeleteManagedCluster(ctx context.Context, cr *v1alpha1.Cluster,
 
 	return nil
 }
+
+//ResourceFor maps the kind to the resource in the managed cluster
+func (c *Client) ResourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	if c.mapper == nil {
+		return schema.GroupVersionResource{}, fmt.Errorf("rest mapper is not available to map %s", gvk.String())
+	}
+	mapping, err := c.mapper.RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		return schema.GroupVersionResource{}, err
+	}
+	return mapping.Resource, nil
+}
+
+//AddOwnerReference adds the owner reference to the object if it is not present already
+//obj is used to retrieve the latest version of the object identified by the key
+func (c *Client) AddOwnerReference(ctx context.Context, obj runtime.Object, key client.ObjectKey, owner metav1.OwnerReference) error {
+	ctx, span := c.startSpan(ctx, "AddOwnerReference")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "AddOwnerReference")
+	log = log.WithValues("name", key.Name, "namespace", key.Namespace, "owner", owner.Name)
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		if err := c.runtimeClient.Get(ctx, key, obj); err != nil {
+			if apierr.IsNotFound(err) {
+				log.Info("Object doesn't exist anymore")
+				return nil
+			}
+			log.Error(err, "unable to get the object")
+			return err
+		}
+		accessor, err := meta.Accessor(obj)
+		if err != nil {
+			return err
+		}
+		owners := accessor.GetOwnerReferences()
+		for _, o := range owners {
+			if o.UID == owner.UID {
+				return nil
+			}
+		}
+		before := obj.DeepCopyObject()
+		accessor.SetOwnerReferences(append(owners, owner))
+		err = c.runtimeClient.Update(ctx, obj)
+		//Conflicts are retried so only the final result is recorded
+		if !apierr.IsConflict(err) {
+			c.recordChange(ctx, audit.Update, kindOf(obj), key.Namespace, key.Name, before, obj, err)
+		}
+		if err != nil {
+			log.Error(err, "unable to add the owner reference")
+			return err
+		}
+		log.V(1).Info("Owner reference added")
+		return nil
+	})
+}
+
+//GetManagedCluster returns the managed cluster
+func (c *Client) GetManagedCluster(ctx context.Context, name string, ns string) (*v1alpha1.Cluster, error) {
+	ctx, span := c.startSpan(ctx, "GetManagedCluster")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "GetManagedCluster")
+
+	cr := &v1alpha1.Cluster{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, cr); err != nil {
+		log.Error(err, "unable to get the managed cluster", "name", name)
+		return nil, err
+	}
+	return cr, nil
+}
+
+//ListManagedClusters returns all the managed clusters in the namespace
+func (c *Client) ListManagedClusters(ctx context.Context, ns string) ([]v1alpha1.Cluster, error) {
+	ctx, span := c.startSpan(ctx, "ListManagedClusters")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "ListManagedClusters")
+
+	list := &v1alpha1.ClusterList{}
+	if err := c.runtimeClient.List(ctx, list, client.InNamespace(ns)); err != nil {
+		log.Error(err, "unable to list the managed clusters")
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+//ListManagedNamespaces returns all the managed namespaces in the namespace
+func (c *Client) ListManagedNamespaces(ctx context.Context, ns string) ([]v1alpha1.ManagedNamespace, error) {
+	ctx, span := c.startSpan(ctx, "ListManagedNamespaces")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "ListManagedNamespaces")
+
+	list := &v1alpha1.ManagedNamespaceList{}
+	if err := c.runtimeClient.List(ctx, list, client.InNamespace(ns)); err != nil {
+		log.Error(err, "unable to list the managed namespaces")
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+//GetNamespaceTemplate returns the namespace template
+func (c *Client) GetNamespaceTemplate(ctx context.Context, name string) (*v1alpha1.NamespaceTemplate, error) {
+	ctx, span := c.startSpan(ctx, "GetNamespaceTemplate")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "GetNamespaceTemplate")
+
+	cr := &v1alpha1.NamespaceTemplate{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Name: name}, cr); err != nil {
+		log.Error(err, "unable to get the namespace template", "name", name)
+		return nil, err
+	}
+	return cr, nil
+}
+
+//UpdateManagedClusterStatus applies the changes to the latest version of the managed cluster status
+func (c *Client) UpdateManagedClusterStatus(ctx context.Context, key client.ObjectKey, mutate func(cr *v1alpha1.Cluster)) error {
+	ctx, span := c.startSpan(ctx, "UpdateManagedClusterStatus")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "UpdateManagedClusterStatus")
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cr := &v1alpha1.Cluster{}
+		if err := c.runtimeClient.Get(ctx, key, cr); err != nil {
+			log.Error(err, "unable to get the managed cluster", "name", key.Name)
+			return err
+		}
+		mutate(cr)
+		if err := c.runtimeClient.Status().Update(ctx, cr); err != nil {
+			log.Error(err, "unable to update the managed cluster status", "name", key.Name)
+			return err
+		}
+		return nil
+	})
+}
+
+//UpdateManagedNamespaceStatus applies the changes to the latest version of the managed namespace status
+func (c *Client) UpdateManagedNamespaceStatus(ctx context.Context, key client.ObjectKey, mutate func(cr *v1alpha1.ManagedNamespace)) error {
+	ctx, span := c.startSpan(ctx, "UpdateManagedNamespaceStatus")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "UpdateManagedNamespaceStatus")
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cr := &v1alpha1.ManagedNamespace{}
+		if err := c.runtimeClient.Get(ctx, key, cr); err != nil {
+			log.Error(err, "unable to get the managed namespace", "name", key.Name)
+			return err
+		}
+		mutate(cr)
+		if err := c.runtimeClient.Status().Update(ctx, cr); err != nil {
+			log.Error(err, "unable to update the managed namespace status", "name", key.Name)
+			return err
+		}
+		return nil
+	})
+}
+
+//CreateManagedNamespace creates managed namespace and fails if it exists already
+func (c *Client) CreateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateManagedNamespace")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateManagedNamespace")
+	cr.SetNamespace(ns)
+	err := c.runtimeClient.Create(ctx, cr)
+	c.recordChange(ctx, audit.Create, managedNamespaceKind, ns, cr.Name, nil, cr, err)
+	if err != nil {
+		log.Error(err, "unable to create the managed namespace", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully created managed namespace", "name", cr.Name)
+	return nil
+}
+
+//GetManagedNamespace returns the managed namespace
+func (c *Client) GetManagedNamespace(ctx context.Context, name string, ns string) (*v1alpha1.ManagedNamespace, error) {
+	ctx, span := c.startSpan(ctx, "GetManagedNamespace")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "GetManagedNamespace")
+
+	cr := &v1alpha1.ManagedNamespace{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Namespace: ns, Name: name}, cr); err != nil {
+		log.Error(err, "unable to get the managed namespace", "name", name)
+		return nil, err
+	}
+	return cr, nil
+}
+
+//UpdateManagedNamespace updates managed namespace. Update fails with conflict if the resource version is outdated
+func (c *Client) UpdateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace) error {
+	ctx, span := c.startSpan(ctx, "UpdateManagedNamespace")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "UpdateManagedNamespace")
+	before := &v1alpha1.ManagedNamespace{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Namespace: cr.Namespace, Name: cr.Name}, before); err != nil {
+		before = nil
+	}
+	err := c.runtimeClient.Update(ctx, cr)
+	c.recordChange(ctx, audit.Update, managedNamespaceKind, cr.Namespace, cr.Name, before, cr, err)
+	if err != nil {
+		log.Error(err, "unable to update the managed namespace", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully updated managed namespace", "name", cr.Name)
+	return nil
+}
+
+//DeleteManagedNamespace deletes managed namespace
+func (c *Client) DeleteManagedNamespace(ctx context.Context, name string, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteManagedNamespace")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "DeleteManagedNamespace")
+	cr := &v1alpha1.ManagedNamespace{}
+	cr.SetName(name)
+	cr.SetNamespace(ns)
+	err := c.runtimeClient.Delete(ctx, cr)
+	c.recordChange(ctx, audit.Delete, managedNamespaceKind, ns, name, nil, nil, err)
+	if err != nil {
+		log.Error(err, "unable to delete the managed namespace", "name", name)
+		return err
+	}
+	log.Info("Successfully deleted managed namespace", "name", name)
+	return nil
+}
+
+//CreateApplication creates application and fails if it exists already
+func (c *Client) CreateApplication(ctx context.Context, cr *v1alpha1.Application) error {
+	ctx, span := c.startSpan(ctx, "CreateApplication")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateApplication")
+	err := c.runtimeClient.Create(ctx, cr)
+	c.recordChange(ctx, audit.Create, applicationKind, "", cr.Name, nil, cr, err)
+	if err != nil {
+		log.Error(err, "unable to create the application", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully created application", "name", cr.Name)
+	return nil
+}
+
+//GetApplication returns the application
+func (c *Client) GetApplication(ctx context.Context, name string) (*v1alpha1.Application, error) {
+	ctx, span := c.startSpan(ctx, "GetApplication")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "GetApplication")
+
+	cr := &v1alpha1.Application{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Name: name}, cr); err != nil {
+		log.Error(err, "unable to get the application", "name", name)
+		return nil, err
+	}
+	return cr, nil
+}
+
+//ListApplications returns all the applications
+func (c *Client) ListApplications(ctx context.Context) ([]v1alpha1.Application, error) {
+	ctx, span := c.startSpan(ctx, "ListApplications")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "ListApplications")
+
+	list := &v1alpha1.ApplicationList{}
+	if err := c.runtimeClient.List(ctx, list); err != nil {
+		log.Error(err, "unable to list the applications")
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+//UpdateApplication updates application. Update fails with conflict if the resource version is outdated
+func (c *Client) UpdateApplication(ctx context.Context, cr *v1alpha1.Application) error {
+	ctx, span := c.startSpan(ctx, "UpdateApplication")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "UpdateApplication")
+	before := &v1alpha1.Application{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Name: cr.Name}, before); err != nil {
+		before = nil
+	}
+	err := c.runtimeClient.Update(ctx, cr)
+	c.recordChange(ctx, audit.Update, applicationKind, "", cr.Name, before, cr, err)
+	if err != nil {
+		log.Error(err, "unable to update the application", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully updated application", "name", cr.Name)
+	return nil
+}
+
+//DeleteApplication deletes application
+func (c *Client) DeleteApplication(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "DeleteApplication")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "DeleteApplication")
+	cr := &v1alpha1.Application{}
+	cr.SetName(name)
+	err := c.runtimeClient.Delete(ctx, cr)
+	c.recordChange(ctx, audit.Delete, applicationKind, "", name, nil, nil, err)
+	if err != nil {
+		log.Error(err, "unable to delete the application", "name", name)
+		return err
+	}
+	log.Info("Successfully deleted application", "name", name)
+	return nil
+}
+
+//ListNamespaceTemplates returns all the namespace templates
+func (c *Client) ListNamespaceTemplates(ctx context.Context) ([]v1alpha1.NamespaceTemplate, error) {
+	ctx, span := c.startSpan(ctx, "ListNamespaceTemplates")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "ListNamespaceTemplates")
+
+	list := &v1alpha1.NamespaceTemplateList{}
+	if err := c.runtimeClient.List(ctx, list); err != nil {
+		log.Error(err, "unable to list the namespace templates")
+		return nil, err
+	}
+	return list.Items, nil
+}
+
+//CreateNamespaceTemplate creates namespace template and fails if it exists already
+func (c *Client) CreateNamespaceTemplate(ctx context.Context, cr *v1alpha1.NamespaceTemplate) error {
+	ctx, span := c.startSpan(ctx, "CreateNamespaceTemplate")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "CreateNamespaceTemplate")
+	err := c.runtimeClient.Create(ctx, cr)
+	c.recordChange(ctx, audit.Create, namespaceTemplateKind, "", cr.Name, nil, cr, err)
+	if err != nil {
+		log.Error(err, "unable to create the namespace template", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully created namespace template", "name", cr.Name)
+	return nil
+}
+
+//UpdateNamespaceTemplate updates namespace template. Update fails with conflict if the resource version is outdated
+func (c *Client) UpdateNamespaceTemplate(ctx context.Context, cr *v1alpha1.NamespaceTemplate) error {
+	ctx, span := c.startSpan(ctx, "UpdateNamespaceTemplate")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "UpdateNamespaceTemplate")
+	before := &v1alpha1.NamespaceTemplate{}
+	if err := c.runtimeClient.Get(ctx, client.ObjectKey{Name: cr.Name}, before); err != nil {
+		before = nil
+	}
+	err := c.runtimeClient.Update(ctx, cr)
+	c.recordChange(ctx, audit.Update, namespaceTemplateKind, "", cr.Name, before, cr, err)
+	if err != nil {
+		log.Error(err, "unable to update the namespace template", "name", cr.Name)
+		return err
+	}
+	log.Info("Successfully updated namespace template", "name", cr.Name)
+	return nil
+}
+
+//DeleteNamespaceTemplate deletes namespace template
+func (c *Client) DeleteNamespaceTemplate(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "DeleteNamespaceTemplate")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "custom", "DeleteNamespaceTemplate")
+	cr := &v1alpha1.NamespaceTemplate{}
+	cr.SetName(name)
+	err := c.runtimeClient.Delete(ctx, cr)
+	c.recordChange(ctx, audit.Delete, namespaceTemplateKind, "", name, nil, nil, err)
+	if err != nil {
+		log.Error(err, "unable to delete the namespace template", "name", name)
+		return err
+	}
+	log.Info("Successfully deleted namespace template", "name", name)
+	return nil
+}
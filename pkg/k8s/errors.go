@@ -0,0 +1,36 @@
+package k8s
+
+import (
+	"fmt"
+
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//statusError adds the context to the api server error and keeps its status
+//apierrors.Is* checks don't unwrap the errors in this client-go version, so the status must be on the error itself
+type statusError struct {
+	msg string
+	err error
+}
+
+func (e *statusError) Error() string {
+	return fmt.Sprintf("%s: %v", e.msg, e.err)
+}
+
+func (e *statusError) Unwrap() error {
+	return e.err
+}
+
+//Status returns the status of the api server error
+func (e *statusError) Status() metav1.Status {
+	return e.err.(apierr.APIStatus).Status()
+}
+
+//wrapError adds the context to the error so that the callers can still classify the api server errors
+func wrapError(msg string, err error) error {
+	if _, ok := err.(apierr.APIStatus); ok {
+		return &statusError{msg: msg, err: err}
+	}
+	return fmt.Errorf("%s: %w", msg, err)
+}
@@ -0,0 +1,31 @@
+package k8s
+
+import (
+	"errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	corev1 "k8s.io/api/core/v1"
+	apierr "k8s.io/apimachinery/pkg/api/errors"
+)
+
+var _ = Describe("wrapError", func() {
+	Context("api server error", func() {
+		It("should keep the status of the error", func() {
+			cause := apierr.NewForbidden(corev1.Resource("namespaces"), "team-app-dev", errors.New("denied"))
+			err := wrapError("unable to create the namespace team-app-dev", cause)
+			Expect(err.Error()).To(HavePrefix("unable to create the namespace team-app-dev: "))
+			Expect(apierr.IsForbidden(err)).To(BeTrue())
+			Expect(errors.Is(err, cause)).To(BeTrue())
+		})
+	})
+
+	Context("other errors", func() {
+		It("should wrap the error", func() {
+			cause := errors.New("connection refused")
+			err := wrapError("unable to create the namespace team-app-dev", cause)
+			Expect(err.Error()).To(Equal("unable to create the namespace team-app-dev: connection refused"))
+			Expect(errors.Is(err, cause)).To(BeTrue())
+		})
+	})
+})
@@ -0,0 +1,84 @@
+package k8s
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/pkg/log"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"time"
+
+	authv1 "k8s.io/api/authorization/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//NodeInventory represents the capacity of the cluster
+type NodeInventory struct {
+	NodeCount         int
+	AllocatableCPU    resource.Quantity
+	AllocatableMemory resource.Quantity
+}
+
+//ServerVersion returns the kubernetes version of the api server along with the observed latency
+func (c *Client) ServerVersion(ctx context.Context) (string, time.Duration, error) {
+	ctx, span := c.startSpan(ctx, "ServerVersion")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "health", "ServerVersion")
+
+	start := time.Now()
+	v, err := c.cl.Discovery().ServerVersion()
+	latency := time.Since(start)
+	if err != nil {
+		log.Error(err, "unable to get the server version")
+		return "", latency, err
+	}
+	return fmt.Sprintf("%s.%s", v.Major, v.Minor), latency, nil
+}
+
+//NodeInventory returns the number of nodes and total allocatable cpu and memory
+func (c *Client) NodeInventory(ctx context.Context) (*NodeInventory, error) {
+	ctx, span := c.startSpan(ctx, "NodeInventory")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "health", "NodeInventory")
+
+	nodes, err := c.cl.CoreV1().Nodes().List(metav1.ListOptions{})
+	if err != nil {
+		log.Error(err, "unable to list the nodes")
+		return nil, err
+	}
+	inventory := &NodeInventory{NodeCount: len(nodes.Items)}
+	for _, node := range nodes.Items {
+		if cpu, ok := node.Status.Allocatable[corev1.ResourceCPU]; ok {
+			inventory.AllocatableCPU.Add(cpu)
+		}
+		if memory, ok := node.Status.Allocatable[corev1.ResourceMemory]; ok {
+			inventory.AllocatableMemory.Add(memory)
+		}
+	}
+	return inventory, nil
+}
+
+//DeniedPermissions checks each permission with self subject access review and returns the ones which are not allowed
+func (c *Client) DeniedPermissions(ctx context.Context, permissions []authv1.ResourceAttributes) ([]authv1.ResourceAttributes, error) {
+	ctx, span := c.startSpan(ctx, "DeniedPermissions")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "health", "DeniedPermissions")
+
+	var denied []authv1.ResourceAttributes
+	for i := range permissions {
+		review := &authv1.SelfSubjectAccessReview{
+			Spec: authv1.SelfSubjectAccessReviewSpec{
+				ResourceAttributes: &permissions[i],
+			},
+		}
+		resp, err := c.cl.AuthorizationV1().SelfSubjectAccessReviews().Create(review)
+		if err != nil {
+			log.Error(err, "unable to review the access", "verb", permissions[i].Verb, "resource", permissions[i].Resource)
+			return nil, err
+		}
+		if !resp.Status.Allowed {
+			denied = append(denied, permissions[i])
+		}
+	}
+	return denied, nil
+}
@@ -4,39 +4,83 @@ import (
 	"context"
 	"github.com/keikoproj/manager/api/v1alpha1"
 	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	authv1 "k8s.io/api/authorization/v1"
 	"k8s.io/api/core/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
 	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"time"
 )
 
 //Interface defines required functions to be implemented by receivers
 type Interface interface {
 	SetUpEventHandler(ctx context.Context) record.EventRecorder
 	GetConfigMap(ctx context.Context, ns string, name string) *v1.ConfigMap
+	ListEvents(ctx context.Context, ns string, kind string, name string) ([]v1.Event, error)
 	CreateServiceAccountForCluster(ctx context.Context, saName string, ns string) error
 	CreateServiceAccount(ctx context.Context, sa *v1.ServiceAccount) error
 	DeleteServiceAccount(ctx context.Context, saName string, ns string) error
-	CreateOrUpdateClusterRole(ctx context.Context, name string) error
+	CreateOrUpdateClusterRole(ctx context.Context, name string, rules []rbacv1.PolicyRule) error
+	GetClusterRole(ctx context.Context, name string) (*rbacv1.ClusterRole, error)
 	DeleteClusterRole(ctx context.Context, name string) error
 	CreateOrUpdateClusterRoleBinding(ctx context.Context, name string) error
 	DeleteClusterRoleBinding(ctx context.Context, name string) error
 
 	CreateOrUpdateRole(ctx context.Context, role *rbacv1.Role, ns string) error
 	CreateOrUpdateRoleBinding(ctx context.Context, roleBinding *rbacv1.RoleBinding) error
+	DeleteRole(ctx context.Context, name string, ns string) error
+	DeleteRoleBinding(ctx context.Context, name string, ns string) error
 
 	GetServiceAccountTokenSecret(ctx context.Context, saName string) (string, error)
 	CreateOrUpdateK8sSecret(ctx context.Context, secret *v1.Secret) error
 	GetK8sSecret(ctx context.Context, name string, ns string) (*v1.Secret, error)
+	DeleteK8sSecret(ctx context.Context, name string, ns string) error
+
+	CreateServiceAccountToken(ctx context.Context, name string, saName string, ns string) (*v1.Secret, error)
 
 	CreateOrUpdateNamespace(ctx context.Context, namespace *v1.Namespace) error
 	DeleteNamespace(ctx context.Context, name string) error
 	GetNamespace(ctx context.Context, name string) error
+	Ping(ctx context.Context) error
+
+	ServerVersion(ctx context.Context) (string, time.Duration, error)
+	NodeInventory(ctx context.Context) (*NodeInventory, error)
+	DeniedPermissions(ctx context.Context, permissions []authv1.ResourceAttributes) ([]authv1.ResourceAttributes, error)
+
+	ResourceFor(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error)
+	AddOwnerReference(ctx context.Context, obj runtime.Object, key client.ObjectKey, owner metav1.OwnerReference) error
 
 	CreateOrUpdateResourceQuota(ctx context.Context, quota *v1.ResourceQuota) error
+	ApplyResource(ctx context.Context, res *namespace.Resource, ns string) error
 
 	CreateOrUpdateManagedCluster(ctx context.Context, cr *v1alpha1.Cluster, ns string) error
 	CreateOrUpdateCustomResource(ctx context.Context, cr *namespace.CustomResource, ns string) error
 	CreateOrUpdateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace, ns string) error
 
 	DeleteManagedCluster(ctx context.Context, name string, ns string) error
+
+	GetManagedCluster(ctx context.Context, name string, ns string) (*v1alpha1.Cluster, error)
+	ListManagedClusters(ctx context.Context, ns string) ([]v1alpha1.Cluster, error)
+	ListManagedNamespaces(ctx context.Context, ns string) ([]v1alpha1.ManagedNamespace, error)
+	GetNamespaceTemplate(ctx context.Context, name string) (*v1alpha1.NamespaceTemplate, error)
+	UpdateManagedClusterStatus(ctx context.Context, key client.ObjectKey, mutate func(cr *v1alpha1.Cluster)) error
+	UpdateManagedNamespaceStatus(ctx context.Context, key client.ObjectKey, mutate func(cr *v1alpha1.ManagedNamespace)) error
+	CreateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace, ns string) error
+	GetManagedNamespace(ctx context.Context, name string, ns string) (*v1alpha1.ManagedNamespace, error)
+	UpdateManagedNamespace(ctx context.Context, cr *v1alpha1.ManagedNamespace) error
+	DeleteManagedNamespace(ctx context.Context, name string, ns string) error
+
+	CreateApplication(ctx context.Context, cr *v1alpha1.Application) error
+	GetApplication(ctx context.Context, name string) (*v1alpha1.Application, error)
+	ListApplications(ctx context.Context) ([]v1alpha1.Application, error)
+	UpdateApplication(ctx context.Context, cr *v1alpha1.Application) error
+	DeleteApplication(ctx context.Context, name string) error
+
+	ListNamespaceTemplates(ctx context.Context) ([]v1alpha1.NamespaceTemplate, error)
+	CreateNamespaceTemplate(ctx context.Context, cr *v1alpha1.NamespaceTemplate) error
+	UpdateNamespaceTemplate(ctx context.Context, cr *v1alpha1.NamespaceTemplate) error
+	DeleteNamespaceTemplate(ctx context.Context, name string) error
 }
@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"github.com/keikoproj/manager/pkg/log"
 	"k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/fields"
 	"k8s.io/client-go/kubernetes/scheme"
 	"k8s.io/client-go/tools/cache"
 	"k8s.io/client-go/tools/record"
@@ -28,7 +29,26 @@ func (c *Client) SetUpEventHandler(ctx context.Context) record.EventRecorder {
 	return eventBroadcaster.NewRecorder(scheme.Scheme, v1.EventSource{Component: "manager"})
 }
 
+//ListEvents returns the events recorded for the object
+func (c *Client) ListEvents(ctx context.Context, ns string, kind string, name string) ([]v1.Event, error) {
+	ctx, span := c.startSpan(ctx, "ListEvents")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "meta", "ListEvents")
+	selector := fields.Set{
+		"involvedObject.kind": kind,
+		"involvedObject.name": name,
+	}.AsSelector().String()
+	res, err := c.cl.CoreV1().Events(ns).List(metav1.ListOptions{FieldSelector: selector})
+	if err != nil {
+		log.Error(err, "unable to list the events", "kind", kind, "name", name)
+		return nil, err
+	}
+	return res.Items, nil
+}
+
 func (c *Client) GetConfigMap(ctx context.Context, ns string, name string) *v1.ConfigMap {
+	ctx, span := c.startSpan(ctx, "GetConfigMap")
+	defer span.End()
 	log := log.Logger(ctx, "k8s", "client", "GetConfigMap")
 	log.WithValues("namespace", ns)
 	log.Info("Retrieving config map")
@@ -0,0 +1,85 @@
+package k8s
+
+import (
+	"context"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"k8s.io/client-go/rest"
+	"sync"
+)
+
+//ClientPool caches the managed cluster clients keyed by cluster.
+//Each entry shares one REST mapper (and its discovery cache) for all the callers of the same cluster
+type ClientPool struct {
+	mu      sync.Mutex
+	entries map[string]*poolEntry
+}
+
+type poolEntry struct {
+	//mu serializes the client builds of the cluster without blocking the other clusters
+	mu sync.Mutex
+	//fingerprint changes whenever the cluster spec or the credentials changes
+	fingerprint string
+	client      *Client
+}
+
+//NewClientPool returns an empty managed cluster client pool
+func NewClientPool() *ClientPool {
+	return &ClientPool{
+		entries: make(map[string]*poolEntry),
+	}
+}
+
+//entry returns the pool entry of the cluster creating it if needed
+func (p *ClientPool) entry(key string) *poolEntry {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+
+	entry, ok := p.entries[key]
+	if !ok {
+		entry = &poolEntry{}
+		p.entries[key] = entry
+	}
+	return entry
+}
+
+//Get returns the cached client for the cluster if the fingerprint matches.
+//Otherwise it builds a new client with the rest config returned by newConfig and replaces the cached one
+//Only the callers of the same cluster wait while the client is being built
+func (p *ClientPool) Get(ctx context.Context, key string, fingerprint string, newConfig func() (*rest.Config, error)) (*Client, error) {
+	log := log.Logger(ctx, "pkg.k8s", "pool", "Get")
+	log = log.WithValues("cluster", key)
+
+	entry := p.entry(key)
+	entry.mu.Lock()
+	defer entry.mu.Unlock()
+
+	if entry.client != nil && entry.fingerprint == fingerprint {
+		metrics.ClusterClientCacheHits.WithLabelValues(key).Inc()
+		return entry.client, nil
+	}
+	metrics.ClusterClientCacheMisses.WithLabelValues(key).Inc()
+
+	log.V(1).Info("Building new client for the managed cluster", "fingerprint", fingerprint)
+	config, err := newConfig()
+	if err != nil {
+		log.Error(err, "unable to prepare the rest config for the managed cluster")
+		return nil, err
+	}
+	cl, err := NewK8sManagedClusterClient(config)
+	if err != nil {
+		log.Error(err, "unable to create the client for the managed cluster")
+		return nil, err
+	}
+	cl.cluster = key
+	entry.fingerprint = fingerprint
+	entry.client = cl
+	return cl, nil
+}
+
+//Invalidate removes the cached client for the cluster
+func (p *ClientPool) Invalidate(key string) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	delete(p.entries, key)
+}
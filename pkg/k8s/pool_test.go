@@ -0,0 +1,81 @@
+package k8s
+
+import (
+	"context"
+	"errors"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/client-go/rest"
+	"time"
+)
+
+var _ = Describe("ClientPool", func() {
+	newConfig := func() (*rest.Config, error) {
+		return &rest.Config{Host: "https://managed-cluster.local"}, nil
+	}
+
+	Describe("Client lookup", func() {
+		pool := NewClientPool()
+		var first *Client
+
+		Context("very first lookup", func() {
+			It("should build a new client", func() {
+				var err error
+				first, err = pool.Get(context.Background(), "manager-system/cluster1", "1/100", newConfig)
+				Expect(err).To(BeNil())
+				Expect(first).NotTo(BeNil())
+			})
+		})
+
+		Context("lookup with the same fingerprint", func() {
+			It("should return the cached client", func() {
+				Expect(pool.Get(context.Background(), "manager-system/cluster1", "1/100", newConfig)).To(BeIdenticalTo(first))
+			})
+		})
+
+		Context("lookup after the credential secret changed", func() {
+			It("should build a new client", func() {
+				Expect(pool.Get(context.Background(), "manager-system/cluster1", "1/101", newConfig)).NotTo(BeIdenticalTo(first))
+			})
+		})
+
+		Context("lookup after invalidation", func() {
+			It("should build a new client", func() {
+				cached, err := pool.Get(context.Background(), "manager-system/cluster1", "1/101", newConfig)
+				Expect(err).To(BeNil())
+				pool.Invalidate("manager-system/cluster1")
+				Expect(pool.Get(context.Background(), "manager-system/cluster1", "1/101", newConfig)).NotTo(BeIdenticalTo(cached))
+			})
+		})
+
+		Context("unable to prepare the rest config", func() {
+			It("should return error", func() {
+				_, err := pool.Get(context.Background(), "manager-system/cluster2", "1/100", func() (*rest.Config, error) {
+					return nil, errors.New("bearer token doesn't exist")
+				})
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Client build", func() {
+		Context("one cluster is slow to build the client", func() {
+			It("shouldn't block the other clusters", func() {
+				pool := NewClientPool()
+				release := make(chan struct{})
+				defer close(release)
+				go pool.Get(context.Background(), "manager-system/slow-cluster", "1/100", func() (*rest.Config, error) {
+					<-release
+					return newConfig()
+				})
+
+				done := make(chan error)
+				go func() {
+					_, err := pool.Get(context.Background(), "manager-system/cluster1", "1/100", newConfig)
+					done <- err
+				}()
+				Eventually(done, 5*time.Second).Should(Receive(BeNil()))
+			})
+		})
+	})
+})
@@ -5,6 +5,7 @@ import (
 	"errors"
 	"fmt"
 	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/audit"
 	"github.com/keikoproj/manager/pkg/log"
 	"k8s.io/apimachinery/pkg/util/wait"
 	"k8s.io/client-go/util/retry"
@@ -18,6 +19,8 @@ import (
 
 //CreateServiceAccountForCluster adds the service account in the target cluster
 func (c *Client) CreateServiceAccountForCluster(ctx context.Context, saName string, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateServiceAccountForCluster")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "rbac", "CreateServiceAccount")
 	serviceAccount := corev1.ServiceAccount{
 		TypeMeta: metav1.TypeMeta{
@@ -39,38 +42,52 @@ func (c *Client) CreateServiceAccountForCluster(ctx context.Context, saName stri
 
 //CreateServiceAccount adds the service account
 func (c *Client) CreateServiceAccount(ctx context.Context, sa *corev1.ServiceAccount, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateServiceAccount")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "rbac", "CreateServiceAccount")
 
-	_, err := c.cl.CoreV1().ServiceAccounts(ns).Create(sa)
+	resp, err := c.cl.CoreV1().ServiceAccounts(ns).Create(sa)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
-			msg := fmt.Sprintf("Failed to create service account %s in namespace %s due to %v", sa.Name, ns, err)
+			msg := fmt.Sprintf("Failed to create service account %s in namespace %s", sa.Name, ns)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.ServiceAccountKind, ns, sa.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Service account already exists. Trying to update", "serviceAccount", sa.Name, "namespace", ns)
-		_, err = c.cl.CoreV1().ServiceAccounts(ns).Update(sa)
+		before, getErr := c.cl.CoreV1().ServiceAccounts(ns).Get(sa.Name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err = c.cl.CoreV1().ServiceAccounts(ns).Update(sa)
+		c.recordChange(ctx, audit.Update, common.ServiceAccountKind, ns, sa.Name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update service account %s due to %v", sa.Name, err)
+			msg := fmt.Sprintf("Failed to update service account %s", sa.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		return nil
 	}
+	c.recordChange(ctx, audit.Create, common.ServiceAccountKind, ns, sa.Name, nil, resp, nil)
 	log.Info("Service account got created successfully", "serviceAccount", sa.Name, "namespace", ns)
 	return nil
 }
 
 //DeleteServiceAccount deletes the service account in the target cluster
 func (c *Client) DeleteServiceAccount(ctx context.Context, saName string, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteServiceAccount")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "DeleteServiceAccount")
 
 	err := c.cl.CoreV1().ServiceAccounts(ns).Delete(saName, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, common.ServiceAccountKind, ns, saName, nil, nil, err)
+	}
 	if err != nil {
 		if !apierr.IsNotFound(err) {
-			msg := fmt.Sprintf("Failed to delete service account %s in namespace %s due to %v", saName, ns, err)
+			msg := fmt.Sprintf("Failed to delete service account %s in namespace %s", saName, ns)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		log.Info("Service account doesn't exists anymore", "serviceAccount", saName, "namespace", ns)
 		return nil
@@ -80,7 +97,9 @@ func (c *Client) DeleteServiceAccount(ctx context.Context, saName string, ns str
 }
 
 //CreateOrUpdateClusterRole create or updates cluster role
-func (c *Client) CreateOrUpdateClusterRole(ctx context.Context, name string) error {
+func (c *Client) CreateOrUpdateClusterRole(ctx context.Context, name string, rules []rbacv1.PolicyRule) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateClusterRole")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "CreateOrUpdateClusterRole")
 	clusterRole := rbacv1.ClusterRole{
 		TypeMeta: metav1.TypeMeta{
@@ -91,34 +110,32 @@ func (c *Client) CreateOrUpdateClusterRole(ctx context.Context, name string) err
 		ObjectMeta: metav1.ObjectMeta{
 			Name: name,
 		},
-		Rules: []rbacv1.PolicyRule{
-			{
-				APIGroups: []string{"*"},
-				Resources: []string{"*"},
-				Verbs:     []string{"*"},
-			},
-			{
-				NonResourceURLs: []string{"*"},
-				Verbs:           []string{"*"},
-			},
-		},
+		Rules: rules,
 	}
 
-	_, err := c.cl.RbacV1().ClusterRoles().Create(&clusterRole)
+	resp, err := c.cl.RbacV1().ClusterRoles().Create(&clusterRole)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
-			msg := fmt.Sprintf("Failed to create cluster role %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to create cluster role %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.ClusterRoleKind, "", name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Cluster Role Already exists. Trying to update", "clusterRole", name)
 		//Already exists. lets Update it
-		_, err := c.cl.RbacV1().ClusterRoles().Update(&clusterRole)
+		before, getErr := c.cl.RbacV1().ClusterRoles().Get(name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err := c.cl.RbacV1().ClusterRoles().Update(&clusterRole)
+		c.recordChange(ctx, audit.Update, common.ClusterRoleKind, "", name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update cluster role %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to update cluster role %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, common.ClusterRoleKind, "", name, nil, resp, nil)
 	}
 	log.Info("Successfully created cluster role", "clusterRole", name)
 	return nil
@@ -126,38 +143,99 @@ func (c *Client) CreateOrUpdateClusterRole(ctx context.Context, name string) err
 
 //CreateOrUpdateRole create or updates role
 func (c *Client) CreateOrUpdateRole(ctx context.Context, role *rbacv1.Role, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateRole")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "CreateOrUpdateRole")
 
-	_, err := c.cl.RbacV1().Roles(ns).Create(role)
+	resp, err := c.cl.RbacV1().Roles(ns).Create(role)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
-			msg := fmt.Sprintf("Failed to create role %s due to %v", role.Name, err)
+			msg := fmt.Sprintf("Failed to create role %s", role.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.RoleKind, ns, role.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Role Already exists. Trying to update", "name", role.Name)
 		//Already exists. lets Update it
-		_, err := c.cl.RbacV1().Roles(ns).Update(role)
+		before, getErr := c.cl.RbacV1().Roles(ns).Get(role.Name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err := c.cl.RbacV1().Roles(ns).Update(role)
+		c.recordChange(ctx, audit.Update, common.RoleKind, ns, role.Name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update role %s due to %v", role.Name, err)
+			msg := fmt.Sprintf("Failed to update role %s", role.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, common.RoleKind, ns, role.Name, nil, resp, nil)
 	}
 	log.Info("Successfully created/updated role", "role", role.Name)
 	return nil
 }
 
+//DeleteRole deletes role
+func (c *Client) DeleteRole(ctx context.Context, name string, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteRole")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "client", "DeleteRole")
+
+	err := c.cl.RbacV1().Roles(ns).Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, common.RoleKind, ns, name, nil, nil, err)
+	}
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			msg := fmt.Sprintf("Failed to delete role %s in namespace %s", name, ns)
+			log.Error(err, msg)
+			return wrapError(msg, err)
+		}
+		log.Info("Role doesn't exist anymore", "role", name, "namespace", ns)
+		return nil
+	}
+	log.Info("Successfully removed role", "role", name, "namespace", ns)
+	return nil
+}
+
+//DeleteRoleBinding deletes role binding
+func (c *Client) DeleteRoleBinding(ctx context.Context, name string, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteRoleBinding")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "client", "DeleteRoleBinding")
+
+	err := c.cl.RbacV1().RoleBindings(ns).Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, common.RoleBindingKind, ns, name, nil, nil, err)
+	}
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			msg := fmt.Sprintf("Failed to delete role binding %s in namespace %s", name, ns)
+			log.Error(err, msg)
+			return wrapError(msg, err)
+		}
+		log.Info("RoleBinding doesn't exist anymore", "roleBinding", name, "namespace", ns)
+		return nil
+	}
+	log.Info("Successfully removed role binding", "roleBinding", name, "namespace", ns)
+	return nil
+}
+
 //DeleteClusterRole deletes cluster role
 func (c *Client) DeleteClusterRole(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "DeleteClusterRole")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "DeleteClusterRole")
 
 	err := c.cl.RbacV1().ClusterRoles().Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, common.ClusterRoleKind, "", name, nil, nil, err)
+	}
 	if err != nil {
 		if !apierr.IsNotFound(err) {
-			msg := fmt.Sprintf("Failed to delete cluster role %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to delete cluster role %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		log.Info("Cluster Role doesn't exist anymore", "clusterRole", name)
 	}
@@ -165,8 +243,24 @@ func (c *Client) DeleteClusterRole(ctx context.Context, name string) error {
 	return nil
 }
 
+//GetClusterRole retrieves the cluster role
+func (c *Client) GetClusterRole(ctx context.Context, name string) (*rbacv1.ClusterRole, error) {
+	ctx, span := c.startSpan(ctx, "GetClusterRole")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "client", "GetClusterRole")
+
+	clusterRole, err := c.cl.RbacV1().ClusterRoles().Get(name, metav1.GetOptions{})
+	if err != nil {
+		log.Error(err, "unable to get the cluster role", "clusterRole", name)
+		return nil, err
+	}
+	return clusterRole, nil
+}
+
 //CreateOrUpdateClusterRole create or updates cluster role
 func (c *Client) CreateOrUpdateClusterRoleBinding(ctx context.Context, name string, clusterRoleName string, subject rbacv1.Subject) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateClusterRoleBinding")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "CreateOrUpdateClusterRoleBinding")
 	clusterRoleBinding := rbacv1.ClusterRoleBinding{
 		TypeMeta: metav1.TypeMeta{
@@ -184,21 +278,29 @@ func (c *Client) CreateOrUpdateClusterRoleBinding(ctx context.Context, name stri
 		Subjects: []rbacv1.Subject{subject},
 	}
 
-	_, err := c.cl.RbacV1().ClusterRoleBindings().Create(&clusterRoleBinding)
+	resp, err := c.cl.RbacV1().ClusterRoleBindings().Create(&clusterRoleBinding)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
-			msg := fmt.Sprintf("Failed to create cluster role binding %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to create cluster role binding %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.ClusterRoleBindingKind, "", name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Cluster RoleBinding Already exists. Trying to update", "clusterRoleBinding", name, "clusterRole", clusterRoleName)
 		//Already exists. lets Update it
-		_, err := c.cl.RbacV1().ClusterRoleBindings().Update(&clusterRoleBinding)
+		before, getErr := c.cl.RbacV1().ClusterRoleBindings().Get(name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err := c.cl.RbacV1().ClusterRoleBindings().Update(&clusterRoleBinding)
+		c.recordChange(ctx, audit.Update, common.ClusterRoleBindingKind, "", name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update cluster role binding %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to update cluster role binding %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, common.ClusterRoleBindingKind, "", name, nil, resp, nil)
 	}
 	log.Info("Successfully created cluster RoleBinding", "clusterRoleBinding", name, "clusterRole", clusterRoleName)
 	return nil
@@ -206,22 +308,32 @@ func (c *Client) CreateOrUpdateClusterRoleBinding(ctx context.Context, name stri
 
 //CreateOrUpdateClusterRole create or updates cluster role
 func (c *Client) CreateOrUpdateRoleBinding(ctx context.Context, binding *rbacv1.RoleBinding, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateRoleBinding")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "client", "CreateOrUpdateRoleBinding")
-	_, err := c.cl.RbacV1().RoleBindings(ns).Create(binding)
+	resp, err := c.cl.RbacV1().RoleBindings(ns).Create(binding)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
-			msg := fmt.Sprintf("Failed to create role binding %s due to %v", binding.Name, err)
+			msg := fmt.Sprintf("Failed to create role binding %s", binding.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.RoleBindingKind, ns, binding.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("RoleBinding Already exists. Trying to update", "RoleBinding", binding.Name, "Role", binding.RoleRef.Name)
 		//Already exists. lets Update it
-		_, err := c.cl.RbacV1().RoleBindings(ns).Update(binding)
+		before, getErr := c.cl.RbacV1().RoleBindings(ns).Get(binding.Name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err := c.cl.RbacV1().RoleBindings(ns).Update(binding)
+		c.recordChange(ctx, audit.Update, common.RoleBindingKind, ns, binding.Name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update role binding %s due to %v", binding.Name, err)
+			msg := fmt.Sprintf("Failed to update role binding %s", binding.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, common.RoleBindingKind, ns, binding.Name, nil, resp, nil)
 	}
 	log.Info("Successfully created/updated RoleBinding", "RoleBinding", binding.Name, "Role", binding.RoleRef.Name)
 	return nil
@@ -229,14 +341,19 @@ func (c *Client) CreateOrUpdateRoleBinding(ctx context.Context, binding *rbacv1.
 
 //CreateOrUpdateClusterRole create or updates cluster role
 func (c *Client) DeleteClusterRoleBinding(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "DeleteClusterRoleBinding")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s.rbac", "DeleteClusterRoleBinding")
 
 	err := c.cl.RbacV1().ClusterRoleBindings().Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, common.ClusterRoleBindingKind, "", name, nil, nil, err)
+	}
 	if err != nil {
 		if !apierr.IsNotFound(err) {
-			msg := fmt.Sprintf("Failed to delete cluster role binding %s due to %v", name, err)
+			msg := fmt.Sprintf("Failed to delete cluster role binding %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		log.Info("Cluster RoleBinding doesn't exist anymore", "clusterRoleBinding", name)
 	}
@@ -246,6 +363,8 @@ func (c *Client) DeleteClusterRoleBinding(ctx context.Context, name string) erro
 
 //GetServiceAccountTokenSecret retrieves the token secret for a given service account
 func (c *Client) GetServiceAccountTokenSecret(ctx context.Context, saName string, ns string) (string, error) {
+	ctx, span := c.startSpan(ctx, "GetServiceAccountTokenSecret")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s.rbac", "GetServiceAccountTokenSecret")
 
 	var secret *corev1.Secret
@@ -289,33 +408,41 @@ func (c *Client) GetServiceAccountTokenSecret(ctx context.Context, saName string
 
 //CreateK8sSecret function creates secret in specific namespace
 func (c *Client) CreateOrUpdateK8sSecret(ctx context.Context, secret *corev1.Secret, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateK8sSecret")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "rbac", "CreateK8sSecret")
 	log = log.WithValues("secret_name", secret.Name, "namespace", ns)
 	// Create the k8s secret
-	_, err := c.cl.CoreV1().Secrets(ns).Create(secret)
+	resp, err := c.cl.CoreV1().Secrets(ns).Create(secret)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the secret %s", secret.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, secretKind, ns, secret.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
+		var before *corev1.Secret
 		//Modify the get response and retry update until no conflicts
 		retryErr := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 			// Get the present CR to bump up the resource version
-			resp, err := c.GetK8sSecret(ctx, secret.Name, ns)
+			resp, err = c.GetK8sSecret(ctx, secret.Name, ns)
 			if err != nil {
 				log.Error(err, "unable to get secret in the target namespace")
 				return err
 			}
 
+			before = resp.DeepCopy()
 			resp.StringData = secret.StringData
-			_, err = c.cl.CoreV1().Secrets(ns).Update(resp)
+			resp, err = c.cl.CoreV1().Secrets(ns).Update(resp)
 			return err
 		})
+		c.recordChange(ctx, audit.Update, secretKind, ns, secret.Name, before, resp, retryErr)
 		if retryErr != nil {
 			log.Error(retryErr, "unable to update the secret")
 			return retryErr
 		}
+	} else {
+		c.recordChange(ctx, audit.Create, secretKind, ns, secret.Name, nil, resp, nil)
 	}
 
 	log.Info("Successfully created/updated secret")
@@ -325,6 +452,8 @@ func (c *Client) CreateOrUpdateK8sSecret(ctx context.Context, secret *corev1.Sec
 
 //GetK8sSecret function retrieves the secrets
 func (c *Client) GetK8sSecret(ctx context.Context, name string, ns string) (*corev1.Secret, error) {
+	ctx, span := c.startSpan(ctx, "GetK8sSecret")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "rbac", "GetK8sSecret")
 	log = log.WithValues("secretName", name, "namespace", ns)
 	log.V(1).Info("Retrieving secret")
@@ -337,3 +466,71 @@ func (c *Client) GetK8sSecret(ctx context.Context, name string, ns string) (*cor
 
 	return secret, nil
 }
+
+//CreateServiceAccountToken creates a new service account token secret with the given name and waits until token controller populates the token
+func (c *Client) CreateServiceAccountToken(ctx context.Context, name string, saName string, ns string) (*corev1.Secret, error) {
+	ctx, span := c.startSpan(ctx, "CreateServiceAccountToken")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "rbac", "CreateServiceAccountToken")
+	log = log.WithValues("secret_name", name, "serviceAccount", saName, "namespace", ns)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: ns,
+			Annotations: map[string]string{
+				corev1.ServiceAccountNameKey: saName,
+			},
+		},
+		Type: corev1.SecretTypeServiceAccountToken,
+	}
+	resp, err := c.cl.CoreV1().Secrets(ns).Create(secret)
+	if err != nil {
+		msg := fmt.Sprintf("Failed to create service account token for %s in namespace %s", saName, ns)
+		log.Error(err, msg)
+		c.recordChange(ctx, audit.Create, secretKind, ns, name, nil, nil, err)
+		return nil, wrapError(msg, err)
+	}
+	c.recordChange(ctx, audit.Create, secretKind, ns, resp.Name, nil, resp, nil)
+	log.V(1).Info("service account token secret created. waiting for the token", "secret_name", resp.Name)
+
+	err = wait.Poll(500*time.Millisecond, 60*time.Second, func() (bool, error) {
+		resp, err = c.cl.CoreV1().Secrets(ns).Get(resp.Name, metav1.GetOptions{})
+		if err != nil {
+			log.Error(err, "unable to retrieve service account token secret")
+			return false, err
+		}
+		_, ok := resp.Data[corev1.ServiceAccountTokenKey]
+		return ok, nil
+	})
+	if err != nil {
+		log.Error(err, "token never got populated for the service account token secret", "secret_name", resp.Name)
+		return nil, err
+	}
+	log.Info("Successfully created service account token", "secret_name", resp.Name)
+	return resp, nil
+}
+
+//DeleteK8sSecret deletes the secret in specific namespace
+func (c *Client) DeleteK8sSecret(ctx context.Context, name string, ns string) error {
+	ctx, span := c.startSpan(ctx, "DeleteK8sSecret")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "rbac", "DeleteK8sSecret")
+	log = log.WithValues("secret_name", name, "namespace", ns)
+
+	err := c.cl.CoreV1().Secrets(ns).Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, secretKind, ns, name, nil, nil, err)
+	}
+	if err != nil {
+		if !apierr.IsNotFound(err) {
+			msg := fmt.Sprintf("Failed to delete secret %s in namespace %s", name, ns)
+			log.Error(err, msg)
+			return wrapError(msg, err)
+		}
+		log.Info("Secret doesn't exist anymore")
+		return nil
+	}
+	log.Info("Successfully deleted secret")
+	return nil
+}
@@ -1,14 +1,19 @@
 package k8s
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
 	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/rbac"
 	. "github.com/onsi/ginkgo"
 	. "github.com/onsi/gomega"
 	"k8s.io/api/rbac/v1"
 
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/kubernetes/fake"
 )
 
 var _ = Describe("K8s_rbac", func() {
@@ -32,13 +37,13 @@ var _ = Describe("K8s_rbac", func() {
 
 		Context("create new cluster role", func() {
 			It("should be successful", func() {
-				Expect(cl.CreateOrUpdateClusterRole(context.Background(), common.ManagerClusterRole)).To(BeNil())
+				Expect(cl.CreateOrUpdateClusterRole(context.Background(), common.ManagerClusterRole, rbac.BaseRules())).To(BeNil())
 			})
 		})
 
 		Context("Trying to create cluster role with same name", func() {
 			It("should be successful", func() {
-				Expect(cl.CreateOrUpdateClusterRole(context.Background(), common.ManagerClusterRole)).To(BeNil())
+				Expect(cl.CreateOrUpdateClusterRole(context.Background(), common.ManagerClusterRole, rbac.BaseRules())).To(BeNil())
 			})
 		})
 
@@ -155,4 +160,43 @@ var _ = Describe("K8s_rbac", func() {
 		})
 	})
 
+	Describe("CreateOrUpdateK8sSecret", func() {
+		var buf *bytes.Buffer
+
+		BeforeEach(func() {
+			buf = &bytes.Buffer{}
+			audit.SetDefault(audit.New(audit.NewWriterSink(buf)))
+		})
+
+		AfterEach(func() {
+			audit.SetDefault(audit.New())
+		})
+
+		Context("secret already exists", func() {
+			It("should record the updated secret in the audit trail", func() {
+				existing := &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-secret", Namespace: common.ManagerDeployedNamespace},
+					StringData: map[string]string{"token": "old-token"},
+				}
+				fakeClient := NewK8sClient(fake.NewSimpleClientset(existing))
+
+				Expect(fakeClient.CreateOrUpdateK8sSecret(context.Background(), &corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Name: "cluster-secret"},
+					StringData: map[string]string{"token": "new-token"},
+				}, common.ManagerDeployedNamespace)).To(Succeed())
+
+				updated, err := fakeClient.GetK8sSecret(context.Background(), "cluster-secret", common.ManagerDeployedNamespace)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(updated.StringData).To(HaveKeyWithValue("token", "new-token"))
+
+				var record audit.Record
+				Expect(json.Unmarshal(buf.Bytes(), &record)).To(Succeed())
+				Expect(record.Action).To(Equal(audit.Update))
+				Expect(record.Result).To(Equal(audit.Success))
+				Expect(record.BeforeHash).To(Equal(audit.Hash(existing)))
+				Expect(record.AfterHash).NotTo(BeEmpty())
+				Expect(record.AfterHash).To(Equal(audit.Hash(updated)))
+			})
+		})
+	})
 })
@@ -2,10 +2,14 @@ package k8s
 
 import (
 	"context"
-	"errors"
 	"fmt"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
 	"k8s.io/api/core/v1"
+	"time"
 
 	apierr "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -13,6 +17,8 @@ import (
 
 //CreateNamespace function creates a namespace in the control plan cluster
 func (c *Client) CreateOrUpdateNamespace(ctx context.Context, ns *v1.Namespace) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateNamespace")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "resources", "CreateNamespace")
 	// Create the namespace
 	resp, err := c.cl.CoreV1().Namespaces().Create(ns)
@@ -20,17 +26,24 @@ func (c *Client) CreateOrUpdateNamespace(ctx context.Context, ns *v1.Namespace)
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the namespace %s", ns.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, namespaceKind, "", ns.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Namespace already exists.. Trying to update", "name", ns.Name)
+		before, getErr := c.cl.CoreV1().Namespaces().Get(ns.Name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
 		resp, err = c.cl.CoreV1().Namespaces().Update(ns)
+		c.recordChange(ctx, audit.Update, namespaceKind, "", ns.Name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update namespace %s due to %v", ns.Name, err)
+			msg := fmt.Sprintf("Failed to update namespace %s", ns.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		return nil
 	}
+	c.recordChange(ctx, audit.Create, namespaceKind, "", ns.Name, nil, resp, nil)
 
 	log.Info("Successfully created namespace", "name", resp.Name)
 	return nil
@@ -38,14 +51,19 @@ func (c *Client) CreateOrUpdateNamespace(ctx context.Context, ns *v1.Namespace)
 
 //DeleteNamespace function creates a namespace in the control plan cluster
 func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "DeleteNamespace")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "resources", "DeleteNamespace")
 	// Delete the namespace
 	err := c.cl.CoreV1().Namespaces().Delete(name, &metav1.DeleteOptions{})
+	if !apierr.IsNotFound(err) {
+		c.recordChange(ctx, audit.Delete, namespaceKind, "", name, nil, nil, err)
+	}
 	if err != nil {
 		if !apierr.IsNotFound(err) {
 			msg := fmt.Sprintf("unable to delete the namespace %s", name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		log.Info("Namespace doesn't exist anymore", "name", name)
 		return nil
@@ -57,6 +75,8 @@ func (c *Client) DeleteNamespace(ctx context.Context, name string) error {
 
 //CreateNamespace function creates a namespace in the control plan cluster
 func (c *Client) GetNamespace(ctx context.Context, name string) error {
+	ctx, span := c.startSpan(ctx, "GetNamespace")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "resources", "GetNamespace")
 	// Create the namespace
 	resp, err := c.cl.CoreV1().Namespaces().Get(name, metav1.GetOptions{})
@@ -71,25 +91,89 @@ func (c *Client) GetNamespace(ctx context.Context, name string) error {
 
 //CreateResourceQuota function creates resource quota for a specified namespace
 func (c *Client) CreateOrUpdateResourceQuota(ctx context.Context, quota *v1.ResourceQuota, ns string) error {
+	ctx, span := c.startSpan(ctx, "CreateOrUpdateResourceQuota")
+	defer span.End()
 	log := log.Logger(ctx, "pkg.k8s", "resources", "CreateResourceQuota")
 	log = log.WithValues("namespace", ns, "quotaName", quota.Name)
 
-	_, err := c.cl.CoreV1().ResourceQuotas(ns).Create(quota)
+	resp, err := c.cl.CoreV1().ResourceQuotas(ns).Create(quota)
 	if err != nil {
 		if !apierr.IsAlreadyExists(err) {
 			msg := fmt.Sprintf("unable to create the resource quota %s", quota.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			c.recordChange(ctx, audit.Create, common.ResourceQuotaKind, ns, quota.Name, nil, nil, err)
+			return wrapError(msg, err)
 		}
 		log.Info("Resource quota already exists. Trying to update")
-		_, err := c.cl.CoreV1().ResourceQuotas(ns).Update(quota)
+		before, getErr := c.cl.CoreV1().ResourceQuotas(ns).Get(quota.Name, metav1.GetOptions{})
+		if getErr != nil {
+			before = nil
+		}
+		resp, err := c.cl.CoreV1().ResourceQuotas(ns).Update(quota)
+		c.recordChange(ctx, audit.Update, common.ResourceQuotaKind, ns, quota.Name, before, resp, err)
 		if err != nil {
-			msg := fmt.Sprintf("Failed to update resource quota %s due to %v", quota.Name, err)
+			msg := fmt.Sprintf("Failed to update resource quota %s", quota.Name)
 			log.Error(err, msg)
-			return errors.New(msg)
+			return wrapError(msg, err)
 		}
 		return nil
 	}
+	c.recordChange(ctx, audit.Create, common.ResourceQuotaKind, ns, quota.Name, nil, resp, nil)
 	log.Info("successfully created resource quota")
 	return nil
 }
+
+//ApplyResource creates/updates the namespace resource based on its type
+func (c *Client) ApplyResource(ctx context.Context, res *namespace.Resource, ns string) (err error) {
+	ctx, span := c.startSpan(ctx, "ApplyResource")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "resources", "ApplyResource")
+	start := time.Now()
+	defer func() {
+		metrics.ResourceApplyDuration.WithLabelValues(res.Type).Observe(time.Since(start).Seconds())
+		if err != nil {
+			metrics.ResourceApplyErrors.WithLabelValues(res.Type).Inc()
+		}
+	}()
+
+	switch res.Type {
+	case common.ServiceAccountKind:
+		log.V(1).Info("Service Account creation is in progress", "name", res.ServiceAccount.Name)
+		return c.CreateServiceAccount(ctx, res.ServiceAccount, ns)
+
+	case common.RoleKind:
+		log.V(1).Info("Role creation is in progress")
+		return c.CreateOrUpdateRole(ctx, res.Role, ns)
+
+	case common.RoleBindingKind:
+		log.V(1).Info("RoleBinding creation is in progress", "name", res.RoleBinding.Name)
+		return c.CreateOrUpdateRoleBinding(ctx, res.RoleBinding, ns)
+
+	case common.ResourceQuotaKind:
+		log.V(1).Info("Resource Quota creation is in progress")
+		return c.CreateOrUpdateResourceQuota(ctx, res.ResourceQuota, ns)
+
+	case common.CustomResourceKind:
+		log.V(1).Info("Custom Resource creation is in progress")
+		return c.CreateOrUpdateCustomResource(ctx, res.CustomResource, ns)
+
+	default:
+		//TODO: handle error management
+		log.Info("Invalid choice")
+	}
+	return nil
+}
+
+//Ping verifies the client is able to reach and authenticate with the api server
+func (c *Client) Ping(ctx context.Context) error {
+	ctx, span := c.startSpan(ctx, "Ping")
+	defer span.End()
+	log := log.Logger(ctx, "pkg.k8s", "resources", "Ping")
+
+	_, err := c.cl.CoreV1().Namespaces().List(metav1.ListOptions{Limit: 1})
+	if err != nil {
+		log.Error(err, "unable to list namespaces")
+		return err
+	}
+	return nil
+}
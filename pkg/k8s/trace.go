@@ -0,0 +1,18 @@
+package k8s
+
+import (
+	"context"
+
+	"github.com/keikoproj/manager/pkg/tracing"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
+)
+
+//startSpan starts the span for the client call. Calls to the managed clusters are tagged with the cluster
+func (c *Client) startSpan(ctx context.Context, name string) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{trace.WithSpanKind(trace.SpanKindClient)}
+	if c.cluster != "" {
+		opts = append(opts, trace.WithAttributes(attribute.String("manager.cluster", c.cluster)))
+	}
+	return tracing.Start(ctx, "k8s."+name, opts...)
+}
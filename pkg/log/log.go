@@ -3,6 +3,7 @@ package log
 import (
 	"context"
 	"github.com/go-logr/logr"
+	"go.opentelemetry.io/otel/trace"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 )
@@ -46,6 +47,10 @@ func Logger(ctx context.Context, names ...string) logr.Logger {
 	if rId != nil {
 		logk = logk.WithValues("request_id", rId)
 	}
+	//trace id connects the logs to the trace of the request
+	if sc := trace.SpanContextFromContext(ctx); sc.IsValid() {
+		logk = logk.WithValues("trace_id", sc.TraceID().String())
+	}
 
 	return logk
 }
@@ -0,0 +1,102 @@
+package metrics
+
+import (
+	"context"
+	"time"
+
+	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	//listTimeout is the timeout for listing the clusters on scrape
+	listTimeout = 10 * time.Second
+)
+
+var (
+	clustersDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "", "clusters"),
+		"Number of managed clusters by state",
+		[]string{"state"}, nil)
+	clusterReachableDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "reachable"),
+		"Whether the managed cluster api server was reachable on the last probe",
+		[]string{"cluster"}, nil)
+	clusterLatencyDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "api_latency_seconds"),
+		"Latency of the managed cluster api server measured on the last probe",
+		[]string{"cluster"}, nil)
+	clusterNamespacesDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "managed_namespaces"),
+		"Number of managed namespaces in the managed cluster by state",
+		[]string{"cluster", "state"}, nil)
+	clusterTokenExpiryDesc = prometheus.NewDesc(
+		prometheus.BuildFQName(namespace, "cluster", "token_expiry_timestamp_seconds"),
+		"Time when the bearer token in use is due to be replaced by the token rotation",
+		[]string{"cluster"}, nil)
+)
+
+//FleetCollector reports the health of the managed clusters from the cluster status on every scrape
+//Values are derived from the status the controllers record, so deleted clusters disappear without any cleanup
+//Namespaces are only counted per cluster and state to keep the cardinality bounded by the number of clusters
+type FleetCollector struct {
+	reader client.Reader
+}
+
+//NewFleetCollector returns the collector listing the clusters with the reader. Reader is expected to be cache backed
+func NewFleetCollector(reader client.Reader) *FleetCollector {
+	return &FleetCollector{reader: reader}
+}
+
+//Describe implements prometheus.Collector
+func (c *FleetCollector) Describe(ch chan<- *prometheus.Desc) {
+	ch <- clustersDesc
+	ch <- clusterReachableDesc
+	ch <- clusterLatencyDesc
+	ch <- clusterNamespacesDesc
+	ch <- clusterTokenExpiryDesc
+}
+
+//Collect implements prometheus.Collector
+func (c *FleetCollector) Collect(ch chan<- prometheus.Metric) {
+	ctx, cancel := context.WithTimeout(context.Background(), listTimeout)
+	defer cancel()
+	log := log.Logger(ctx, "pkg.metrics", "FleetCollector", "Collect")
+
+	var clusterList managerv1alpha1.ClusterList
+	if err := c.reader.List(ctx, &clusterList); err != nil {
+		log.Error(err, "unable to list the clusters")
+		ch <- prometheus.NewInvalidMetric(clustersDesc, err)
+		return
+	}
+
+	states := make(map[managerv1alpha1.State]int)
+	for i := range clusterList.Items {
+		cluster := &clusterList.Items[i]
+		key := cluster.Namespace + "/" + cluster.Name
+		if cluster.Status.State != "" {
+			states[cluster.Status.State]++
+		}
+
+		reachable := 0.0
+		if managerv1alpha1.IsConditionTrue(cluster.Status.Conditions, managerv1alpha1.Reachable) {
+			reachable = 1
+		}
+		ch <- prometheus.MustNewConstMetric(clusterReachableDesc, prometheus.GaugeValue, reachable, key)
+
+		if cluster.Status.APILatency != nil {
+			ch <- prometheus.MustNewConstMetric(clusterLatencyDesc, prometheus.GaugeValue, cluster.Status.APILatency.Seconds(), key)
+		}
+		for state, count := range cluster.Status.NamespaceStateCounts {
+			ch <- prometheus.MustNewConstMetric(clusterNamespacesDesc, prometheus.GaugeValue, float64(count), key, string(state))
+		}
+		if rotation := cluster.Status.TokenRotation; rotation != nil && rotation.NextRotationTime != nil {
+			ch <- prometheus.MustNewConstMetric(clusterTokenExpiryDesc, prometheus.GaugeValue, float64(rotation.NextRotationTime.Unix()), key)
+		}
+	}
+	for state, count := range states {
+		ch <- prometheus.MustNewConstMetric(clustersDesc, prometheus.GaugeValue, float64(count), string(state))
+	}
+}
@@ -0,0 +1,68 @@
+package metrics_test
+
+import (
+	"strings"
+	"time"
+
+	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/metrics"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("FleetCollector", func() {
+	It("reports the health of every cluster", func() {
+		scheme := runtime.NewScheme()
+		Expect(managerv1alpha1.AddToScheme(scheme)).To(Succeed())
+
+		next := metav1.NewTime(time.Unix(1600000000, 0))
+		ready := &managerv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "ready", Namespace: "team"},
+			Status: managerv1alpha1.ClusterStatus{
+				State:      managerv1alpha1.Ready,
+				APILatency: &metav1.Duration{Duration: 250 * time.Millisecond},
+				Conditions: []managerv1alpha1.Condition{{Type: managerv1alpha1.Reachable, Status: "True"}},
+				NamespaceStateCounts: map[managerv1alpha1.State]int{
+					managerv1alpha1.Ready:   3,
+					managerv1alpha1.Warning: 1,
+				},
+				TokenRotation: &managerv1alpha1.TokenRotationStatus{NextRotationTime: &next},
+			},
+		}
+		unreachable := &managerv1alpha1.Cluster{
+			ObjectMeta: metav1.ObjectMeta{Name: "unreachable", Namespace: "team"},
+			Status: managerv1alpha1.ClusterStatus{
+				State:      managerv1alpha1.Warning,
+				Conditions: []managerv1alpha1.Condition{{Type: managerv1alpha1.Reachable, Status: "False"}},
+			},
+		}
+		collector := metrics.NewFleetCollector(fake.NewFakeClientWithScheme(scheme, ready, unreachable))
+
+		expected := `
+# HELP manager_cluster_api_latency_seconds Latency of the managed cluster api server measured on the last probe
+# TYPE manager_cluster_api_latency_seconds gauge
+manager_cluster_api_latency_seconds{cluster="team/ready"} 0.25
+# HELP manager_cluster_managed_namespaces Number of managed namespaces in the managed cluster by state
+# TYPE manager_cluster_managed_namespaces gauge
+manager_cluster_managed_namespaces{cluster="team/ready",state="Ready"} 3
+manager_cluster_managed_namespaces{cluster="team/ready",state="Warning"} 1
+# HELP manager_cluster_reachable Whether the managed cluster api server was reachable on the last probe
+# TYPE manager_cluster_reachable gauge
+manager_cluster_reachable{cluster="team/ready"} 1
+manager_cluster_reachable{cluster="team/unreachable"} 0
+# HELP manager_cluster_token_expiry_timestamp_seconds Time when the bearer token in use is due to be replaced by the token rotation
+# TYPE manager_cluster_token_expiry_timestamp_seconds gauge
+manager_cluster_token_expiry_timestamp_seconds{cluster="team/ready"} 1.6e+09
+# HELP manager_clusters Number of managed clusters by state
+# TYPE manager_clusters gauge
+manager_clusters{state="Ready"} 1
+manager_clusters{state="Warning"} 1
+`
+		Expect(testutil.CollectAndCompare(collector, strings.NewReader(expected))).To(Succeed())
+	})
+})
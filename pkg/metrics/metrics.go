@@ -0,0 +1,111 @@
+package metrics
+
+import (
+	"net/http"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+)
+
+const (
+	namespace = "manager"
+)
+
+var (
+	//ClusterClientCacheHits counts the managed cluster client lookups served from the client pool
+	ClusterClientCacheHits = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cluster_client",
+		Name:      "cache_hits_total",
+		Help:      "Total number of managed cluster client lookups served from the client pool",
+	}, []string{"cluster"})
+
+	//ClusterClientCacheMisses counts the managed cluster client lookups which required building a new client
+	ClusterClientCacheMisses = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "cluster_client",
+		Name:      "cache_misses_total",
+		Help:      "Total number of managed cluster client lookups which required building a new client",
+	}, []string{"cluster"})
+
+	//ResourceApplyDuration observes the time taken to apply the namespace resources to the managed clusters
+	//Kind is one of the namespace template resource types, so the cardinality doesn't grow with the clusters or namespaces
+	ResourceApplyDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "resource",
+		Name:      "apply_duration_seconds",
+		Help:      "Time taken to apply the namespace resources to the managed clusters",
+		Buckets:   []float64{0.01, 0.05, 0.1, 0.25, 0.5, 1, 2.5, 5, 10},
+	}, []string{"kind"})
+
+	//ResourceApplyErrors counts the namespace resources which failed to apply
+	ResourceApplyErrors = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "resource",
+		Name:      "apply_errors_total",
+		Help:      "Total number of namespace resources which failed to apply to the managed clusters",
+	}, []string{"kind"})
+
+	//TemplateRenderFailures counts the namespace templates which failed to render for a managed namespace
+	TemplateRenderFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "template",
+		Name:      "render_failures_total",
+		Help:      "Total number of namespace template render failures",
+	}, []string{"template"})
+
+	//ReconcileRetries counts the reconciles which are requeued to retry after a failure
+	ReconcileRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "reconcile",
+		Name:      "retries_total",
+		Help:      "Total number of reconciles requeued to retry after a failure",
+	}, []string{"kind"})
+
+	//GRPCRequests counts the handled grpc requests by method and status code
+	GRPCRequests = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "requests_total",
+		Help:      "Total number of grpc requests handled by the server",
+	}, []string{"method", "code"})
+
+	//GRPCRequestDuration observes the time taken to handle the grpc requests. Streams are observed when they end
+	GRPCRequestDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: namespace,
+		Subsystem: "grpc",
+		Name:      "request_duration_seconds",
+		Help:      "Time taken to handle the grpc requests",
+		Buckets:   prometheus.DefBuckets,
+	}, []string{"method"})
+)
+
+func init() {
+	//Register with controller runtime registry so that metrics are exposed with --metrics-addr
+	metrics.Registry.MustRegister(
+		ClusterClientCacheHits,
+		ClusterClientCacheMisses,
+		ResourceApplyDuration,
+		ResourceApplyErrors,
+		TemplateRenderFailures,
+		ReconcileRetries,
+		GRPCRequests,
+		GRPCRequestDuration,
+	)
+}
+
+//Register registers the collectors with the controller runtime registry
+func Register(collectors ...prometheus.Collector) error {
+	for _, c := range collectors {
+		if err := metrics.Registry.Register(c); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+//Handler returns the http handler exposing the metrics. Used by the processes which don't run the controller manager
+func Handler() http.Handler {
+	return promhttp.HandlerFor(metrics.Registry, promhttp.HandlerOpts{})
+}
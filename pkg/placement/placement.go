@@ -0,0 +1,95 @@
+package placement
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/log"
+	"sort"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+const (
+	//Spread chooses the cluster with the least number of managed namespaces
+	Spread = "spread"
+	//LeastLoaded chooses the cluster with the most allocatable cpu per managed namespace
+	LeastLoaded = "least-loaded"
+	//Pinned chooses the first matching cluster in the alphabetical order
+	Pinned = "pinned"
+)
+
+var (
+	noSelectorErr      = "cluster selector must be provided when cluster name is empty"
+	noClusterErr       = "no ready cluster matches the cluster selector %s"
+	invalidStrategyErr = "invalid placement strategy %s"
+)
+
+//SelectCluster chooses one of the ready clusters matching the selector based on the placement strategy
+//Empty strategy defaults to spread
+func SelectCluster(ctx context.Context, selector *metav1.LabelSelector, strategy string, clusters []v1alpha1.Cluster) (*v1alpha1.Cluster, error) {
+	log := log.Logger(ctx, "pkg.placement", "SelectCluster")
+
+	if selector == nil {
+		err := errors.New(noSelectorErr)
+		log.Error(err, noSelectorErr)
+		return nil, err
+	}
+	sel, err := metav1.LabelSelectorAsSelector(selector)
+	if err != nil {
+		log.Error(err, "invalid cluster selector")
+		return nil, err
+	}
+
+	var candidates []v1alpha1.Cluster
+	for _, cluster := range clusters {
+		if !cluster.ObjectMeta.DeletionTimestamp.IsZero() || cluster.Status.State != v1alpha1.Ready {
+			continue
+		}
+		if sel.Matches(labels.Set(cluster.ObjectMeta.Labels)) {
+			candidates = append(candidates, cluster)
+		}
+	}
+	if len(candidates) == 0 {
+		msg := fmt.Sprintf(noClusterErr, sel.String())
+		err := errors.New(msg)
+		log.Error(err, msg)
+		return nil, err
+	}
+
+	//Sort by name first so that ties are always broken the same way
+	sort.Slice(candidates, func(i, j int) bool {
+		return candidates[i].Name < candidates[j].Name
+	})
+
+	switch strategy {
+	case Spread, "":
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return candidates[i].Status.NamespaceCount < candidates[j].Status.NamespaceCount
+		})
+	case LeastLoaded:
+		sort.SliceStable(candidates, func(i, j int) bool {
+			return cpuPerNamespace(&candidates[i]) > cpuPerNamespace(&candidates[j])
+		})
+	case Pinned:
+		//Already sorted by name
+	default:
+		msg := fmt.Sprintf(invalidStrategyErr, strategy)
+		err := errors.New(msg)
+		log.Error(err, msg)
+		return nil, err
+	}
+
+	log.V(1).Info("Cluster selected", "cluster", candidates[0].Name, "strategy", strategy, "candidates", len(candidates))
+	return &candidates[0], nil
+}
+
+//cpuPerNamespace returns the allocatable cpu (in milli cores) available for each managed namespace including the new one
+func cpuPerNamespace(cluster *v1alpha1.Cluster) float64 {
+	if cluster.Status.AllocatableCPU == nil {
+		return 0
+	}
+	return float64(cluster.Status.AllocatableCPU.MilliValue()) / float64(cluster.Status.NamespaceCount+1)
+}
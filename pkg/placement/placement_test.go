@@ -0,0 +1,101 @@
+package placement_test
+
+import (
+	"context"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/placement"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"k8s.io/apimachinery/pkg/api/resource"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func newCluster(name string, region string, state v1alpha1.State, nsCount int, cpu string) v1alpha1.Cluster {
+	cluster := v1alpha1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: map[string]string{"env": "prod", "region": region},
+		},
+		Status: v1alpha1.ClusterStatus{
+			State:          state,
+			NamespaceCount: nsCount,
+		},
+	}
+	if cpu != "" {
+		q := resource.MustParse(cpu)
+		cluster.Status.AllocatableCPU = &q
+	}
+	return cluster
+}
+
+var _ = Describe("Placement", func() {
+	clusters := []v1alpha1.Cluster{
+		newCluster("cluster-c", "us-west-2", v1alpha1.Ready, 10, "100"),
+		newCluster("cluster-b", "us-west-2", v1alpha1.Ready, 2, "8"),
+		newCluster("cluster-a", "us-west-2", v1alpha1.Ready, 5, "40"),
+		newCluster("cluster-d", "us-west-2", v1alpha1.Error, 0, "200"),
+		newCluster("cluster-e", "us-east-1", v1alpha1.Ready, 0, "200"),
+	}
+	usWest := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod", "region": "us-west-2"}}
+
+	Describe("SelectCluster", func() {
+		Context("spread strategy", func() {
+			It("should choose the matching cluster with the least namespaces", func() {
+				cluster, err := placement.SelectCluster(context.Background(), usWest, placement.Spread, clusters)
+				Expect(err).To(BeNil())
+				Expect(cluster.Name).To(Equal("cluster-b"))
+			})
+			It("should be the default strategy", func() {
+				cluster, err := placement.SelectCluster(context.Background(), usWest, "", clusters)
+				Expect(err).To(BeNil())
+				Expect(cluster.Name).To(Equal("cluster-b"))
+			})
+		})
+
+		Context("least-loaded strategy", func() {
+			It("should choose the matching cluster with the most cpu per namespace", func() {
+				cluster, err := placement.SelectCluster(context.Background(), usWest, placement.LeastLoaded, clusters)
+				Expect(err).To(BeNil())
+				Expect(cluster.Name).To(Equal("cluster-c"))
+			})
+		})
+
+		Context("pinned strategy", func() {
+			It("should choose the first matching cluster by name", func() {
+				cluster, err := placement.SelectCluster(context.Background(), usWest, placement.Pinned, clusters)
+				Expect(err).To(BeNil())
+				Expect(cluster.Name).To(Equal("cluster-a"))
+			})
+		})
+
+		Context("match expressions", func() {
+			It("should honor the expressions", func() {
+				selector := &metav1.LabelSelector{
+					MatchExpressions: []metav1.LabelSelectorRequirement{
+						{Key: "region", Operator: metav1.LabelSelectorOpIn, Values: []string{"us-east-1"}},
+					},
+				}
+				cluster, err := placement.SelectCluster(context.Background(), selector, placement.Spread, clusters)
+				Expect(err).To(BeNil())
+				Expect(cluster.Name).To(Equal("cluster-e"))
+			})
+		})
+
+		Context("error use cases", func() {
+			It("should fail if no ready cluster matches", func() {
+				selector := &metav1.LabelSelector{MatchLabels: map[string]string{"region": "eu-west-1"}}
+				_, err := placement.SelectCluster(context.Background(), selector, placement.Spread, clusters)
+				Expect(err).NotTo(BeNil())
+			})
+			It("should fail if selector is not provided", func() {
+				_, err := placement.SelectCluster(context.Background(), nil, placement.Spread, clusters)
+				Expect(err).NotTo(BeNil())
+			})
+			It("should fail for unknown strategy", func() {
+				_, err := placement.SelectCluster(context.Background(), usWest, "random", clusters)
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
+})
@@ -0,0 +1,313 @@
+package rbac
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/log"
+	"sort"
+	"strings"
+
+	authv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilerrors "k8s.io/apimachinery/pkg/util/errors"
+)
+
+//ResourceMapper maps the kind to the resource in the managed cluster
+type ResourceMapper func(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error)
+
+var (
+	//ManagedVerbs are the verbs manager needs on the resources it manages
+	ManagedVerbs = []string{"get", "list", "watch", "create", "update", "patch", "delete"}
+
+	//probeVerbs are the verbs validated in the managed cluster during the cluster probe
+	probeVerbs = []string{"create", "update", "delete"}
+)
+
+//BaseRules returns the cluster role rules manager needs in every managed cluster irrespective of the templates
+func BaseRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		{
+			APIGroups: []string{""},
+			Resources: []string{"namespaces"},
+			Verbs:     ManagedVerbs,
+		},
+		//Cluster inventory
+		{
+			APIGroups: []string{""},
+			Resources: []string{"nodes"},
+			Verbs:     []string{"get", "list"},
+		},
+		//Permission checks during the cluster probe
+		{
+			APIGroups: []string{authv1.GroupName},
+			Resources: []string{"selfsubjectaccessreviews"},
+			Verbs:     []string{"create"},
+		},
+		//Clean up while unregistering the cluster
+		//Manager never modifies its own cluster role. Admin grants the new kinds with "manager cluster sync-rbac"
+		{
+			APIGroups:     []string{rbacv1.GroupName},
+			Resources:     []string{"clusterroles"},
+			ResourceNames: []string{common.ManagerClusterRole},
+			Verbs:         []string{"get", "delete"},
+		},
+		{
+			APIGroups:     []string{rbacv1.GroupName},
+			Resources:     []string{"clusterrolebindings"},
+			ResourceNames: []string{common.ManagerClusterRoleBinding},
+			Verbs:         []string{"get", "update", "patch"},
+		},
+		{
+			APIGroups:     []string{""},
+			Resources:     []string{"serviceaccounts"},
+			ResourceNames: []string{common.ManagerServiceAccountName},
+			Verbs:         []string{"get", "update", "patch"},
+		},
+	}
+}
+
+//TokenRules returns the role rules manager needs in the system namespace to rotate the bearer tokens
+func TokenRules() []rbacv1.PolicyRule {
+	return []rbacv1.PolicyRule{
+		//Create can't be restricted to the resource names. Rest of the verbs are limited to the manager token secrets
+		{
+			APIGroups: []string{""},
+			Resources: []string{"secrets"},
+			Verbs:     []string{"create"},
+		},
+		{
+			APIGroups:     []string{""},
+			Resources:     []string{"secrets"},
+			ResourceNames: []string{common.ManagerTokenSecretA, common.ManagerTokenSecretB},
+			Verbs:         []string{"get", "update", "delete"},
+		},
+		//Clean up while unregistering the cluster
+		{
+			APIGroups:     []string{rbacv1.GroupName},
+			Resources:     []string{"roles"},
+			ResourceNames: []string{common.ManagerTokenRole},
+			Verbs:         []string{"get", "update", "patch"},
+		},
+		{
+			APIGroups:     []string{rbacv1.GroupName},
+			Resources:     []string{"rolebindings"},
+			ResourceNames: []string{common.ManagerTokenRoleBinding},
+			Verbs:         []string{"get", "update", "patch"},
+		},
+	}
+}
+
+//TemplateRules returns the rules needed to create the resources included in the template
+func TemplateRules(resources *namespace.NamespaceResources, mapper ResourceMapper) ([]rbacv1.PolicyRule, error) {
+	var rules []rbacv1.PolicyRule
+	if resources == nil {
+		return rules, nil
+	}
+	for _, res := range resources.Resources {
+		switch res.Type {
+		case common.ServiceAccountKind:
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"serviceaccounts"}, Verbs: ManagedVerbs})
+		case common.ResourceQuotaKind:
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{""}, Resources: []string{"resourcequotas"}, Verbs: ManagedVerbs})
+		case common.RoleKind:
+			//Roles are limited to the permissions manager itself has unless the escalation is allowed. See EscalationRules
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles"}, Verbs: ManagedVerbs})
+		case common.RoleBindingKind:
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"rolebindings"}, Verbs: ManagedVerbs})
+		case common.CustomResourceKind:
+			if res.CustomResource == nil || res.CustomResource.GVK == nil {
+				return nil, fmt.Errorf("custom resource %s doesn't include the group version kind", res.Name)
+			}
+			gvk := schema.GroupVersionKind{Group: res.CustomResource.GVK.Group, Version: res.CustomResource.GVK.Version, Kind: res.CustomResource.GVK.Kind}
+			if mapper == nil {
+				return nil, fmt.Errorf("unable to find the resource for %s", gvk.String())
+			}
+			gvr, err := mapper(gvk)
+			if err != nil {
+				return nil, err
+			}
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{gvr.Group}, Resources: []string{gvr.Resource}, Verbs: ManagedVerbs})
+		}
+	}
+	return dedupe(rules), nil
+}
+
+//EscalationRules returns the rules which let the manager create the roles and role bindings of the template
+//granting the permissions manager itself doesn't have. Template role names are parameterized so the rules
+//can't be restricted to the resource names. They allow binding any role including cluster-admin, hence they are
+//granted only when the cluster admin opts in
+func EscalationRules(resources *namespace.NamespaceResources) []rbacv1.PolicyRule {
+	var rules []rbacv1.PolicyRule
+	if resources == nil {
+		return rules
+	}
+	for _, res := range resources.Resources {
+		switch res.Type {
+		case common.RoleKind:
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles"}, Verbs: []string{"escalate"}})
+		case common.RoleBindingKind:
+			rules = append(rules, rbacv1.PolicyRule{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles", "clusterroles"}, Verbs: []string{"bind"}})
+		}
+	}
+	return dedupe(rules)
+}
+
+//ClusterRoleRules generates the manager cluster role rules from the base rules and the kinds used in the templates
+//Templates which can not be mapped in the managed cluster are skipped and reported in the error
+func ClusterRoleRules(ctx context.Context, templates []v1alpha1.NamespaceTemplate, mapper ResourceMapper) ([]rbacv1.PolicyRule, error) {
+	log := log.Logger(ctx, "pkg.rbac", "ClusterRoleRules")
+
+	rules := BaseRules()
+	var errs []error
+	for i := range templates {
+		templateRules, err := TemplateRules(templates[i].Spec.NsResources, mapper)
+		if err != nil {
+			log.Error(err, "unable to generate the rules for the template", "template", templates[i].Name)
+			errs = append(errs, fmt.Errorf("template %s: %v", templates[i].Name, err))
+			continue
+		}
+		rules = append(rules, templateRules...)
+	}
+	return dedupe(rules), utilerrors.NewAggregate(errs)
+}
+
+//Merge combines the rule sets dropping the duplicate rules
+func Merge(ruleSets ...[]rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	var rules []rbacv1.PolicyRule
+	for _, ruleSet := range ruleSets {
+		rules = append(rules, ruleSet...)
+	}
+	return dedupe(rules)
+}
+
+//Permissions flattens the rules into the permissions to be validated in the managed cluster
+//Rules restricted to the resource names are skipped
+func Permissions(rules []rbacv1.PolicyRule) []authv1.ResourceAttributes {
+	var permissions []authv1.ResourceAttributes
+	seen := make(map[authv1.ResourceAttributes]bool)
+	for _, rule := range rules {
+		if len(rule.ResourceNames) > 0 {
+			continue
+		}
+		for _, verb := range rule.Verbs {
+			if !contains(probeVerbs, verb) {
+				continue
+			}
+			for _, group := range rule.APIGroups {
+				for _, resource := range rule.Resources {
+					p := authv1.ResourceAttributes{Verb: verb, Group: group, Resource: resource}
+					if !seen[p] {
+						seen[p] = true
+						permissions = append(permissions, p)
+					}
+				}
+			}
+		}
+	}
+	return permissions
+}
+
+//RulesEqual compares the rules irrespective of the order
+func RulesEqual(a []rbacv1.PolicyRule, b []rbacv1.PolicyRule) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	keys := make(map[string]int)
+	for _, rule := range a {
+		keys[ruleKey(rule)]++
+	}
+	for _, rule := range b {
+		keys[ruleKey(rule)]--
+	}
+	for _, v := range keys {
+		if v != 0 {
+			return false
+		}
+	}
+	return true
+}
+
+func dedupe(rules []rbacv1.PolicyRule) []rbacv1.PolicyRule {
+	var result []rbacv1.PolicyRule
+	seen := make(map[string]bool)
+	for _, rule := range rules {
+		key := ruleKey(rule)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		result = append(result, rule)
+	}
+	return result
+}
+
+func ruleKey(rule rbacv1.PolicyRule) string {
+	return strings.Join([]string{
+		sortedKey(rule.APIGroups),
+		sortedKey(rule.Resources),
+		sortedKey(rule.ResourceNames),
+		sortedKey(rule.NonResourceURLs),
+		sortedKey(rule.Verbs),
+	}, "|")
+}
+
+func sortedKey(values []string) string {
+	sorted := append([]string(nil), values...)
+	sort.Strings(sorted)
+	return strings.Join(sorted, ",")
+}
+
+func contains(slice []string, s string) bool {
+	for _, item := range slice {
+		if item == s {
+			return true
+		}
+	}
+	return false
+}
+
+//TokenRole returns the role granting TokenRules to the manager in the system namespace
+func TokenRole() *rbacv1.Role {
+	return &rbacv1.Role{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: common.RBACApiVersion,
+			Kind:       common.RoleKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ManagerTokenRole,
+			Namespace: common.SystemNameSpace,
+		},
+		Rules: TokenRules(),
+	}
+}
+
+//TokenRoleBinding returns the role binding of the TokenRole to the manager service account
+func TokenRoleBinding() *rbacv1.RoleBinding {
+	return &rbacv1.RoleBinding{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: common.RBACApiVersion,
+			Kind:       common.RoleBindingKind,
+		},
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      common.ManagerTokenRoleBinding,
+			Namespace: common.SystemNameSpace,
+		},
+		RoleRef: rbacv1.RoleRef{
+			APIGroup: rbacv1.GroupName,
+			Kind:     common.RoleKind,
+			Name:     common.ManagerTokenRole,
+		},
+		Subjects: []rbacv1.Subject{
+			{
+				Kind:      common.ServiceAccountKind,
+				Name:      common.ManagerServiceAccountName,
+				Namespace: common.SystemNameSpace,
+			},
+		},
+	}
+}
@@ -0,0 +1,161 @@
+package rbac_test
+
+import (
+	"context"
+	"errors"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/rbac"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+
+	authv1 "k8s.io/api/authorization/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func mapper(gvk schema.GroupVersionKind) (schema.GroupVersionResource, error) {
+	if gvk.Kind == "Foo" {
+		return schema.GroupVersionResource{Group: gvk.Group, Version: gvk.Version, Resource: "foos"}, nil
+	}
+	return schema.GroupVersionResource{}, errors.New("no matches for kind " + gvk.Kind)
+}
+
+func template(name string, resources ...*namespace.Resource) v1alpha1.NamespaceTemplate {
+	return v1alpha1.NamespaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: v1alpha1.NamespaceTemplateSpec{
+			NamespaceTemplate: namespace.NamespaceTemplate{
+				NsResources: &namespace.NamespaceResources{Resources: resources},
+			},
+		},
+	}
+}
+
+func customResource(kind string) *namespace.Resource {
+	return &namespace.Resource{
+		Name: "cr",
+		Type: "CustomResource",
+		CustomResource: &namespace.CustomResource{
+			GVK: &namespace.GroupVersionKind{Group: "example.com", Version: "v1", Kind: kind},
+		},
+	}
+}
+
+var _ = Describe("Rules", func() {
+	Describe("ClusterRoleRules", func() {
+		Context("without templates", func() {
+			It("should include only the base rules", func() {
+				rules, err := rbac.ClusterRoleRules(context.Background(), nil, mapper)
+				Expect(err).To(BeNil())
+				Expect(rbac.RulesEqual(rules, rbac.BaseRules())).To(BeTrue())
+			})
+			It("should not include any wildcard", func() {
+				rules, _ := rbac.ClusterRoleRules(context.Background(), nil, mapper)
+				for _, rule := range rules {
+					Expect(rule.APIGroups).NotTo(ContainElement("*"))
+					Expect(rule.Resources).NotTo(ContainElement("*"))
+					Expect(rule.Verbs).NotTo(ContainElement("*"))
+					Expect(rule.NonResourceURLs).To(BeEmpty())
+				}
+			})
+		})
+		Context("templates with custom resources", func() {
+			It("should include the mapped resource", func() {
+				rules, err := rbac.ClusterRoleRules(context.Background(), []v1alpha1.NamespaceTemplate{template("foo", customResource("Foo"))}, mapper)
+				Expect(err).To(BeNil())
+				Expect(rbac.Permissions(rules)).To(ContainElement(authv1.ResourceAttributes{Verb: "create", Group: "example.com", Resource: "foos"}))
+			})
+			It("should skip the template which can not be mapped and report it", func() {
+				rules, err := rbac.ClusterRoleRules(context.Background(), []v1alpha1.NamespaceTemplate{template("bar", customResource("Bar"))}, mapper)
+				Expect(err).NotTo(BeNil())
+				Expect(rbac.RulesEqual(rules, rbac.BaseRules())).To(BeTrue())
+			})
+		})
+		Context("templates with roles and role bindings", func() {
+			It("should not allow the escalation", func() {
+				role := &namespace.Resource{Name: "role", Type: "Role"}
+				binding := &namespace.Resource{Name: "binding", Type: "RoleBinding"}
+				rules, err := rbac.ClusterRoleRules(context.Background(), []v1alpha1.NamespaceTemplate{template("a", role, binding)}, mapper)
+				Expect(err).To(BeNil())
+				for _, rule := range rules {
+					Expect(rule.Verbs).NotTo(ContainElement("escalate"))
+					Expect(rule.Verbs).NotTo(ContainElement("bind"))
+				}
+			})
+		})
+		Context("templates sharing the kinds", func() {
+			It("should not duplicate the rules", func() {
+				sa := &namespace.Resource{Name: "sa", Type: "ServiceAccount"}
+				rules, err := rbac.ClusterRoleRules(context.Background(), []v1alpha1.NamespaceTemplate{template("a", sa), template("b", sa)}, mapper)
+				Expect(err).To(BeNil())
+				Expect(rules).To(HaveLen(len(rbac.BaseRules()) + 1))
+			})
+		})
+	})
+
+	Describe("EscalationRules", func() {
+		It("should grant escalate and bind only for the kinds in the template", func() {
+			role := &namespace.Resource{Name: "role", Type: "Role"}
+			binding := &namespace.Resource{Name: "binding", Type: "RoleBinding"}
+			sa := &namespace.Resource{Name: "sa", Type: "ServiceAccount"}
+			for _, entry := range []struct {
+				name      string
+				resources *namespace.NamespaceResources
+				rules     []rbacv1.PolicyRule
+			}{
+				{name: "no resources"},
+				{name: "no roles", resources: &namespace.NamespaceResources{Resources: []*namespace.Resource{sa}}},
+				{
+					name:      "role",
+					resources: &namespace.NamespaceResources{Resources: []*namespace.Resource{role}},
+					rules:     []rbacv1.PolicyRule{{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles"}, Verbs: []string{"escalate"}}},
+				},
+				{
+					name:      "role binding",
+					resources: &namespace.NamespaceResources{Resources: []*namespace.Resource{binding, sa}},
+					rules:     []rbacv1.PolicyRule{{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"roles", "clusterroles"}, Verbs: []string{"bind"}}},
+				},
+			} {
+				Expect(rbac.RulesEqual(rbac.EscalationRules(entry.resources), entry.rules)).To(BeTrue(), entry.name)
+			}
+		})
+	})
+
+	Describe("Permissions", func() {
+		It("should skip the rules restricted to resource names", func() {
+			permissions := rbac.Permissions([]rbacv1.PolicyRule{
+				{APIGroups: []string{rbacv1.GroupName}, Resources: []string{"clusterroles"}, ResourceNames: []string{"x"}, Verbs: []string{"update"}},
+			})
+			Expect(permissions).To(BeEmpty())
+		})
+		It("should include only the probe verbs", func() {
+			permissions := rbac.Permissions([]rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"namespaces"}, Verbs: rbac.ManagedVerbs},
+			})
+			Expect(permissions).To(ConsistOf(
+				authv1.ResourceAttributes{Verb: "create", Resource: "namespaces"},
+				authv1.ResourceAttributes{Verb: "update", Resource: "namespaces"},
+				authv1.ResourceAttributes{Verb: "delete", Resource: "namespaces"},
+			))
+		})
+	})
+
+	Describe("RulesEqual", func() {
+		It("should ignore the order", func() {
+			a := []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"namespaces"}, Verbs: []string{"get", "list"}},
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"list"}},
+			}
+			b := []rbacv1.PolicyRule{
+				{APIGroups: []string{""}, Resources: []string{"nodes"}, Verbs: []string{"list"}},
+				{APIGroups: []string{""}, Resources: []string{"namespaces"}, Verbs: []string{"list", "get"}},
+			}
+			Expect(rbac.RulesEqual(a, b)).To(BeTrue())
+		})
+		It("should detect the new rules", func() {
+			Expect(rbac.RulesEqual(rbac.BaseRules(), append(rbac.BaseRules(), rbacv1.PolicyRule{Resources: []string{"foos"}}))).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,75 @@
+package retry
+
+import (
+	"errors"
+	"math/rand"
+	"time"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	//BaseBackoff is the wait time before the first retry
+	BaseBackoff = 5 * time.Second
+	//DefaultMaxBackoff is used when the cap is not configured
+	DefaultMaxBackoff = 300 * time.Second
+)
+
+//PermanentError is the error which can not be fixed by retrying. Object has to be changed
+type PermanentError struct {
+	Err error
+}
+
+func (e *PermanentError) Error() string {
+	return e.Err.Error()
+}
+
+func (e *PermanentError) Unwrap() error {
+	return e.Err
+}
+
+//Permanent marks the error as permanent. Nil error is returned as is
+func Permanent(err error) error {
+	if err == nil {
+		return nil
+	}
+	return &PermanentError{Err: err}
+}
+
+//IsPermanent returns true if retrying won't help
+//Errors marked with Permanent and forbidden, invalid and bad request responses from the api server are permanent.
+//Everything else including network errors, conflicts and 5xx responses is transient
+func IsPermanent(err error) bool {
+	if err == nil {
+		return false
+	}
+	var permanent *PermanentError
+	if errors.As(err, &permanent) {
+		return true
+	}
+	var status apierrs.APIStatus
+	if errors.As(err, &status) {
+		switch status.Status().Reason {
+		case metav1.StatusReasonForbidden, metav1.StatusReasonInvalid, metav1.StatusReasonBadRequest:
+			return true
+		}
+	}
+	return false
+}
+
+//Backoff returns the wait time before the retry. Wait time doubles with every retry up to the max
+//and a random jitter of up to half of it is applied so that the objects failing together don't retry together
+func Backoff(retryCount int, max time.Duration) time.Duration {
+	if max <= 0 {
+		max = DefaultMaxBackoff
+	}
+	d := BaseBackoff
+	for i := 1; i < retryCount && d < max; i++ {
+		d = d * 2
+	}
+	if d > max {
+		d = max
+	}
+	return d/2 + time.Duration(rand.Int63n(int64(d/2)+1))
+}
@@ -0,0 +1,70 @@
+package retry_test
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/keikoproj/manager/pkg/retry"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+)
+
+var _ = Describe("Retry", func() {
+	gr := schema.GroupResource{Resource: "namespaces"}
+
+	Context("IsPermanent", func() {
+		It("should treat the marked errors as permanent", func() {
+			err := retry.Permanent(errors.New("invalid template"))
+			Expect(retry.IsPermanent(err)).To(BeTrue())
+			Expect(retry.IsPermanent(fmt.Errorf("unable to process: %w", err))).To(BeTrue())
+			Expect(err.Error()).To(Equal("invalid template"))
+			Expect(retry.Permanent(nil)).To(BeNil())
+		})
+
+		It("should treat forbidden and invalid responses as permanent", func() {
+			Expect(retry.IsPermanent(apierrs.NewForbidden(gr, "ns", errors.New("denied")))).To(BeTrue())
+			Expect(retry.IsPermanent(apierrs.NewInvalid(schema.GroupKind{Kind: "Namespace"}, "ns", field.ErrorList{}))).To(BeTrue())
+			Expect(retry.IsPermanent(apierrs.NewBadRequest("bad"))).To(BeTrue())
+			Expect(retry.IsPermanent(fmt.Errorf("apply failed: %w", apierrs.NewForbidden(gr, "ns", errors.New("denied"))))).To(BeTrue())
+		})
+
+		It("should treat everything else as transient", func() {
+			Expect(retry.IsPermanent(nil)).To(BeFalse())
+			Expect(retry.IsPermanent(errors.New("connection refused"))).To(BeFalse())
+			Expect(retry.IsPermanent(apierrs.NewConflict(gr, "ns", errors.New("modified")))).To(BeFalse())
+			Expect(retry.IsPermanent(apierrs.NewInternalError(errors.New("boom")))).To(BeFalse())
+			Expect(retry.IsPermanent(apierrs.NewServiceUnavailable("down"))).To(BeFalse())
+			Expect(retry.IsPermanent(apierrs.NewNotFound(gr, "ns"))).To(BeFalse())
+		})
+	})
+
+	Context("Backoff", func() {
+		It("should double the wait time with every retry", func() {
+			for i, want := range []time.Duration{5 * time.Second, 10 * time.Second, 20 * time.Second, 40 * time.Second} {
+				d := retry.Backoff(i+1, time.Hour)
+				Expect(d).To(BeNumerically(">=", want/2))
+				Expect(d).To(BeNumerically("<=", want))
+			}
+		})
+
+		It("should not exceed the max", func() {
+			for i := 0; i < 100; i++ {
+				Expect(retry.Backoff(i, time.Minute)).To(BeNumerically("<=", time.Minute))
+				Expect(retry.Backoff(i, 0)).To(BeNumerically("<=", retry.DefaultMaxBackoff))
+			}
+			Expect(retry.Backoff(1000, time.Minute)).To(BeNumerically(">=", 30*time.Second))
+		})
+
+		It("should apply the jitter", func() {
+			seen := make(map[time.Duration]bool)
+			for i := 0; i < 20; i++ {
+				seen[retry.Backoff(5, time.Hour)] = true
+			}
+			Expect(len(seen)).To(BeNumerically(">", 1))
+		})
+	})
+})
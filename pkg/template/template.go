@@ -3,10 +3,15 @@ package template
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	managerv1alpha1 "github.com/keikoproj/manager/api/v1alpha1"
 	"github.com/keikoproj/manager/internal/config/common"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"github.com/keikoproj/manager/pkg/tracing"
 	"github.com/keikoproj/manager/pkg/validation"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/trace"
 	"strings"
 )
 
@@ -16,8 +21,16 @@ import (
 //Decide either to convert the template to Go Template or write our own template
 
 //ProcessTemplate function is an utility function to replace the template exported fields with dynamic values
-func ProcessTemplate(ctx context.Context, template *managerv1alpha1.NamespaceTemplate, nsReq *managerv1alpha1.ManagedNamespace) error {
+func ProcessTemplate(ctx context.Context, template *managerv1alpha1.NamespaceTemplate, nsReq *managerv1alpha1.ManagedNamespace) (err error) {
+	ctx, span := tracing.Start(ctx, "template.ProcessTemplate", trace.WithAttributes(attribute.String("manager.template", template.Name)))
+	defer span.End()
 	log := log.Logger(ctx, "pkg.template", "template", "ExecuteTemplate")
+	defer func() {
+		if err != nil {
+			tracing.RecordError(ctx, err)
+			metrics.TemplateRenderFailures.WithLabelValues(template.Name).Inc()
+		}
+	}()
 
 	//Validate Namespace Template
 	if err := validation.ValidateTemplate(ctx, template.Spec.NsResources); err != nil {
@@ -39,7 +52,12 @@ func ProcessTemplate(ctx context.Context, template *managerv1alpha1.NamespaceTem
 	log.V(1).Info("Exported params", "count", len(template.Spec.ExportedParamName))
 	//Replace it from the namespace request
 	for _, param := range template.Spec.ExportedParamName {
-		templateString = strings.ReplaceAll(templateString, "${"+param+"}", nsReq.Spec.Params[param])
+		value, err := ParamValue(template, nsReq, param)
+		if err != nil {
+			log.Error(err, "unable to process the template")
+			return err
+		}
+		templateString = strings.ReplaceAll(templateString, "${"+param+"}", value)
 	}
 
 	//log.V(1).Info("template ", "temp", templateString)
@@ -99,7 +117,11 @@ func ProcessCustomResourceTemplate(ctx context.Context, template managerv1alpha1
 			customTemplateString = string(bytes)
 			//Replace it from the namespace request
 			for _, param := range template.Spec.ExportedParamName {
-				customTemplateString = strings.ReplaceAll(customTemplateString, "${"+param+"}", nsReq.Spec.Params[param])
+				value, err := ParamValue(&template, nsReq, param)
+				if err != nil {
+					return err
+				}
+				customTemplateString = strings.ReplaceAll(customTemplateString, "${"+param+"}", value)
 			}
 			res.CustomResource.Manifest = customTemplateString
 			nsReq.Spec.NsResources.Resources = append(nsReq.Spec.NsResources.Resources, res)
@@ -109,3 +131,21 @@ func ProcessCustomResourceTemplate(ctx context.Context, template managerv1alpha1
 
 	return nil
 }
+
+//ParamValue returns the value of the exported param from the namespace request
+//Default value documented in the template is used if the namespace doesn't provide the value
+func ParamValue(template *managerv1alpha1.NamespaceTemplate, nsReq *managerv1alpha1.ManagedNamespace, param string) (string, error) {
+	if value, ok := nsReq.Spec.Params[param]; ok {
+		return value, nil
+	}
+	for _, p := range template.Spec.Params {
+		if p.Name != param {
+			continue
+		}
+		if p.DefaultValue == "" && p.Required {
+			return "", fmt.Errorf("required param %s is not provided for template %s", param, template.Name)
+		}
+		return p.DefaultValue, nil
+	}
+	return "", nil
+}
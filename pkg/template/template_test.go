@@ -130,4 +130,59 @@ var _ = Describe("template test suite", func() {
 			})
 		})
 	})
+
+	Describe("ParamValue test cases", func() {
+		nsTemplate := &v1alpha1.NamespaceTemplate{
+			ObjectMeta: metav1.ObjectMeta{
+				Name: "default-template",
+			},
+			Spec: v1alpha1.NamespaceTemplateSpec{
+				NamespaceTemplate: namespace.NamespaceTemplate{
+					ExportedParamName: []string{"env", "team", "owner"},
+					Params: []*namespace.Param{
+						{
+							Name:         "env",
+							DefaultValue: "dev",
+						},
+						{
+							Name:     "owner",
+							Required: true,
+						},
+					},
+				},
+			},
+		}
+		mns := func(params map[string]string) *v1alpha1.ManagedNamespace {
+			return &v1alpha1.ManagedNamespace{
+				Spec: v1alpha1.ManagedNamespaceSpec{
+					Namespace: namespace.Namespace{
+						Params: params,
+					},
+				},
+			}
+		}
+		Context("param is provided in the namespace", func() {
+			It("should take precedence over the default value", func() {
+				value, err := template.ParamValue(nsTemplate, mns(map[string]string{"env": "prod"}), "env")
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal("prod"))
+			})
+		})
+		Context("param is not provided in the namespace", func() {
+			It("should use the default value", func() {
+				value, err := template.ParamValue(nsTemplate, mns(nil), "env")
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal("dev"))
+			})
+			It("should be empty if the param is not documented", func() {
+				value, err := template.ParamValue(nsTemplate, mns(nil), "team")
+				Expect(err).To(BeNil())
+				Expect(value).To(Equal(""))
+			})
+			It("should fail if the param is required", func() {
+				_, err := template.ParamValue(nsTemplate, mns(nil), "owner")
+				Expect(err).NotTo(BeNil())
+			})
+		})
+	})
 })
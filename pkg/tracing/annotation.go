@@ -0,0 +1,81 @@
+package tracing
+
+import (
+	"context"
+
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/trace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	//annotationPrefix is prepended to the propagation fields (traceparent, tracestate) to form the annotation keys
+	annotationPrefix = "manager.keikoproj.io/"
+	//TraceParentAnnotation carries the W3C trace context of the last request which changed the object
+	TraceParentAnnotation = annotationPrefix + "traceparent"
+)
+
+//annotationCarrier adapts the object annotations to the propagation carrier
+type annotationCarrier struct {
+	obj metav1.Object
+}
+
+func (c annotationCarrier) Get(key string) string {
+	return c.obj.GetAnnotations()[annotationPrefix+key]
+}
+
+func (c annotationCarrier) Set(key string, value string) {
+	annotations := c.obj.GetAnnotations()
+	if annotations == nil {
+		annotations = make(map[string]string)
+	}
+	annotations[annotationPrefix+key] = value
+	c.obj.SetAnnotations(annotations)
+}
+
+func (c annotationCarrier) Keys() []string {
+	var keys []string
+	for k := range c.obj.GetAnnotations() {
+		if len(k) > len(annotationPrefix) && k[:len(annotationPrefix)] == annotationPrefix {
+			keys = append(keys, k[len(annotationPrefix):])
+		}
+	}
+	return keys
+}
+
+//Inject records the trace context of the request in the object annotations before the object is created or updated
+//Controllers reconciling the object link their spans to the request with it
+func Inject(ctx context.Context, obj metav1.Object) {
+	if !trace.SpanContextFromContext(ctx).IsValid() {
+		return
+	}
+	otel.GetTextMapPropagator().Inject(ctx, annotationCarrier{obj: obj})
+}
+
+//Propagate copies the trace context annotations from the object to the object derived from it
+//Derived objects are linked to the request which changed the original object
+func Propagate(from metav1.Object, to metav1.Object) {
+	for _, key := range (annotationCarrier{obj: from}).Keys() {
+		annotationCarrier{obj: to}.Set(key, annotationCarrier{obj: from}.Get(key))
+	}
+}
+
+//Extract returns the span context recorded in the object annotations
+func Extract(obj metav1.Object) trace.SpanContext {
+	ctx := otel.GetTextMapPropagator().Extract(context.Background(), annotationCarrier{obj: obj})
+	return trace.SpanContextFromContext(ctx)
+}
+
+//StartReconcile starts the span for reconciling the object
+//Reconciles are repeated periodically, so the span starts a new trace linked to the request which last changed the object
+func StartReconcile(ctx context.Context, name string, kind string, obj metav1.Object) (context.Context, trace.Span) {
+	opts := []trace.SpanStartOption{trace.WithNewRoot(), trace.WithAttributes(ObjectAttributes(kind, obj.GetNamespace(), obj.GetName())...)}
+	if sc := Extract(obj); sc.IsValid() {
+		opts = append(opts, trace.WithLinks(trace.Link{SpanContext: sc}))
+	}
+	return Start(ctx, name, opts...)
+}
+
+//ensure the carrier satisfies the interface
+var _ propagation.TextMapCarrier = annotationCarrier{}
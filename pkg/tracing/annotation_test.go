@@ -0,0 +1,103 @@
+package tracing_test
+
+import (
+	"context"
+	"errors"
+
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/pkg/tracing"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/propagation"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	"go.opentelemetry.io/otel/sdk/trace/tracetest"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var _ = Describe("Annotations", func() {
+	var recorder *tracetest.SpanRecorder
+
+	BeforeEach(func() {
+		recorder = tracetest.NewSpanRecorder()
+		otel.SetTracerProvider(sdktrace.NewTracerProvider(sdktrace.WithSpanProcessor(recorder)))
+		otel.SetTextMapPropagator(propagation.TraceContext{})
+	})
+
+	newApp := func() *v1alpha1.Application {
+		return &v1alpha1.Application{ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "manager-system"}}
+	}
+
+	Context("Inject", func() {
+		It("should record the trace context in the annotations", func() {
+			ctx, span := tracing.Start(context.Background(), "request")
+			defer span.End()
+			app := newApp()
+			tracing.Inject(ctx, app)
+			Expect(app.Annotations).To(HaveKey(tracing.TraceParentAnnotation))
+
+			sc := tracing.Extract(app)
+			Expect(sc.IsValid()).To(BeTrue())
+			Expect(sc.TraceID()).To(Equal(span.SpanContext().TraceID()))
+			Expect(sc.SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+
+		It("should not touch the annotations without a span", func() {
+			app := newApp()
+			tracing.Inject(context.Background(), app)
+			Expect(app.Annotations).To(BeNil())
+			Expect(tracing.Extract(app).IsValid()).To(BeFalse())
+		})
+	})
+
+	Context("Propagate", func() {
+		It("should copy only the trace context annotations", func() {
+			ctx, span := tracing.Start(context.Background(), "request")
+			defer span.End()
+			app := newApp()
+			app.Annotations = map[string]string{"owner": "team-a"}
+			tracing.Inject(ctx, app)
+
+			ns := &v1alpha1.ManagedNamespace{ObjectMeta: metav1.ObjectMeta{Name: "app-dev"}}
+			tracing.Propagate(app, ns)
+			Expect(ns.Annotations).To(Equal(map[string]string{tracing.TraceParentAnnotation: app.Annotations[tracing.TraceParentAnnotation]}))
+		})
+	})
+
+	Context("StartReconcile", func() {
+		It("should start a new trace linked to the request", func() {
+			ctx, span := tracing.Start(context.Background(), "request")
+			app := newApp()
+			tracing.Inject(ctx, app)
+			span.End()
+
+			_, reconcile := tracing.StartReconcile(ctx, "Application/Reconcile", "Application", app)
+			reconcile.End()
+
+			Expect(reconcile.SpanContext().TraceID()).NotTo(Equal(span.SpanContext().TraceID()))
+			ended := recorder.Ended()
+			Expect(ended).To(HaveLen(2))
+			Expect(ended[1].Name()).To(Equal("Application/Reconcile"))
+			Expect(ended[1].Links()).To(HaveLen(1))
+			Expect(ended[1].Links()[0].SpanContext.SpanID()).To(Equal(span.SpanContext().SpanID()))
+		})
+
+		It("should not link when the object was not annotated", func() {
+			_, reconcile := tracing.StartReconcile(context.Background(), "Application/Reconcile", "Application", newApp())
+			reconcile.End()
+			Expect(recorder.Ended()[0].Links()).To(BeEmpty())
+		})
+	})
+
+	Context("RecordError", func() {
+		It("should mark the span as failed", func() {
+			ctx, span := tracing.Start(context.Background(), "request")
+			tracing.RecordError(ctx, nil)
+			tracing.RecordError(ctx, errors.New("boom"))
+			span.End()
+			Expect(recorder.Ended()[0].Status().Code).To(Equal(codes.Error))
+			Expect(recorder.Ended()[0].Status().Description).To(Equal("boom"))
+		})
+	})
+})
@@ -0,0 +1,122 @@
+package tracing
+
+import (
+	"context"
+	"fmt"
+	"os"
+
+	"go.opentelemetry.io/contrib/instrumentation/google.golang.org/grpc/otelgrpc"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/exporters/stdout/stdouttrace"
+	"go.opentelemetry.io/otel/propagation"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.4.0"
+	"go.opentelemetry.io/otel/trace"
+	"google.golang.org/grpc"
+)
+
+const (
+	//instrumentationName is the name of the tracer used by the manager
+	instrumentationName = "github.com/keikoproj/manager"
+
+	//Exporters
+	ExporterNone   = "none"
+	ExporterOTLP   = "otlp"
+	ExporterStdout = "stdout"
+)
+
+//Config contains the tracing options
+type Config struct {
+	//Exporter is one of none, otlp and stdout. Default is none
+	Exporter string `json:"exporter,omitempty"`
+	//Endpoint is the OTLP gRPC collector address. Defaults to OTEL_EXPORTER_OTLP_ENDPOINT or localhost:4317
+	Endpoint string `json:"endpoint,omitempty"`
+	//Insecure disables TLS to the collector
+	Insecure bool `json:"insecure,omitempty"`
+	//SampleRatio is the fraction of the new traces sampled. Traces started by the callers follow the caller decision. Default is 1
+	SampleRatio *float64 `json:"sampleRatio,omitempty"`
+}
+
+//Setup installs the global tracer provider and the W3C trace context propagator
+//The returned function flushes the pending spans and must be called before the process exits
+func Setup(ctx context.Context, serviceName string, cfg Config) (func(context.Context) error, error) {
+	otel.SetTextMapPropagator(propagation.NewCompositeTextMapPropagator(propagation.TraceContext{}, propagation.Baggage{}))
+
+	var exporter sdktrace.SpanExporter
+	var err error
+	switch cfg.Exporter {
+	case "", ExporterNone:
+		//Spans are not recorded but the context is still propagated
+		return func(context.Context) error { return nil }, nil
+	case ExporterStdout:
+		exporter, err = stdouttrace.New(stdouttrace.WithWriter(os.Stdout))
+	case ExporterOTLP:
+		var opts []otlptracegrpc.Option
+		if cfg.Endpoint != "" {
+			opts = append(opts, otlptracegrpc.WithEndpoint(cfg.Endpoint))
+		}
+		if cfg.Insecure {
+			opts = append(opts, otlptracegrpc.WithInsecure())
+		}
+		exporter, err = otlptracegrpc.New(ctx, opts...)
+	default:
+		return nil, fmt.Errorf("unknown tracing exporter %s", cfg.Exporter)
+	}
+	if err != nil {
+		return nil, fmt.Errorf("unable to create the %s trace exporter: %v", cfg.Exporter, err)
+	}
+
+	ratio := 1.0
+	if cfg.SampleRatio != nil {
+		ratio = *cfg.SampleRatio
+	}
+	res, err := resource.Merge(resource.Default(), resource.NewWithAttributes(semconv.SchemaURL, semconv.ServiceNameKey.String(serviceName)))
+	if err != nil {
+		return nil, err
+	}
+	provider := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+		sdktrace.WithSampler(sdktrace.ParentBased(sdktrace.TraceIDRatioBased(ratio))),
+	)
+	otel.SetTracerProvider(provider)
+	return provider.Shutdown, nil
+}
+
+//Start starts the span with the global tracer
+func Start(ctx context.Context, name string, opts ...trace.SpanStartOption) (context.Context, trace.Span) {
+	return otel.Tracer(instrumentationName).Start(ctx, name, opts...)
+}
+
+//RecordError marks the span in the context as failed. Nil errors are ignored
+func RecordError(ctx context.Context, err error) {
+	if err == nil {
+		return
+	}
+	span := trace.SpanFromContext(ctx)
+	span.RecordError(err)
+	span.SetStatus(codes.Error, err.Error())
+}
+
+//ObjectAttributes returns the span attributes identifying the object
+func ObjectAttributes(kind string, namespace string, name string) []attribute.KeyValue {
+	attrs := []attribute.KeyValue{attribute.String("k8s.kind", kind), attribute.String("k8s.name", name)}
+	if namespace != "" {
+		attrs = append(attrs, attribute.String("k8s.namespace", namespace))
+	}
+	return attrs
+}
+
+//UnaryServerInterceptor starts the span for every unary rpc. Trace context sent by the caller is continued
+func UnaryServerInterceptor() grpc.UnaryServerInterceptor {
+	return otelgrpc.UnaryServerInterceptor()
+}
+
+//StreamServerInterceptor starts the span for every streaming rpc
+func StreamServerInterceptor() grpc.StreamServerInterceptor {
+	return otelgrpc.StreamServerInterceptor()
+}
@@ -12,6 +12,7 @@ var (
 	uniqueNameErr             = "resource Names must be unique across the template. %s repeated more than once"
 	nonExistDependsOnValueErr = "%s resource DependsOn value referring to a value %s which doesn't exist"
 	circularDependencyErr     = "circular dependency is not allowed for resource dependsOn property"
+	undeclaredParamErr        = "param %s is documented but not included in the exportedParamName"
 )
 
 const (
@@ -83,3 +84,21 @@ func ValidateTemplate(ctx context.Context, resources *namespace.NamespaceResourc
 	}
 	return nil
 }
+
+//ValidateParams function validates that the documented params are exported by the template
+func ValidateParams(ctx context.Context, template *namespace.NamespaceTemplate) error {
+	log := log.Logger(ctx, "pkg.validation", "ValidateParams")
+
+	exported := make(map[string]bool)
+	for _, name := range template.ExportedParamName {
+		exported[name] = true
+	}
+	for _, p := range template.Params {
+		if !exported[p.Name] {
+			err := errors.New(fmt.Sprintf(undeclaredParamErr, p.Name))
+			log.Error(err, fmt.Sprintf(undeclaredParamErr, p.Name))
+			return err
+		}
+	}
+	return nil
+}
@@ -255,4 +255,28 @@ var _ = Describe("Validation in namespace file", func() {
 		})
 	})
 
+
+	Describe("Documented params must be exported", func() {
+		Context("Successful use case", func() {
+			It("Error should be nil", func() {
+				Expect(validation.ValidateParams(context.Background(), &namespace.NamespaceTemplate{
+					ExportedParamName: []string{"env", "team"},
+					Params: []*namespace.Param{
+						{Name: "env", DefaultValue: "dev"},
+					},
+				})).To(BeNil())
+			})
+		})
+
+		Context("Param is not exported", func() {
+			It("Error should NOT be nil", func() {
+				Expect(validation.ValidateParams(context.Background(), &namespace.NamespaceTemplate{
+					ExportedParamName: []string{"env"},
+					Params: []*namespace.Param{
+						{Name: "team"},
+					},
+				})).NotTo(BeNil())
+			})
+		})
+	})
 })
@@ -0,0 +1,196 @@
+package watch
+
+import (
+	"errors"
+	"sort"
+	"sync"
+
+	"github.com/golang/protobuf/proto"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+)
+
+const (
+	Added    = "ADDED"
+	Modified = "MODIFIED"
+	Deleted  = "DELETED"
+)
+
+//ErrExpired is returned when the resource version to resume from is not present in the history anymore
+var ErrExpired = errors.New("resource version is too old to resume the watch")
+
+//Filter decides whether the event to be delivered to the subscriber
+type Filter func(event *apis.WatchEvent) bool
+
+//Broadcaster delivers the events to the subscribers
+//It keeps the latest event of each resource to serve the current state to the new subscribers
+//and a bounded history of the events to let the subscribers resume from a resource version
+type Broadcaster struct {
+	mu          sync.Mutex
+	latest      map[string]*apis.WatchEvent
+	history     []*apis.WatchEvent
+	next        int
+	bufferSize  int
+	subscribers map[*Subscription]struct{}
+}
+
+//Subscription receives the events matching the filter
+type Subscription struct {
+	events  chan *apis.WatchEvent
+	filter  Filter
+	dropped bool
+}
+
+//Events returns the channel to receive the events. Channel gets closed when the subscription is removed
+func (s *Subscription) Events() <-chan *apis.WatchEvent {
+	return s.events
+}
+
+//Dropped returns true if the subscription got removed because the subscriber couldn't keep up with the events
+func (s *Subscription) Dropped() bool {
+	return s.dropped
+}
+
+//NewBroadcaster returns the broadcaster which keeps historySize events to resume from
+//and buffers up to bufferSize events for each subscriber
+func NewBroadcaster(historySize int, bufferSize int) *Broadcaster {
+	return &Broadcaster{
+		latest:      make(map[string]*apis.WatchEvent),
+		history:     make([]*apis.WatchEvent, 0, historySize),
+		bufferSize:  bufferSize,
+		subscribers: make(map[*Subscription]struct{}),
+	}
+}
+
+//Publish records the event and delivers it to the subscribers
+//Event is ignored if the resource version didn't change since the last event (ex: informer resync)
+func (b *Broadcaster) Publish(event *apis.WatchEvent) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	k := key(event)
+	if prev, ok := b.latest[k]; ok {
+		if event.Type != Deleted && prev.ResourceVersion == event.ResourceVersion {
+			return
+		}
+		event.PreviousState = prev.State
+	}
+	if event.Type == Deleted {
+		delete(b.latest, k)
+	} else {
+		b.latest[k] = event
+	}
+	b.record(event)
+
+	for sub := range b.subscribers {
+		if !sub.filter(event) {
+			continue
+		}
+		select {
+		case sub.events <- event:
+		default:
+			//Subscriber is too slow, it must watch again
+			sub.dropped = true
+			b.remove(sub)
+		}
+	}
+}
+
+//Subscribe registers the subscriber
+//If resourceVersion is empty, current state of the matching resources is delivered first as ADDED events
+//otherwise the events recorded after the resource version are delivered first
+func (b *Broadcaster) Subscribe(resourceVersion string, filter Filter) (*Subscription, error) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+
+	var initial []*apis.WatchEvent
+	if resourceVersion == "" {
+		initial = b.snapshot(filter)
+	} else {
+		replay, err := b.since(resourceVersion, filter)
+		if err != nil {
+			return nil, err
+		}
+		initial = replay
+	}
+
+	sub := &Subscription{
+		events: make(chan *apis.WatchEvent, len(initial)+b.bufferSize),
+		filter: filter,
+	}
+	for _, event := range initial {
+		sub.events <- event
+	}
+	b.subscribers[sub] = struct{}{}
+	return sub, nil
+}
+
+//Unsubscribe removes the subscriber
+func (b *Broadcaster) Unsubscribe(sub *Subscription) {
+	b.mu.Lock()
+	defer b.mu.Unlock()
+	b.remove(sub)
+}
+
+func (b *Broadcaster) remove(sub *Subscription) {
+	if _, ok := b.subscribers[sub]; !ok {
+		return
+	}
+	delete(b.subscribers, sub)
+	close(sub.events)
+}
+
+//record adds the event to the history replacing the oldest event once the history is full
+func (b *Broadcaster) record(event *apis.WatchEvent) {
+	if cap(b.history) == 0 {
+		return
+	}
+	if len(b.history) < cap(b.history) {
+		b.history = append(b.history, event)
+		return
+	}
+	b.history[b.next] = event
+	b.next = (b.next + 1) % len(b.history)
+}
+
+//ordered returns the history from the oldest to the newest event
+func (b *Broadcaster) ordered() []*apis.WatchEvent {
+	return append(append([]*apis.WatchEvent{}, b.history[b.next:]...), b.history[:b.next]...)
+}
+
+func (b *Broadcaster) since(resourceVersion string, filter Filter) ([]*apis.WatchEvent, error) {
+	history := b.ordered()
+	for i := len(history) - 1; i >= 0; i-- {
+		if history[i].ResourceVersion != resourceVersion {
+			continue
+		}
+		var res []*apis.WatchEvent
+		for _, event := range history[i+1:] {
+			if filter(event) {
+				res = append(res, event)
+			}
+		}
+		return res, nil
+	}
+	return nil, ErrExpired
+}
+
+func (b *Broadcaster) snapshot(filter Filter) []*apis.WatchEvent {
+	var res []*apis.WatchEvent
+	for _, event := range b.latest {
+		if !filter(event) {
+			continue
+		}
+		added := proto.Clone(event).(*apis.WatchEvent)
+		added.Type = Added
+		added.PreviousState = ""
+		res = append(res, added)
+	}
+	sort.Slice(res, func(i, j int) bool {
+		return key(res[i]) < key(res[j])
+	})
+	return res
+}
+
+func key(event *apis.WatchEvent) string {
+	return event.Kind + "/" + event.Name
+}
@@ -0,0 +1,113 @@
+package watch_test
+
+import (
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/watch"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+func event(eventType string, name string, rv string, state string) *apis.WatchEvent {
+	return &apis.WatchEvent{
+		Type:            eventType,
+		Kind:            "ManagedNamespace",
+		Name:            name,
+		ResourceVersion: rv,
+		State:           state,
+	}
+}
+
+func all(*apis.WatchEvent) bool {
+	return true
+}
+
+func receive(sub *watch.Subscription, n int) []*apis.WatchEvent {
+	var res []*apis.WatchEvent
+	for i := 0; i < n; i++ {
+		var e *apis.WatchEvent
+		Eventually(sub.Events()).Should(Receive(&e))
+		res = append(res, e)
+	}
+	return res
+}
+
+var _ = Describe("Broadcaster", func() {
+	var b *watch.Broadcaster
+
+	BeforeEach(func() {
+		b = watch.NewBroadcaster(3, 10)
+		b.Publish(event(watch.Added, "ns1", "1", ""))
+		b.Publish(event(watch.Modified, "ns1", "2", "Ready"))
+		b.Publish(event(watch.Added, "ns2", "3", "Error"))
+	})
+
+	Context("subscribe without resource version", func() {
+		It("should send the current state as ADDED events", func() {
+			sub, err := b.Subscribe("", all)
+			Expect(err).To(BeNil())
+			events := receive(sub, 2)
+			Expect(events[0].Name).To(Equal("ns1"))
+			Expect(events[0].Type).To(Equal(watch.Added))
+			Expect(events[0].State).To(Equal("Ready"))
+			Expect(events[1].Name).To(Equal("ns2"))
+			Consistently(sub.Events()).ShouldNot(Receive())
+		})
+
+		It("should not send the deleted resources", func() {
+			b.Publish(event(watch.Deleted, "ns2", "4", "Error"))
+			sub, err := b.Subscribe("", all)
+			Expect(err).To(BeNil())
+			Expect(receive(sub, 1)[0].Name).To(Equal("ns1"))
+			Consistently(sub.Events()).ShouldNot(Receive())
+		})
+	})
+
+	Context("subscribe with resource version", func() {
+		It("should replay the events after the resource version", func() {
+			sub, err := b.Subscribe("2", all)
+			Expect(err).To(BeNil())
+			Expect(receive(sub, 1)[0].ResourceVersion).To(Equal("3"))
+		})
+
+		It("should fail if the resource version is not in the history anymore", func() {
+			b.Publish(event(watch.Modified, "ns2", "4", "Ready"))
+			_, err := b.Subscribe("1", all)
+			Expect(err).To(Equal(watch.ErrExpired))
+			_, err = b.Subscribe("2", all)
+			Expect(err).To(BeNil())
+		})
+	})
+
+	Context("live events", func() {
+		It("should record the status transition and apply the filter", func() {
+			sub, err := b.Subscribe("3", func(e *apis.WatchEvent) bool {
+				return e.Name == "ns2"
+			})
+			Expect(err).To(BeNil())
+			b.Publish(event(watch.Modified, "ns1", "4", "Error"))
+			b.Publish(event(watch.Modified, "ns2", "5", "Ready"))
+			e := receive(sub, 1)[0]
+			Expect(e.ResourceVersion).To(Equal("5"))
+			Expect(e.PreviousState).To(Equal("Error"))
+			Expect(e.State).To(Equal("Ready"))
+		})
+
+		It("should ignore the events without resource version change", func() {
+			sub, err := b.Subscribe("3", all)
+			Expect(err).To(BeNil())
+			b.Publish(event(watch.Modified, "ns2", "3", "Error"))
+			Consistently(sub.Events()).ShouldNot(Receive())
+		})
+
+		It("should drop the slow subscriber", func() {
+			b = watch.NewBroadcaster(3, 1)
+			sub, err := b.Subscribe("", all)
+			Expect(err).To(BeNil())
+			b.Publish(event(watch.Added, "ns1", "1", ""))
+			b.Publish(event(watch.Added, "ns2", "2", ""))
+			Expect(sub.Dropped()).To(BeTrue())
+			receive(sub, 1)
+			Eventually(sub.Events()).Should(BeClosed())
+		})
+	})
+})
@@ -0,0 +1,179 @@
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/log"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+//ApplicationValidatorPath is the path application validation webhook is served on
+const ApplicationValidatorPath = "/validate-manager-keikoproj-io-v1alpha1-application"
+
+// +kubebuilder:webhook:path=/validate-manager-keikoproj-io-v1alpha1-application,mutating=false,failurePolicy=fail,groups=manager.keikoproj.io,resources=applications,verbs=create;update,versions=v1alpha1,name=vapplication.manager.keikoproj.io
+
+//ApplicationValidator rejects the applications claiming the namespace names already claimed by the other applications
+//or by the managed namespaces created outside of the application
+type ApplicationValidator struct {
+	Client client.Reader
+	//NamingPattern returns the pattern used to name the managed namespaces of the environments
+	NamingPattern func() string
+	decoder       *admission.Decoder
+}
+
+//InjectDecoder is called by the webhook server
+func (v *ApplicationValidator) InjectDecoder(d *admission.Decoder) error {
+	v.decoder = d
+	return nil
+}
+
+//Handle validates the application create and update requests
+func (v *ApplicationValidator) Handle(ctx context.Context, req admission.Request) admission.Response {
+	log := log.Logger(ctx, "pkg.webhooks", "application", "Handle")
+	log = log.WithValues("application", req.Name, "operation", req.Operation)
+
+	app := &v1alpha1.Application{}
+	if err := v.decoder.Decode(req, app); err != nil {
+		log.Error(err, "unable to decode the application")
+		return admission.Errored(http.StatusBadRequest, err)
+	}
+
+	//Managed namespace names are unique in the manager namespace irrespective of the clusters they target
+	names := make(map[string]string)
+	//Namespaces created in the managed clusters are unique per cluster
+	targets := make(map[string]string)
+	for _, env := range app.Spec.Environments {
+		name, err := app.EnvironmentNamespaceName(env, v.namingPattern())
+		if err != nil {
+			return admission.Denied(err.Error())
+		}
+		if other, ok := names[name]; ok {
+			return admission.Denied(fmt.Sprintf("environments %s and %s can not use the same namespace name %s", other, env.Name, name))
+		}
+		names[name] = env.Name
+		for _, target := range environmentTargets(env.Namespace, targetNamespace(env.Namespace, name)) {
+			if other, ok := targets[target]; ok {
+				return admission.Denied(fmt.Sprintf("environments %s and %s can not create the same namespace %s", other, env.Name, target))
+			}
+			targets[target] = env.Name
+		}
+	}
+
+	var apps v1alpha1.ApplicationList
+	if err := v.Client.List(ctx, &apps); err != nil {
+		log.Error(err, "unable to list the applications")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	for i := range apps.Items {
+		other := &apps.Items[i]
+		if other.Name == app.Name {
+			continue
+		}
+		for _, env := range other.Spec.Environments {
+			name, err := other.EnvironmentNamespaceName(env, v.namingPattern())
+			if err != nil {
+				continue
+			}
+			if envName, ok := names[name]; ok {
+				return admission.Denied(fmt.Sprintf("namespace name %s of environment %s is already claimed by application %s", name, envName, other.Name))
+			}
+			for _, target := range environmentTargets(env.Namespace, targetNamespace(env.Namespace, name)) {
+				if envName, ok := targets[target]; ok {
+					return admission.Denied(fmt.Sprintf("namespace %s of environment %s is already created by application %s", target, envName, other.Name))
+				}
+			}
+		}
+	}
+
+	var mnsList v1alpha1.ManagedNamespaceList
+	if err := v.Client.List(ctx, &mnsList, client.InNamespace(common.ManagerDeployedNamespace)); err != nil {
+		log.Error(err, "unable to list the managed namespaces")
+		return admission.Errored(http.StatusInternalServerError, err)
+	}
+	for i := range mnsList.Items {
+		mns := &mnsList.Items[i]
+		owner := metav1.GetControllerOf(mns)
+		createdByApplication := owner != nil && owner.Kind == "Application"
+		if createdByApplication && owner.Name == app.Name {
+			continue
+		}
+		if envName, ok := names[mns.Name]; ok {
+			return admission.Denied(fmt.Sprintf("namespace name %s of environment %s is already used by a managed namespace not created by this application", mns.Name, envName))
+		}
+		//Namespaces of the other applications are validated above from their spec
+		if createdByApplication {
+			continue
+		}
+		for _, target := range managedNamespaceTargets(mns) {
+			if envName, ok := targets[target]; ok {
+				return admission.Denied(fmt.Sprintf("namespace %s of environment %s is already created by managed namespace %s", target, envName, mns.Name))
+			}
+		}
+	}
+	return admission.Allowed("")
+}
+
+func (v *ApplicationValidator) namingPattern() string {
+	if v.NamingPattern == nil {
+		return ""
+	}
+	return v.NamingPattern()
+}
+
+//targetNamespace returns the name of the namespace created in the managed cluster for the environment
+//Explicit namespace in the resources takes precedence, then the name param passed to the template and then the managed namespace name
+func targetNamespace(ns *namespace.Namespace, mnsName string) string {
+	if ns == nil {
+		return mnsName
+	}
+	if res := ns.NsResources; res != nil && res.Namespace != nil && res.Namespace.Name != "" && !strings.Contains(res.Namespace.Name, "${") {
+		return res.Namespace.Name
+	}
+	if name := ns.Params["name"]; name != "" {
+		return name
+	}
+	return mnsName
+}
+
+//environmentTargets returns the namespace qualified by each of the clusters it is created in
+//Clusters chosen by the selector are not known upfront, so the namespaces using the same selector are treated as the same cluster
+func environmentTargets(ns *namespace.Namespace, name string) []string {
+	var clusters []string
+	if ns != nil {
+		if ns.ClusterName != "" {
+			clusters = append(clusters, ns.ClusterName)
+		}
+		clusters = append(clusters, ns.ClusterNames...)
+		if len(clusters) == 0 && ns.ClusterSelector != nil {
+			clusters = append(clusters, "selector("+metav1.FormatLabelSelector(ns.ClusterSelector)+")")
+		}
+	}
+	var targets []string
+	for _, cluster := range clusters {
+		targets = append(targets, fmt.Sprintf("%s in cluster %s", name, cluster))
+	}
+	if len(targets) == 0 {
+		targets = append(targets, name)
+	}
+	return targets
+}
+
+//managedNamespaceTargets returns the namespaces the managed namespace created in the clusters
+//along with the ones it is going to create as per the spec
+func managedNamespaceTargets(mns *v1alpha1.ManagedNamespace) []string {
+	targets := environmentTargets(&mns.Spec.Namespace, targetNamespace(&mns.Spec.Namespace, mns.Name))
+	for _, cs := range mns.Status.Clusters {
+		if cs.Namespace != "" {
+			targets = append(targets, fmt.Sprintf("%s in cluster %s", cs.Namespace, cs.ClusterName))
+		}
+	}
+	return targets
+}
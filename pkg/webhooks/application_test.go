@@ -0,0 +1,162 @@
+package webhooks_test
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/grpc/proto/application"
+	"github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	"github.com/keikoproj/manager/pkg/webhooks"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	admissionv1beta1 "k8s.io/api/admission/v1beta1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+)
+
+func newApplication(name string, team string, envs ...*application.Environment) *v1alpha1.Application {
+	return &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.ManagerDeployedNamespace},
+		Spec: v1alpha1.ApplicationSpec{
+			Application: application.Application{AppName: name, Team: team, Environments: envs},
+		},
+	}
+}
+
+func newManagedNamespace(name string, owner string) *v1alpha1.ManagedNamespace {
+	mns := &v1alpha1.ManagedNamespace{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: common.ManagerDeployedNamespace},
+	}
+	if owner != "" {
+		isController := true
+		mns.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: v1alpha1.GroupVersion.String(),
+			Kind:       "Application",
+			Name:       owner,
+			Controller: &isController,
+		}}
+	}
+	return mns
+}
+
+var _ = Describe("ApplicationValidator", func() {
+	var (
+		scheme  *runtime.Scheme
+		pattern string
+	)
+
+	BeforeEach(func() {
+		scheme = runtime.NewScheme()
+		Expect(v1alpha1.AddToScheme(scheme)).To(Succeed())
+		pattern = ""
+	})
+
+	validate := func(app *v1alpha1.Application, objs ...runtime.Object) admission.Response {
+		validator := &webhooks.ApplicationValidator{
+			Client:        fake.NewFakeClientWithScheme(scheme, objs...),
+			NamingPattern: func() string { return pattern },
+		}
+		decoder, err := admission.NewDecoder(scheme)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(validator.InjectDecoder(decoder)).To(Succeed())
+
+		raw, err := json.Marshal(app)
+		Expect(err).NotTo(HaveOccurred())
+		return validator.Handle(context.Background(), admission.Request{AdmissionRequest: admissionv1beta1.AdmissionRequest{
+			Name:      app.Name,
+			Operation: admissionv1beta1.Create,
+			Object:    runtime.RawExtension{Raw: raw},
+		}})
+	}
+
+	It("allows the application without collisions", func() {
+		resp := validate(newApplication("foo", "", &application.Environment{Name: "dev"}),
+			newApplication("bar", "", &application.Environment{Name: "dev"}))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("denies the namespace name claimed by another application", func() {
+		resp := validate(newApplication("foo", "", &application.Environment{Name: "dev", NamespaceName: "shared"}),
+			newApplication("bar", "", &application.Environment{Name: "dev", NamespaceName: "shared"}))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(string(resp.Result.Reason)).To(ContainSubstring("application bar"))
+	})
+
+	It("denies the namespace name used by a managed namespace not created by the application", func() {
+		resp := validate(newApplication("foo", "", &application.Environment{Name: "dev"}), newManagedNamespace("foo-dev", ""))
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("allows the managed namespace created by the application", func() {
+		resp := validate(newApplication("foo", "", &application.Environment{Name: "dev"}), newManagedNamespace("foo-dev", "foo"))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	It("denies the environments sharing the namespace name", func() {
+		resp := validate(newApplication("foo", "",
+			&application.Environment{Name: "dev", NamespaceName: "foo"},
+			&application.Environment{Name: "qa", NamespaceName: "foo"}))
+		Expect(resp.Allowed).To(BeFalse())
+	})
+
+	It("denies the naming pattern placeholder without a value", func() {
+		pattern = "{team}-{app}-{env}"
+		resp := validate(newApplication("foo", "", &application.Environment{Name: "dev"}))
+		Expect(resp.Allowed).To(BeFalse())
+		Expect(string(resp.Result.Reason)).To(ContainSubstring("{team}"))
+
+		resp = validate(newApplication("foo", "core", &application.Environment{Name: "dev"}), newManagedNamespace("foo-dev", ""))
+		Expect(resp.Allowed).To(BeTrue())
+	})
+
+	Describe("namespace created in the cluster", func() {
+		//environment returns the environment passing the namespace name to the template
+		environment := func(name string, cluster string, nsName string) *application.Environment {
+			return &application.Environment{Name: name, Namespace: &namespace.Namespace{ClusterName: cluster, Params: map[string]string{"name": nsName}}}
+		}
+
+		It("denies the name param claimed by another application in the same cluster", func() {
+			resp := validate(newApplication("foo", "", environment("dev", "cluster-1", "shared")),
+				newApplication("bar", "", environment("dev", "cluster-1", "shared")))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(string(resp.Result.Reason)).To(ContainSubstring("shared in cluster cluster-1"))
+			Expect(string(resp.Result.Reason)).To(ContainSubstring("application bar"))
+		})
+
+		It("allows the same name param in different clusters", func() {
+			resp := validate(newApplication("foo", "", environment("dev", "cluster-1", "shared")),
+				newApplication("bar", "", environment("dev", "cluster-2", "shared")))
+			Expect(resp.Allowed).To(BeTrue())
+		})
+
+		It("denies the environments creating the same namespace in the same cluster", func() {
+			resp := validate(newApplication("foo", "", environment("dev", "cluster-1", "shared"), environment("qa", "cluster-1", "shared")))
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(string(resp.Result.Reason)).To(ContainSubstring("environments dev and qa"))
+		})
+
+		It("denies the name param of the namespace created by another managed namespace", func() {
+			mns := newManagedNamespace("legacy", "")
+			mns.Status.Clusters = []v1alpha1.ClusterNamespaceStatus{{ClusterName: "cluster-1", Namespace: "shared"}}
+			resp := validate(newApplication("foo", "", environment("dev", "cluster-1", "shared")), mns)
+			Expect(resp.Allowed).To(BeFalse())
+			Expect(string(resp.Result.Reason)).To(ContainSubstring("managed namespace legacy"))
+		})
+
+		It("denies the explicit namespace claimed by another application using the same cluster selector", func() {
+			selector := &metav1.LabelSelector{MatchLabels: map[string]string{"env": "dev"}}
+			explicit := &application.Environment{Name: "dev", Namespace: &namespace.Namespace{
+				ClusterSelector: selector,
+				NsResources:     &namespace.NamespaceResources{Namespace: &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "shared"}}},
+			}}
+			other := &application.Environment{Name: "dev", Namespace: &namespace.Namespace{ClusterSelector: selector, Params: map[string]string{"name": "shared"}}}
+			resp := validate(newApplication("foo", "", explicit), newApplication("bar", "", other))
+			Expect(resp.Allowed).To(BeFalse())
+		})
+	})
+})
@@ -0,0 +1,206 @@
+package agent
+
+import (
+	"context"
+	"crypto/subtle"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/internal/utils"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/template"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+	"io"
+	"k8s.io/api/core/v1"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+type agentService struct {
+	k8sClient *k8s.Client
+}
+
+func New(sClient *k8s.Client) *agentService {
+	return &agentService{
+		k8sClient: sClient,
+	}
+}
+
+//Sync records the agent heartbeat and returns the desired state of the managed namespaces targeting the agent cluster
+func (a *agentService) Sync(ctx context.Context, req *apis.SyncRequest) (*apis.SyncResponse, error) {
+	log := log.Logger(ctx, "server.agent", "Sync")
+	log = log.WithValues("cluster", req.ClusterName)
+
+	cluster, err := a.authenticate(ctx, req.ClusterName)
+	if err != nil {
+		return nil, err
+	}
+
+	err = a.k8sClient.UpdateManagedClusterStatus(ctx, client.ObjectKey{Namespace: cluster.Namespace, Name: cluster.Name}, func(cr *v1alpha1.Cluster) {
+		now := metav1.Now()
+		cr.Status.KubernetesVersion = req.KubernetesVersion
+		cr.Status.NodeCount = int(req.NodeCount)
+		cr.Status.LastProbeTime = &now
+		v1alpha1.SetCondition(&cr.Status.Conditions, v1alpha1.Condition{
+			Type:    v1alpha1.Reachable,
+			Status:  v1.ConditionTrue,
+			Reason:  "AgentConnected",
+			Message: "agent synced with the manager",
+		})
+	})
+	if err != nil {
+		log.Error(err, "unable to record the agent heartbeat")
+		return nil, err
+	}
+
+	mnsList, err := a.k8sClient.ListManagedNamespaces(ctx, cluster.Namespace)
+	if err != nil {
+		return nil, err
+	}
+	resp := &apis.SyncResponse{}
+	for i := range mnsList {
+		mns := &mnsList[i]
+		targeted := mns.ObjectMeta.DeletionTimestamp.IsZero() && utils.ContainsString(mns.TargetClusterNames(), cluster.Name)
+		if !targeted {
+			//Namespace is not targeted anymore
+			if cs := mns.ClusterStatus(cluster.Name); cs != nil && cs.Namespace != "" && mns.Spec.DeletionPolicy == v1alpha1.DeletionPolicyDelete {
+				resp.Deletions = append(resp.Deletions, &apis.NamespaceDeletion{Name: mns.Name, Namespace: cs.Namespace})
+			}
+			continue
+		}
+		if mns.Spec.TemplateName != "" {
+			nsTemplate, err := a.k8sClient.GetNamespaceTemplate(ctx, mns.Spec.TemplateName)
+			if err != nil {
+				continue
+			}
+			if err := template.ProcessTemplate(ctx, nsTemplate, mns); err != nil {
+				log.Error(err, "unable to process namespace template", "mns", mns.Name, "template", mns.Spec.TemplateName)
+				continue
+			}
+		}
+		if mns.Spec.NsResources == nil || mns.Spec.NsResources.Namespace == nil {
+			continue
+		}
+		resp.Namespaces = append(resp.Namespaces, &apis.DesiredNamespace{Name: mns.Name, Resources: mns.Spec.NsResources})
+	}
+	log.V(1).Info("Desired state", "namespaces", len(resp.Namespaces), "deletions", len(resp.Deletions))
+	return resp, nil
+}
+
+//ReportStatus records the state of the managed namespaces reported by the agent
+func (a *agentService) ReportStatus(stream apis.AgentService_ReportStatusServer) error {
+	ctx := stream.Context()
+	log := log.Logger(ctx, "server.agent", "ReportStatus")
+
+	var cluster *v1alpha1.Cluster
+	for {
+		nsStatus, err := stream.Recv()
+		if err == io.EOF {
+			return stream.SendAndClose(&apis.ReportStatusResponse{})
+		}
+		if err != nil {
+			return err
+		}
+		if cluster == nil || cluster.Name != utils.SanitizeName(nsStatus.ClusterName) {
+			if cluster, err = a.authenticate(ctx, nsStatus.ClusterName); err != nil {
+				return err
+			}
+		}
+
+		//Agent can only report the namespaces targeting its own cluster
+		mns, err := a.k8sClient.GetManagedNamespace(ctx, nsStatus.Name, cluster.Namespace)
+		if err != nil {
+			log.Error(err, "unable to get the managed namespace", "mns", nsStatus.Name)
+			return err
+		}
+		if !reportAllowed(mns, cluster.Name, nsStatus) {
+			log.Info("Rejecting the status of the namespace not targeting the agent cluster", "mns", nsStatus.Name, "cluster", cluster.Name)
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("managed namespace %s doesn't target cluster %s", nsStatus.Name, nsStatus.ClusterName))
+		}
+
+		key := client.ObjectKey{Namespace: cluster.Namespace, Name: nsStatus.Name}
+		err = a.k8sClient.UpdateManagedNamespaceStatus(ctx, key, func(cr *v1alpha1.ManagedNamespace) {
+			recordStatus(cr, cluster.Name, nsStatus)
+		})
+		if err != nil {
+			log.Error(err, "unable to record the namespace status", "mns", nsStatus.Name)
+			return err
+		}
+	}
+}
+
+//reportAllowed returns true if the managed namespace targets the agent cluster.
+//Deletions are allowed for the clusters which are not targeted anymore but still have the entry in the status
+func reportAllowed(mns *v1alpha1.ManagedNamespace, clusterName string, nsStatus *apis.NamespaceStatus) bool {
+	if utils.ContainsString(mns.TargetClusterNames(), clusterName) {
+		return true
+	}
+	return nsStatus.Deleted && mns.ClusterStatus(clusterName) != nil
+}
+
+//recordStatus updates the entry of the agent cluster in the managed namespace status
+func recordStatus(mns *v1alpha1.ManagedNamespace, clusterName string, nsStatus *apis.NamespaceStatus) {
+	var clusters []v1alpha1.ClusterNamespaceStatus
+	for _, cs := range mns.Status.Clusters {
+		if cs.ClusterName != clusterName {
+			clusters = append(clusters, cs)
+		}
+	}
+	if !nsStatus.Deleted {
+		cs := v1alpha1.ClusterNamespaceStatus{ClusterName: clusterName}
+		if existing := mns.ClusterStatus(clusterName); existing != nil {
+			cs = *existing
+		}
+		cs.Namespace = nsStatus.Namespace
+		cs.State = v1alpha1.State(nsStatus.State)
+		cs.ErrorDescription = nsStatus.ErrorDescription
+		if cs.State == v1alpha1.Ready {
+			now := metav1.Now()
+			cs.LastSyncTime = &now
+		}
+		clusters = append(clusters, cs)
+	}
+	mns.Status.Clusters = clusters
+
+	state, desc := mns.Status.ClusterSummary()
+	mns.Status.State = state
+	mns.Status.ErrorDescription = desc
+	v1alpha1.SetStateConditions(&mns.Status.Conditions, state, desc)
+}
+
+//authenticate validates the agent token sent in the request metadata against the one stored in the cluster secret
+func (a *agentService) authenticate(ctx context.Context, clusterName string) (*v1alpha1.Cluster, error) {
+	log := log.Logger(ctx, "server.agent", "authenticate")
+
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok || len(md.Get("authorization")) == 0 {
+		return nil, status.Error(codes.Unauthenticated, "agent token is not provided")
+	}
+	token := strings.TrimPrefix(md.Get("authorization")[0], "Bearer ")
+
+	name := utils.SanitizeName(clusterName)
+	cluster, err := a.k8sClient.GetManagedCluster(ctx, name, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("cluster %s is not registered", clusterName))
+	}
+	if !cluster.IsAgentMode() {
+		return nil, status.Error(codes.PermissionDenied, fmt.Sprintf("cluster %s is not registered in agent mode", clusterName))
+	}
+	secret, err := a.k8sClient.GetK8sSecret(ctx, cluster.Spec.Config.BearerTokenSecret, cluster.Namespace)
+	if err != nil {
+		log.Error(err, "unable to retrieve the agent token for the cluster", "cluster", name)
+		return nil, status.Error(codes.Internal, "unable to retrieve the agent token")
+	}
+	expected := secret.Data[utils.AgentTokenKey(name)]
+	if len(expected) == 0 || subtle.ConstantTimeCompare(expected, []byte(token)) != 1 {
+		log.Info("Invalid agent token", "cluster", name)
+		return nil, status.Error(codes.Unauthenticated, "invalid agent token")
+	}
+	return cluster, nil
+}
@@ -0,0 +1,268 @@
+package application
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"github.com/keikoproj/manager/server/namespace"
+	"github.com/keikoproj/manager/server/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+
+	pb "github.com/keikoproj/manager/pkg/grpc/proto/application"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+type applicationService struct {
+	k8sClient *k8s.Client
+}
+
+func New(sClient *k8s.Client) *applicationService {
+	return &applicationService{
+		k8sClient: sClient,
+	}
+}
+
+//CreateApplication creates the application. Controller creates the managed namespace for each environment
+func (a *applicationService) CreateApplication(ctx context.Context, req *apis.Application) (*apis.Application, error) {
+	log := log.Logger(ctx, "server.application", "CreateApplication")
+
+	if req.Spec == nil || req.Spec.AppName == "" {
+		return nil, status.Error(codes.InvalidArgument, "spec with the appName is required")
+	}
+	name := req.Name
+	if name == "" {
+		name = req.Spec.AppName
+	}
+	log.Info("Request received", "name", name)
+
+	cr := &v1alpha1.Application{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   name,
+			Labels: req.Labels,
+		},
+		Spec: v1alpha1.ApplicationSpec{
+			Application: *req.Spec,
+		},
+	}
+	tracing.Inject(ctx, cr)
+	if err := a.k8sClient.CreateApplication(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr, nil), nil
+}
+
+//GetApplication returns the application along with the status of each environment
+func (a *applicationService) GetApplication(ctx context.Context, req *apis.GetApplicationRequest) (*apis.Application, error) {
+	cr, err := a.k8sClient.GetApplication(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr, func(name string) *v1alpha1.ManagedNamespace {
+		mns, err := a.k8sClient.GetManagedNamespace(ctx, name, common.ManagerDeployedNamespace)
+		if err != nil {
+			return nil
+		}
+		return mns
+	}), nil
+}
+
+//ListApplications returns the applications ordered by name
+func (a *applicationService) ListApplications(ctx context.Context, req *apis.ListApplicationsRequest) (*apis.ListApplicationsResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	items, err := a.k8sClient.ListApplications(ctx)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	mnsList, err := a.k8sClient.ListManagedNamespaces(ctx, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	mnsMap := make(map[string]*v1alpha1.ManagedNamespace)
+	for i := range mnsList {
+		mnsMap[mnsList[i].Name] = &mnsList[i]
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	resp := &apis.ListApplicationsResponse{}
+	for i := range items {
+		//Page token is the name of the last application returned in the previous page
+		if req.PageToken != "" && items[i].Name <= req.PageToken {
+			continue
+		}
+		if len(resp.Items) == pageSize {
+			resp.NextPageToken = resp.Items[len(resp.Items)-1].Name
+			break
+		}
+		resp.Items = append(resp.Items, ToProto(&items[i], func(name string) *v1alpha1.ManagedNamespace {
+			return mnsMap[name]
+		}))
+	}
+	return resp, nil
+}
+
+//UpdateApplication updates the spec and labels of the application
+func (a *applicationService) UpdateApplication(ctx context.Context, req *apis.Application) (*apis.Application, error) {
+	log := log.Logger(ctx, "server.application", "UpdateApplication")
+	log.Info("Request received", "name", req.Name)
+
+	if req.Name == "" || req.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "name and spec are required")
+	}
+	cr, err := a.k8sClient.GetApplication(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	//Resource version in the request makes sure the changes made in the meantime are not overwritten
+	if req.ResourceVersion != "" {
+		cr.SetResourceVersion(req.ResourceVersion)
+	}
+	cr.Labels = util.MergeLabels(cr.Labels, req.Labels)
+	cr.Spec.Application = *req.Spec
+	tracing.Inject(ctx, cr)
+	if err := a.k8sClient.UpdateApplication(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr, nil), nil
+}
+
+//DeleteApplication deletes the application
+func (a *applicationService) DeleteApplication(ctx context.Context, req *apis.DeleteApplicationRequest) (*apis.DeleteApplicationResponse, error) {
+	log := log.Logger(ctx, "server.application", "DeleteApplication")
+	log.Info("Request received", "name", req.Name)
+
+	if err := a.k8sClient.DeleteApplication(ctx, req.Name); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return &apis.DeleteApplicationResponse{}, nil
+}
+
+//AddEnvironment adds the environment to the application
+func (a *applicationService) AddEnvironment(ctx context.Context, req *apis.AddEnvironmentRequest) (*apis.Application, error) {
+	log := log.Logger(ctx, "server.application", "AddEnvironment")
+
+	if req.Environment == nil || req.Environment.Name == "" || req.Environment.Namespace == nil {
+		return nil, status.Error(codes.InvalidArgument, "environment name and namespace are required")
+	}
+	log.Info("Request received", "name", req.Name, "environment", req.Environment.Name)
+
+	cr, err := a.k8sClient.GetApplication(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	if environmentIndex(cr, req.Environment.Name) >= 0 {
+		return nil, status.Error(codes.AlreadyExists, fmt.Sprintf("environment %s already exists in application %s", req.Environment.Name, req.Name))
+	}
+	cr.Spec.Environments = append(cr.Spec.Environments, req.Environment)
+	tracing.Inject(ctx, cr)
+	if err := a.k8sClient.UpdateApplication(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr, nil), nil
+}
+
+//RemoveEnvironment removes the environment from the application
+func (a *applicationService) RemoveEnvironment(ctx context.Context, req *apis.RemoveEnvironmentRequest) (*apis.Application, error) {
+	log := log.Logger(ctx, "server.application", "RemoveEnvironment")
+	log.Info("Request received", "name", req.Name, "environment", req.EnvironmentName)
+
+	cr, err := a.k8sClient.GetApplication(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	i := environmentIndex(cr, req.EnvironmentName)
+	if i < 0 {
+		return nil, status.Error(codes.NotFound, fmt.Sprintf("environment %s doesn't exist in application %s", req.EnvironmentName, req.Name))
+	}
+	if len(cr.Spec.Environments) == 1 {
+		return nil, status.Error(codes.FailedPrecondition, "application must have at least one environment")
+	}
+	cr.Spec.Environments = append(cr.Spec.Environments[:i], cr.Spec.Environments[i+1:]...)
+	tracing.Inject(ctx, cr)
+	if err := a.k8sClient.UpdateApplication(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr, nil), nil
+}
+
+func environmentIndex(app *v1alpha1.Application, envName string) int {
+	for i, env := range app.Spec.Environments {
+		if env.Name == envName {
+			return i
+		}
+	}
+	return -1
+}
+
+//ToProto converts the application custom resource to the api representation
+//Environment status is included only if the managed namespace lookup is provided
+func ToProto(app *v1alpha1.Application, lookup func(name string) *v1alpha1.ManagedNamespace) *apis.Application {
+	spec := app.Spec.Application
+	res := &apis.Application{
+		Name:              app.Name,
+		Labels:            app.Labels,
+		Spec:              &spec,
+		ResourceVersion:   app.ResourceVersion,
+		CreationTimestamp: util.Timestamp(&app.CreationTimestamp),
+		Status: &apis.ApplicationStatus{
+			State:            string(app.Status.State),
+			RetryCount:       int32(app.Status.RetryCount),
+			ErrorDescription: app.Status.ErrorDescription,
+		},
+	}
+	if lookup == nil {
+		return res
+	}
+	for _, env := range app.Spec.Environments {
+		res.Status.Environments = append(res.Status.Environments, environmentStatus(app, env, lookup))
+	}
+	for _, env := range res.Status.Environments {
+		if env.State == string(v1alpha1.Ready) {
+			res.Status.ReadyEnvironments++
+		}
+	}
+	return res
+}
+
+func environmentStatus(app *v1alpha1.Application, env *pb.Environment, lookup func(name string) *v1alpha1.ManagedNamespace) *apis.EnvironmentStatus {
+	//Name is chosen by the controller based on the naming pattern configured in the manager
+	name := app.RecordedNamespaceName(env.Name)
+	envStatus := &apis.EnvironmentStatus{
+		Name:          env.Name,
+		NamespaceName: name,
+	}
+	var mns *v1alpha1.ManagedNamespace
+	if name != "" {
+		mns = lookup(name)
+	}
+	if mns == nil {
+		envStatus.State = string(v1alpha1.Pending)
+		envStatus.ErrorDescription = "managed namespace is not created yet"
+		return envStatus
+	}
+	mnsStatus := namespace.ToProto(mns).Status
+	envStatus.State = mnsStatus.State
+	envStatus.ErrorDescription = mnsStatus.ErrorDescription
+	envStatus.Clusters = mnsStatus.Clusters
+	return envStatus
+}
@@ -0,0 +1,136 @@
+package auth
+
+import (
+	"context"
+	"errors"
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/log"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"strings"
+)
+
+//errNoCredentials is returned by the authenticator if the request doesn't carry the credentials it understands
+var errNoCredentials = errors.New("no credentials provided")
+
+//Identity of the caller
+type Identity struct {
+	User   string
+	UID    string
+	Groups []string
+	Extra  map[string][]string
+}
+
+//Authenticator returns the identity of the caller
+type Authenticator interface {
+	Authenticate(ctx context.Context) (*Identity, error)
+}
+
+//Authorizer decides whether the caller is allowed to invoke the method
+type Authorizer interface {
+	Authorize(ctx context.Context, id *Identity, method string) error
+}
+
+type identityKey struct{}
+
+//NewContext returns the context carrying the caller identity
+func NewContext(ctx context.Context, id *Identity) context.Context {
+	return context.WithValue(ctx, identityKey{}, id)
+}
+
+//FromContext returns the caller identity if the request got authenticated
+func FromContext(ctx context.Context) (*Identity, bool) {
+	id, ok := ctx.Value(identityKey{}).(*Identity)
+	return id, ok
+}
+
+//Chain tries the authenticators in order and returns the identity from the first one which finds the credentials
+func Chain(authenticators ...Authenticator) Authenticator {
+	return chain(authenticators)
+}
+
+type chain []Authenticator
+
+func (c chain) Authenticate(ctx context.Context) (*Identity, error) {
+	for _, a := range c {
+		id, err := a.Authenticate(ctx)
+		if err == errNoCredentials {
+			continue
+		}
+		return id, err
+	}
+	return nil, status.Error(codes.Unauthenticated, errNoCredentials.Error())
+}
+
+//Interceptor authenticates and authorizes every request except the methods with the skipped prefixes
+type Interceptor struct {
+	Authenticator Authenticator
+	Authorizer    Authorizer
+	//SkipPrefixes contains the full method name prefixes which do their own authentication (ex: agent service)
+	SkipPrefixes []string
+}
+
+//Unary returns the unary server interceptor
+func (i *Interceptor) Unary() grpc.UnaryServerInterceptor {
+	return func(ctx context.Context, req interface{}, info *grpc.UnaryServerInfo, handler grpc.UnaryHandler) (interface{}, error) {
+		ctx, err := i.check(ctx, info.FullMethod)
+		if err != nil {
+			return nil, err
+		}
+		return handler(ctx, req)
+	}
+}
+
+//Stream returns the stream server interceptor
+func (i *Interceptor) Stream() grpc.StreamServerInterceptor {
+	return func(srv interface{}, ss grpc.ServerStream, info *grpc.StreamServerInfo, handler grpc.StreamHandler) error {
+		ctx, err := i.check(ss.Context(), info.FullMethod)
+		if err != nil {
+			return err
+		}
+		return handler(srv, &identityStream{ServerStream: ss, ctx: ctx})
+	}
+}
+
+func (i *Interceptor) check(ctx context.Context, method string) (context.Context, error) {
+	log := log.Logger(ctx, "server.auth", "Interceptor")
+
+	for _, prefix := range i.SkipPrefixes {
+		if strings.HasPrefix(method, prefix) {
+			return ctx, nil
+		}
+	}
+	id, err := i.Authenticator.Authenticate(ctx)
+	if err != nil {
+		log.Info("Request is not authenticated", "method", method, "error", err.Error())
+		return nil, toStatus(codes.Unauthenticated, err)
+	}
+	//Rejected requests are audited with the authenticated caller too
+	if req := audit.FromContext(ctx); req != nil {
+		req.SetPrincipal(id.User, id.Groups)
+	}
+	if err := i.Authorizer.Authorize(ctx, id, method); err != nil {
+		log.Info("Request is not authorized", "method", method, "user", id.User, "error", err.Error())
+		return nil, toStatus(codes.PermissionDenied, err)
+	}
+	return NewContext(ctx, id), nil
+}
+
+//toStatus keeps the status code if the error is already a grpc status error
+func toStatus(code codes.Code, err error) error {
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	return status.Error(code, err.Error())
+}
+
+//identityStream overrides the stream context to carry the caller identity
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s *identityStream) Context() context.Context {
+	return s.ctx
+}
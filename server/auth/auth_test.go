@@ -0,0 +1,302 @@
+package auth_test
+
+import (
+	"context"
+	"errors"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/server/auth"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/status"
+
+	authnv1 "k8s.io/api/authentication/v1"
+	authzv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/fake"
+	k8stesting "k8s.io/client-go/testing"
+)
+
+//fakeAuthenticator returns the configured identity or error
+type fakeAuthenticator struct {
+	id  *auth.Identity
+	err error
+}
+
+func (f fakeAuthenticator) Authenticate(ctx context.Context) (*auth.Identity, error) {
+	return f.id, f.err
+}
+
+//fakeAuthorizer allows only the configured methods
+type fakeAuthorizer struct {
+	allowed map[string]bool
+}
+
+func (f fakeAuthorizer) Authorize(ctx context.Context, id *auth.Identity, method string) error {
+	if !f.allowed[method] {
+		return status.Error(codes.PermissionDenied, "denied")
+	}
+	return nil
+}
+
+//identityStream is the server stream with the given context
+type identityStream struct {
+	grpc.ServerStream
+	ctx context.Context
+}
+
+func (s identityStream) Context() context.Context {
+	return s.ctx
+}
+
+func withToken(token string) context.Context {
+	return metadata.NewIncomingContext(context.Background(), metadata.Pairs("authorization", "Bearer "+token))
+}
+
+var _ = Describe("server.auth test cases", func() {
+	alice := &auth.Identity{User: "alice", Groups: []string{"admins"}}
+
+	Describe("Interceptor", func() {
+		const method = "/apis.ClusterService/ListClusters"
+		var (
+			interceptor *auth.Interceptor
+			seen        *auth.Identity
+		)
+		handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+			seen, _ = auth.FromContext(ctx)
+			return "ok", nil
+		}
+
+		BeforeEach(func() {
+			seen = nil
+			interceptor = &auth.Interceptor{
+				Authenticator: fakeAuthenticator{id: alice},
+				Authorizer:    fakeAuthorizer{allowed: map[string]bool{method: true}},
+				SkipPrefixes:  []string{"/apis.AgentService/"},
+			}
+		})
+
+		Context("caller is authenticated and authorized", func() {
+			It("should invoke the handler with the caller identity", func() {
+				resp, err := interceptor.Unary()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(resp).To(Equal("ok"))
+				Expect(seen).To(Equal(alice))
+			})
+		})
+
+		Context("caller is not authenticated", func() {
+			It("should reject the request with Unauthenticated", func() {
+				interceptor.Authenticator = fakeAuthenticator{err: errors.New("bad token")}
+				_, err := interceptor.Unary()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				Expect(seen).To(BeNil())
+			})
+		})
+
+		Context("authenticator returns the status error", func() {
+			It("should keep the status code", func() {
+				interceptor.Authenticator = fakeAuthenticator{err: status.Error(codes.Unavailable, "token review failed")}
+				_, err := interceptor.Unary()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: method}, handler)
+				Expect(status.Code(err)).To(Equal(codes.Unavailable))
+			})
+		})
+
+		Context("caller is not authorized", func() {
+			It("should reject the request with PermissionDenied", func() {
+				_, err := interceptor.Unary()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/apis.ClusterService/RegisterCluster"}, handler)
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(seen).To(BeNil())
+			})
+		})
+
+		Context("method with the skipped prefix", func() {
+			It("should invoke the handler without the authentication", func() {
+				interceptor.Authenticator = fakeAuthenticator{err: errors.New("no token")}
+				_, err := interceptor.Unary()(context.Background(), nil, &grpc.UnaryServerInfo{FullMethod: "/apis.AgentService/Sync"}, handler)
+				Expect(err).NotTo(HaveOccurred())
+				Expect(seen).To(BeNil())
+			})
+		})
+
+		Context("stream request", func() {
+			It("should pass the caller identity in the stream context", func() {
+				err := interceptor.Stream()(nil, identityStream{ctx: context.Background()}, &grpc.StreamServerInfo{FullMethod: method}, func(srv interface{}, ss grpc.ServerStream) error {
+					seen, _ = auth.FromContext(ss.Context())
+					return nil
+				})
+				Expect(err).NotTo(HaveOccurred())
+				Expect(seen).To(Equal(alice))
+			})
+		})
+	})
+
+	Describe("Chain", func() {
+		Context("first authenticator doesn't find the credentials", func() {
+			It("should fall back to the next authenticator", func() {
+				id, err := auth.Chain(auth.TLSAuthenticator{}, fakeAuthenticator{id: alice}).Authenticate(context.Background())
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).To(Equal(alice))
+			})
+		})
+		Context("none of the authenticators find the credentials", func() {
+			It("should return Unauthenticated", func() {
+				_, err := auth.Chain(auth.TLSAuthenticator{}, auth.TokenReviewAuthenticator{Client: fake.NewSimpleClientset()}).Authenticate(context.Background())
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+			})
+		})
+	})
+
+	Describe("TokenReviewAuthenticator", func() {
+		var cs *fake.Clientset
+
+		BeforeEach(func() {
+			cs = fake.NewSimpleClientset()
+			cs.PrependReactor("create", "tokenreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authnv1.TokenReview)
+				switch review.Spec.Token {
+				case "valid-token":
+					review.Status = authnv1.TokenReviewStatus{
+						Authenticated: true,
+						User: authnv1.UserInfo{
+							Username: "alice",
+							UID:      "1234",
+							Groups:   []string{"admins"},
+							Extra:    map[string]authnv1.ExtraValue{"scopes": {"all"}},
+						},
+					}
+				case "expired-token":
+					review.Status = authnv1.TokenReviewStatus{Error: "token expired"}
+				default:
+					//Fake token review client expects the object along with the error
+					return true, review, errors.New("api server unavailable")
+				}
+				return true, review, nil
+			})
+		})
+
+		Context("valid token", func() {
+			It("should return the identity from the token review", func() {
+				id, err := auth.TokenReviewAuthenticator{Client: cs}.Authenticate(withToken("valid-token"))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(id).To(Equal(&auth.Identity{User: "alice", UID: "1234", Groups: []string{"admins"}, Extra: map[string][]string{"scopes": {"all"}}}))
+			})
+		})
+
+		Context("token is rejected", func() {
+			It("should return Unauthenticated", func() {
+				_, err := auth.TokenReviewAuthenticator{Client: cs}.Authenticate(withToken("expired-token"))
+				Expect(status.Code(err)).To(Equal(codes.Unauthenticated))
+				Expect(err.Error()).To(ContainSubstring("token expired"))
+			})
+		})
+
+		Context("token review fails", func() {
+			It("should return Unavailable", func() {
+				_, err := auth.TokenReviewAuthenticator{Client: cs}.Authenticate(withToken("other-token"))
+				Expect(status.Code(err)).To(Equal(codes.Unavailable))
+			})
+		})
+	})
+
+	Describe("SubjectAccessReviewAuthorizer", func() {
+		var (
+			cs      *fake.Clientset
+			reviews []authzv1.SubjectAccessReviewSpec
+		)
+
+		BeforeEach(func() {
+			reviews = nil
+			cs = fake.NewSimpleClientset()
+			//alice can do anything except deleting the clusters
+			cs.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+				review := action.(k8stesting.CreateAction).GetObject().(*authzv1.SubjectAccessReview)
+				reviews = append(reviews, review.Spec)
+				attributes := review.Spec.ResourceAttributes
+				review.Status.Allowed = review.Spec.User == "alice" && !(attributes.Resource == "clusters" && attributes.Verb == "delete")
+				return true, review, nil
+			})
+		})
+
+		authorize := func(id *auth.Identity, method string) error {
+			return auth.SubjectAccessReviewAuthorizer{Client: cs}.Authorize(context.Background(), id, method)
+		}
+
+		Context("caller has all the permissions", func() {
+			It("should allow the request after reviewing every permission", func() {
+				Expect(authorize(alice, "/apis.ClusterService/DescribeCluster")).To(Succeed())
+				Expect(reviews).To(HaveLen(2))
+				Expect(reviews[0].User).To(Equal("alice"))
+				Expect(reviews[0].Groups).To(Equal([]string{"admins"}))
+				Expect(reviews[0].ResourceAttributes.Namespace).To(Equal(common.ManagerDeployedNamespace))
+				Expect(reviews[1].ResourceAttributes.Resource).To(Equal("managednamespaces"))
+			})
+		})
+
+		Context("cluster scoped resource", func() {
+			It("should review the access without the namespace", func() {
+				Expect(authorize(alice, "/apis.ApplicationService/CreateApplication")).To(Succeed())
+				Expect(reviews[0].ResourceAttributes.Namespace).To(BeEmpty())
+			})
+		})
+
+		Context("caller is missing the permission", func() {
+			It("should return PermissionDenied", func() {
+				err := authorize(alice, "/apis.ClusterService/UnregisterCluster")
+				Expect(status.Code(err)).To(Equal(codes.PermissionDenied))
+				Expect(err.Error()).To(ContainSubstring("cannot delete clusters"))
+			})
+		})
+
+		Context("method is not listed in the method permissions", func() {
+			It("should return PermissionDenied without the review", func() {
+				Expect(status.Code(authorize(alice, "/apis.ClusterService/Unknown"))).To(Equal(codes.PermissionDenied))
+				Expect(reviews).To(BeEmpty())
+			})
+		})
+
+		Context("access review fails", func() {
+			It("should return Unavailable", func() {
+				cs.PrependReactor("create", "subjectaccessreviews", func(action k8stesting.Action) (bool, runtime.Object, error) {
+					return true, nil, errors.New("api server unavailable")
+				})
+				Expect(status.Code(authorize(alice, "/apis.ClusterService/ListClusters"))).To(Equal(codes.Unavailable))
+			})
+		})
+	})
+
+	Describe("MethodPermissions", func() {
+		It("should require at least one permission for every method", func() {
+			for method, permissions := range auth.MethodPermissions {
+				Expect(permissions).NotTo(BeEmpty(), method)
+			}
+		})
+
+		It("should not include the agent service which does its own authentication", func() {
+			for method := range auth.MethodPermissions {
+				Expect(method).NotTo(HavePrefix("/apis.AgentService/"))
+			}
+		})
+
+		It("should return the kind changed by the mutating methods", func() {
+			for _, entry := range []struct {
+				method  string
+				kind    string
+				mutated bool
+			}{
+				{"/apis.ClusterService/RegisterCluster", "Cluster", true},
+				{"/apis.NamespaceService/Update", "ManagedNamespace", true},
+				{"/apis.ApplicationService/AddEnvironment", "Application", true},
+				{"/apis.TemplateService/Delete", "NamespaceTemplate", true},
+				{"/apis.ClusterService/DescribeCluster", "", false},
+				{"/apis.ClusterService/Unknown", "", false},
+			} {
+				kind, ok := auth.MutatedKind(entry.method)
+				Expect(ok).To(Equal(entry.mutated), entry.method)
+				Expect(kind).To(Equal(entry.kind), entry.method)
+			}
+		})
+	})
+})
@@ -0,0 +1,91 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/pkg/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/metadata"
+	"google.golang.org/grpc/peer"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+	"strings"
+
+	authnv1 "k8s.io/api/authentication/v1"
+)
+
+//TLSAuthenticator authenticates the caller using the verified client certificate
+//Common name is used as the user and organizations are used as the groups just like kubernetes
+type TLSAuthenticator struct{}
+
+//Authenticate returns the identity from the client certificate
+func (TLSAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	p, ok := peer.FromContext(ctx)
+	if !ok {
+		return nil, errNoCredentials
+	}
+	tlsInfo, ok := p.AuthInfo.(credentials.TLSInfo)
+	if !ok || len(tlsInfo.State.VerifiedChains) == 0 || len(tlsInfo.State.VerifiedChains[0]) == 0 {
+		return nil, errNoCredentials
+	}
+	cert := tlsInfo.State.VerifiedChains[0][0]
+	if cert.Subject.CommonName == "" {
+		return nil, status.Error(codes.Unauthenticated, "client certificate doesn't have the common name")
+	}
+	return &Identity{
+		User:   cert.Subject.CommonName,
+		Groups: cert.Subject.Organization,
+	}, nil
+}
+
+//TokenReviewAuthenticator authenticates the bearer token using the TokenReview api of the control plane cluster
+type TokenReviewAuthenticator struct {
+	Client kubernetes.Interface
+}
+
+//Authenticate returns the identity from the token review
+func (t TokenReviewAuthenticator) Authenticate(ctx context.Context) (*Identity, error) {
+	log := log.Logger(ctx, "server.auth", "TokenReviewAuthenticator", "Authenticate")
+
+	token := bearerToken(ctx)
+	if token == "" {
+		return nil, errNoCredentials
+	}
+	review, err := t.Client.AuthenticationV1().TokenReviews().Create(&authnv1.TokenReview{
+		Spec: authnv1.TokenReviewSpec{
+			Token: token,
+		},
+	})
+	if err != nil {
+		log.Error(err, "unable to review the token")
+		return nil, status.Error(codes.Unavailable, "unable to validate the token")
+	}
+	if !review.Status.Authenticated {
+		return nil, status.Error(codes.Unauthenticated, fmt.Sprintf("invalid token %s", review.Status.Error))
+	}
+	extra := make(map[string][]string)
+	for k, v := range review.Status.User.Extra {
+		extra[k] = v
+	}
+	return &Identity{
+		User:   review.Status.User.Username,
+		UID:    review.Status.User.UID,
+		Groups: review.Status.User.Groups,
+		Extra:  extra,
+	}, nil
+}
+
+//bearerToken returns the token from the authorization metadata
+func bearerToken(ctx context.Context) string {
+	md, ok := metadata.FromIncomingContext(ctx)
+	if !ok {
+		return ""
+	}
+	for _, value := range md.Get("authorization") {
+		if strings.HasPrefix(value, "Bearer ") {
+			return strings.TrimPrefix(value, "Bearer ")
+		}
+	}
+	return ""
+}
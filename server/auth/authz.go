@@ -0,0 +1,140 @@
+package auth
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/pkg/log"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/client-go/kubernetes"
+
+	authzv1 "k8s.io/api/authorization/v1"
+)
+
+//permission is the kubernetes verb on the manager resource required to invoke the method
+type permission struct {
+	resource string
+	verb     string
+	//clusterScoped resources are checked without the namespace
+	clusterScoped bool
+}
+
+//resourceKinds maps the manager resources to their kinds
+var resourceKinds = map[string]string{
+	"clusters":          "Cluster",
+	"managednamespaces": "ManagedNamespace",
+	"applications":      "Application",
+	"namespacetemplate": "NamespaceTemplate",
+}
+
+func clusters(verb string) permission {
+	return permission{resource: "clusters", verb: verb}
+}
+
+func managedNamespaces(verb string) permission {
+	return permission{resource: "managednamespaces", verb: verb}
+}
+
+func applications(verb string) permission {
+	return permission{resource: "applications", verb: verb, clusterScoped: true}
+}
+
+func templates(verb string) permission {
+	return permission{resource: "namespacetemplate", verb: verb, clusterScoped: true}
+}
+
+//MethodPermissions maps each rpc to the permissions the caller must have on the manager custom resources
+//Methods which are not listed here are denied
+var MethodPermissions = map[string][]permission{
+	"/apis.ClusterService/RegisterCluster":   {clusters("create")},
+	"/apis.ClusterService/UnregisterCluster": {clusters("delete")},
+	"/apis.ClusterService/ListClusters":      {clusters("list")},
+	"/apis.ClusterService/GetCluster":        {clusters("get")},
+	"/apis.ClusterService/DescribeCluster":   {clusters("get"), managedNamespaces("list")},
+
+	"/apis.NamespaceService/Create": {managedNamespaces("create")},
+	"/apis.NamespaceService/Get":    {managedNamespaces("get")},
+	"/apis.NamespaceService/List":   {managedNamespaces("list")},
+	"/apis.NamespaceService/Update": {managedNamespaces("update")},
+	"/apis.NamespaceService/Delete": {managedNamespaces("delete")},
+	"/apis.NamespaceService/Resync": {managedNamespaces("update")},
+
+	"/apis.ApplicationService/CreateApplication": {applications("create")},
+	"/apis.ApplicationService/GetApplication":    {applications("get"), managedNamespaces("get")},
+	"/apis.ApplicationService/ListApplications":  {applications("list"), managedNamespaces("list")},
+	"/apis.ApplicationService/UpdateApplication": {applications("update")},
+	"/apis.ApplicationService/DeleteApplication": {applications("delete")},
+	"/apis.ApplicationService/AddEnvironment":    {applications("update")},
+	"/apis.ApplicationService/RemoveEnvironment": {applications("update")},
+
+	"/apis.TemplateService/Create":  {templates("create")},
+	"/apis.TemplateService/Get":     {templates("get")},
+	"/apis.TemplateService/List":    {templates("list")},
+	"/apis.TemplateService/Update":  {templates("update")},
+	"/apis.TemplateService/Delete":  {templates("delete")},
+	"/apis.TemplateService/Catalog": {templates("list"), managedNamespaces("list")},
+
+	"/apis.WatchService/Watch": {clusters("watch"), managedNamespaces("watch"), applications("watch")},
+}
+
+//MutatedKind returns the kind of the object changed by the method. False is returned for the read only methods
+func MutatedKind(method string) (string, bool) {
+	for _, p := range MethodPermissions[method] {
+		switch p.verb {
+		case "create", "update", "patch", "delete":
+			return resourceKinds[p.resource], true
+		}
+	}
+	return "", false
+}
+
+//SubjectAccessReviewAuthorizer authorizes the caller using the SubjectAccessReview api of the control plane cluster
+//Caller must have the same permissions on the manager custom resources as it would need with kubectl
+type SubjectAccessReviewAuthorizer struct {
+	Client kubernetes.Interface
+}
+
+//Authorize returns nil if the caller has all the permissions required by the method
+func (s SubjectAccessReviewAuthorizer) Authorize(ctx context.Context, id *Identity, method string) error {
+	log := log.Logger(ctx, "server.auth", "SubjectAccessReviewAuthorizer", "Authorize")
+
+	permissions, ok := MethodPermissions[method]
+	if !ok {
+		return status.Error(codes.PermissionDenied, fmt.Sprintf("method %s is not allowed", method))
+	}
+	for _, p := range permissions {
+		attributes := &authzv1.ResourceAttributes{
+			Group:     v1alpha1.GroupVersion.Group,
+			Version:   v1alpha1.GroupVersion.Version,
+			Resource:  p.resource,
+			Verb:      p.verb,
+			Namespace: common.ManagerDeployedNamespace,
+		}
+		if p.clusterScoped {
+			attributes.Namespace = ""
+		}
+		extra := make(map[string]authzv1.ExtraValue)
+		for k, v := range id.Extra {
+			extra[k] = v
+		}
+		review, err := s.Client.AuthorizationV1().SubjectAccessReviews().Create(&authzv1.SubjectAccessReview{
+			Spec: authzv1.SubjectAccessReviewSpec{
+				ResourceAttributes: attributes,
+				User:               id.User,
+				UID:                id.UID,
+				Groups:             id.Groups,
+				Extra:              extra,
+			},
+		})
+		if err != nil {
+			log.Error(err, "unable to review the access", "user", id.User)
+			return status.Error(codes.Unavailable, "unable to authorize the request")
+		}
+		if !review.Status.Allowed {
+			return status.Error(codes.PermissionDenied, fmt.Sprintf("user %s cannot %s %s in %s group", id.User, p.verb, p.resource, v1alpha1.GroupVersion.Group))
+		}
+	}
+	return nil
+}
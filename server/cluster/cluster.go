@@ -10,11 +10,19 @@ import (
 	pb "github.com/keikoproj/manager/pkg/grpc/proto/cluster"
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"github.com/keikoproj/manager/server/util"
 	"k8s.io/api/core/v1"
+	"sort"
 
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
+const (
+	//maxEvents is the number of most recent events returned in the cluster description
+	maxEvents = 20
+)
+
 type clusterService struct {
 	k8sClient *k8s.Client
 }
@@ -42,7 +50,18 @@ func (c *clusterService) RegisterCluster(ctx context.Context, cl *pb.Cluster) (*
 	// Create the secret
 	s := make(map[string]string)
 
-	s[fmt.Sprintf("%s_%s", name, "config")] = cl.Config.BearerToken
+	s[utils.TokenKey(name)] = cl.Config.BearerToken
+	//Agent uses its own token since manager can not reach the cluster
+	agentToken := ""
+	if cl.Mode == v1alpha1.ClusterModeAgent {
+		token, err := utils.NewAgentToken()
+		if err != nil {
+			log.Error(err, "unable to generate the agent token", "name", name)
+			return nil, err
+		}
+		agentToken = token
+		s[utils.AgentTokenKey(name)] = agentToken
+	}
 	secretName := fmt.Sprintf("%s-%s", name, "secrets")
 	secret := &v1.Secret{
 		ObjectMeta: metav1.ObjectMeta{
@@ -65,17 +84,20 @@ func (c *clusterService) RegisterCluster(ctx context.Context, cl *pb.Cluster) (*
 		ObjectMeta: metav1.ObjectMeta{
 			Name:      utils.SanitizeName(cl.Name),
 			Namespace: common.ManagerDeployedNamespace,
+			Labels:    cl.Labels,
 		},
 		Spec: v1alpha1.ClusterSpec{
 			Cluster: *cl,
 		},
 	}
+	tracing.Inject(ctx, cr)
 	err = c.k8sClient.CreateOrUpdateManagedCluster(ctx, cr, common.ManagerDeployedNamespace)
 	if err != nil {
 		log.Error(err, "unable to create/update cluster CR in the namespace", "name", name)
 		return nil, err
 	}
 
+	cl.Config.AgentToken = agentToken
 	return cl, nil
 }
 
@@ -103,3 +125,149 @@ func (c *clusterService) UnregisterCluster(ctx context.Context, req *apis.Unregi
 	}
 	return &apis.UnregisterClusterResponse{}, nil
 }
+
+//ListClusters returns all the registered clusters ordered by name
+func (c *clusterService) ListClusters(ctx context.Context, req *apis.ListClustersRequest) (*apis.ListClustersResponse, error) {
+	items, err := c.k8sClient.ListManagedClusters(ctx, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	resp := &apis.ListClustersResponse{}
+	for i := range items {
+		resp.Items = append(resp.Items, ToProto(&items[i]))
+	}
+	return resp, nil
+}
+
+//GetCluster returns the cluster spec and status
+func (c *clusterService) GetCluster(ctx context.Context, req *apis.GetClusterRequest) (*apis.ClusterInfo, error) {
+	cr, err := c.k8sClient.GetManagedCluster(ctx, utils.SanitizeName(req.ClusterName), common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr), nil
+}
+
+//DescribeCluster returns the cluster along with the namespaces hosted on it and the recent events
+func (c *clusterService) DescribeCluster(ctx context.Context, req *apis.GetClusterRequest) (*apis.DescribeClusterResponse, error) {
+	name := utils.SanitizeName(req.ClusterName)
+	cr, err := c.k8sClient.GetManagedCluster(ctx, name, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	resp := &apis.DescribeClusterResponse{
+		Cluster: ToProto(cr),
+	}
+
+	mnsList, err := c.k8sClient.ListManagedNamespaces(ctx, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	sort.Slice(mnsList, func(i, j int) bool {
+		return mnsList[i].Name < mnsList[j].Name
+	})
+	for i := range mnsList {
+		if !targets(&mnsList[i], name) {
+			continue
+		}
+		hosted := &apis.HostedNamespace{
+			ManagedNamespace: mnsList[i].Name,
+			State:            string(mnsList[i].Status.State),
+			ErrorDescription: mnsList[i].Status.ErrorDescription,
+		}
+		if cs := mnsList[i].ClusterStatus(name); cs != nil {
+			hosted.Namespace = cs.Namespace
+			hosted.State = string(cs.State)
+			hosted.ErrorDescription = cs.ErrorDescription
+		}
+		resp.Namespaces = append(resp.Namespaces, hosted)
+	}
+
+	events, err := c.k8sClient.ListEvents(ctx, common.ManagerDeployedNamespace, "Cluster", name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	//Most recent events first
+	sort.Slice(events, func(i, j int) bool {
+		return events[i].LastTimestamp.After(events[j].LastTimestamp.Time)
+	})
+	for i := range events {
+		if i == maxEvents {
+			break
+		}
+		resp.Events = append(resp.Events, &apis.ClusterEvent{
+			Type:          events[i].Type,
+			Reason:        events[i].Reason,
+			Message:       events[i].Message,
+			Count:         events[i].Count,
+			LastTimestamp: util.Timestamp(&events[i].LastTimestamp),
+		})
+	}
+	return resp, nil
+}
+
+func targets(mns *v1alpha1.ManagedNamespace, clusterName string) bool {
+	for _, name := range mns.TargetClusterNames() {
+		if name == clusterName {
+			return true
+		}
+	}
+	return mns.ClusterStatus(clusterName) != nil
+}
+
+//ToProto converts the cluster custom resource to the api representation with the credentials redacted
+func ToProto(cr *v1alpha1.Cluster) *apis.ClusterInfo {
+	spec := cr.Spec.Cluster.DeepCopy()
+	if spec.Config != nil {
+		spec.Config.BearerToken = ""
+		spec.Config.Password = ""
+		spec.Config.AgentToken = ""
+		if spec.Config.TlsClientConfig != nil {
+			spec.Config.TlsClientConfig.KeyData = nil
+		}
+	}
+	res := &apis.ClusterInfo{
+		Name:              cr.Name,
+		Labels:            cr.Labels,
+		Spec:              spec,
+		ResourceVersion:   cr.ResourceVersion,
+		CreationTimestamp: util.Timestamp(&cr.CreationTimestamp),
+		Status: &apis.ClusterStatus{
+			State:             string(cr.Status.State),
+			RetryCount:        int32(cr.Status.RetryCount),
+			ErrorDescription:  cr.Status.ErrorDescription,
+			NamespaceCount:    int32(cr.Status.NamespaceCount),
+			KubernetesVersion: cr.Status.KubernetesVersion,
+			NodeCount:         int32(cr.Status.NodeCount),
+			LastProbeTime:     util.Timestamp(cr.Status.LastProbeTime),
+		},
+	}
+	if len(cr.Status.NamespaceStateCounts) > 0 {
+		res.Status.NamespaceStateCounts = make(map[string]int32)
+		for state, count := range cr.Status.NamespaceStateCounts {
+			res.Status.NamespaceStateCounts[string(state)] = int32(count)
+		}
+	}
+	if cr.Status.AllocatableCPU != nil {
+		res.Status.AllocatableCPU = cr.Status.AllocatableCPU.String()
+	}
+	if cr.Status.AllocatableMemory != nil {
+		res.Status.AllocatableMemory = cr.Status.AllocatableMemory.String()
+	}
+	if cr.Status.APILatency != nil {
+		res.Status.ApiLatency = cr.Status.APILatency.Duration.String()
+	}
+	for _, cond := range cr.Status.Conditions {
+		res.Status.Conditions = append(res.Status.Conditions, &apis.ClusterCondition{
+			Type:               string(cond.Type),
+			Status:             string(cond.Status),
+			Reason:             cond.Reason,
+			Message:            cond.Message,
+			LastTransitionTime: util.Timestamp(&cond.LastTransitionTime),
+		})
+	}
+	return res
+}
@@ -0,0 +1,65 @@
+package gateway
+
+import (
+	"context"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"google.golang.org/grpc"
+	"net/http"
+	"strings"
+
+	"github.com/keikoproj/manager/pkg/audit"
+
+	"github.com/grpc-ecosystem/grpc-gateway/runtime"
+)
+
+const (
+	//OpenAPIPath is the path the OpenAPI document is published on
+	OpenAPIPath = "/openapi.json"
+)
+
+//New returns the http handler serving the REST APIs and the OpenAPI document
+//Requests are proxied to the grpc server over the connection so they go through the same authentication and authorization
+//Only the bearer token in the Authorization header identifies the caller. Client certificates are not forwarded
+func New(ctx context.Context, conn *grpc.ClientConn) (http.Handler, error) {
+	//encoding/json is used so the json field names are the same as in the custom resources
+	gw := runtime.NewServeMux(runtime.WithMarshalerOption(runtime.MIMEWildcard, &runtime.JSONBuiltin{}), runtime.WithIncomingHeaderMatcher(headerMatcher))
+	for _, register := range []func(context.Context, *runtime.ServeMux, *grpc.ClientConn) error{
+		apis.RegisterClusterServiceHandler,
+		apis.RegisterNamespaceServiceHandler,
+		apis.RegisterApplicationServiceHandler,
+		apis.RegisterTemplateServiceHandler,
+		apis.RegisterWatchServiceHandler,
+	} {
+		if err := register(ctx, gw, conn); err != nil {
+			return nil, err
+		}
+	}
+
+	mux := http.NewServeMux()
+	mux.Handle("/v1/", gw)
+	mux.HandleFunc(OpenAPIPath, serveOpenAPI)
+	return mux, nil
+}
+
+//headerMatcher forwards the request id header as is so the callers can correlate the audit records
+//W3C trace context headers are forwarded too so that the grpc spans continue the caller trace
+func headerMatcher(key string) (string, bool) {
+	if strings.EqualFold(key, audit.RequestIDHeader) {
+		return audit.RequestIDHeader, true
+	}
+	for _, h := range []string{"traceparent", "tracestate"} {
+		if strings.EqualFold(key, h) {
+			return h, true
+		}
+	}
+	return runtime.DefaultHeaderMatcher(key)
+}
+
+func serveOpenAPI(w http.ResponseWriter, r *http.Request) {
+	if r.Method != http.MethodGet {
+		http.Error(w, http.StatusText(http.StatusMethodNotAllowed), http.StatusMethodNotAllowed)
+		return
+	}
+	w.Header().Set("Content-Type", "application/json")
+	w.Write([]byte(openAPISpec))
+}
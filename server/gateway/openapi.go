@@ -0,0 +1,2412 @@
+// Code generated by hack/gen-openapi.sh. DO NOT EDIT.
+
+package gateway
+
+//openAPISpec is the OpenAPI document of the REST APIs
+const openAPISpec = `{
+  "swagger": "2.0",
+  "info": {
+    "title": "Manager API",
+    "version": "v1"
+  },
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "paths": {
+    "/v1/applications": {
+      "get": {
+        "operationId": "ListApplications",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisListApplicationsResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "pageSize",
+            "description": "pageSize is the maximum number of applications to be returned. Default is 100.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "pageToken",
+            "description": "pageToken is the nextPageToken returned by the previous request.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      },
+      "post": {
+        "operationId": "CreateApplication",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      }
+    },
+    "/v1/applications/{name}": {
+      "get": {
+        "operationId": "GetApplication",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      },
+      "delete": {
+        "operationId": "DeleteApplication",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisDeleteApplicationResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      },
+      "put": {
+        "operationId": "UpdateApplication",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the application resource. Defaults to the appName",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      }
+    },
+    "/v1/applications/{name}/environments": {
+      "post": {
+        "operationId": "AddEnvironment",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the application",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/applicationEnvironment"
+            }
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      }
+    },
+    "/v1/applications/{name}/environments/{environmentName}": {
+      "delete": {
+        "operationId": "RemoveEnvironment",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisApplication"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the application",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "environmentName",
+            "description": "environmentName to be removed",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ApplicationService"
+        ]
+      }
+    },
+    "/v1/catalog": {
+      "get": {
+        "operationId": "Catalog",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisCatalogResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the template to be described. All the templates are returned if empty.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      }
+    },
+    "/v1/catalog/{name}": {
+      "get": {
+        "operationId": "Catalog2",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisCatalogResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the template to be described. All the templates are returned if empty",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      }
+    },
+    "/v1/clusters": {
+      "get": {
+        "operationId": "ListClusters",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisListClustersResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "tags": [
+          "ClusterService"
+        ]
+      },
+      "post": {
+        "operationId": "RegisterCluster",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/clusterCluster"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/clusterCluster"
+            }
+          }
+        ],
+        "tags": [
+          "ClusterService"
+        ]
+      }
+    },
+    "/v1/clusters/{clusterName}": {
+      "get": {
+        "operationId": "GetCluster",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisClusterInfo"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "clusterName",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ClusterService"
+        ]
+      },
+      "delete": {
+        "operationId": "UnregisterCluster",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisUnregisterClusterResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "clusterName",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ClusterService"
+        ]
+      }
+    },
+    "/v1/clusters/{clusterName}/describe": {
+      "get": {
+        "operationId": "DescribeCluster",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisDescribeClusterResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "clusterName",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "ClusterService"
+        ]
+      }
+    },
+    "/v1/namespaces": {
+      "get": {
+        "operationId": "List",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisListNamespacesResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "clusterName",
+            "description": "clusterName returns only the namespaces targeting the cluster.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "templateName",
+            "description": "templateName returns only the namespaces using the template.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "state",
+            "description": "state returns only the namespaces in the state.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "pageSize",
+            "description": "pageSize is the maximum number of namespaces to be returned. Default is 100.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "pageToken",
+            "description": "pageToken is the nextPageToken returned by the previous request.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      },
+      "post": {
+        "operationId": "Create",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      }
+    },
+    "/v1/namespaces/{name}": {
+      "get": {
+        "operationId": "Get",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      },
+      "delete": {
+        "operationId": "Delete",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisDeleteNamespaceResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      },
+      "put": {
+        "operationId": "Update",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the managed namespace",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      }
+    },
+    "/v1/namespaces/{name}/resync": {
+      "post": {
+        "operationId": "Resync",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisManagedNamespace"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisResyncNamespaceRequest"
+            }
+          }
+        ],
+        "tags": [
+          "NamespaceService"
+        ]
+      }
+    },
+    "/v1/templates": {
+      "get": {
+        "operationId": "List",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisListTemplatesResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "pageSize",
+            "description": "pageSize is the maximum number of templates to be returned. Default is 100.",
+            "in": "query",
+            "required": false,
+            "type": "integer",
+            "format": "int32"
+          },
+          {
+            "name": "pageToken",
+            "description": "pageToken is the nextPageToken returned by the previous request.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      },
+      "post": {
+        "operationId": "Create",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisTemplate"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisTemplate"
+            }
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      }
+    },
+    "/v1/templates/{name}": {
+      "get": {
+        "operationId": "Get",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisTemplate"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      },
+      "delete": {
+        "operationId": "Delete",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisDeleteTemplateResponse"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      },
+      "put": {
+        "operationId": "Update",
+        "responses": {
+          "200": {
+            "description": "A successful response.",
+            "schema": {
+              "$ref": "#/definitions/apisTemplate"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "name",
+            "description": "name of the namespace template",
+            "in": "path",
+            "required": true,
+            "type": "string"
+          },
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apisTemplate"
+            }
+          }
+        ],
+        "tags": [
+          "TemplateService"
+        ]
+      }
+    },
+    "/v1/watch": {
+      "get": {
+        "operationId": "Watch",
+        "responses": {
+          "200": {
+            "description": "A successful response.(streaming responses)",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "result": {
+                  "$ref": "#/definitions/apisWatchEvent"
+                },
+                "error": {
+                  "$ref": "#/definitions/runtimeStreamError"
+                }
+              },
+              "title": "Stream result of apisWatchEvent"
+            }
+          },
+          "default": {
+            "description": "An unexpected error response",
+            "schema": {
+              "$ref": "#/definitions/runtimeError"
+            }
+          }
+        },
+        "parameters": [
+          {
+            "name": "kinds",
+            "description": "kinds to be watched. Allowed values are Cluster, ManagedNamespace and Application. All the kinds are watched if empty.",
+            "in": "query",
+            "required": false,
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi"
+          },
+          {
+            "name": "name",
+            "description": "name of the resource to be watched. All the resources are watched if empty.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "labelSelector",
+            "description": "labelSelector filters the resources by labels. ex: env=prod,team in (a,b).",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          },
+          {
+            "name": "resourceVersion",
+            "description": "resourceVersion of the last event received. Watch resumes after this event\nIf empty, current state of all the matching resources is sent as ADDED events first.",
+            "in": "query",
+            "required": false,
+            "type": "string"
+          }
+        ],
+        "tags": [
+          "WatchService"
+        ]
+      }
+    }
+  },
+  "definitions": {
+    "apisApplication": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the application resource. Defaults to the appName"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "labels of the application"
+        },
+        "spec": {
+          "$ref": "#/definitions/applicationApplication",
+          "title": "spec is the desired state of the application"
+        },
+        "status": {
+          "$ref": "#/definitions/apisApplicationStatus",
+          "title": "status is the observed state of the application. Ignored in create/update requests"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "title": "resourceVersion must be provided to update only if the application is not modified in the meantime"
+        },
+        "creationTimestamp": {
+          "type": "string",
+          "title": "creationTimestamp in RFC3339 format"
+        }
+      },
+      "title": "Application represents the Application custom resource"
+    },
+    "apisApplicationStatus": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        },
+        "retryCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "environments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisEnvironmentStatus"
+          },
+          "title": "environments contains the state of the managed namespace of each environment"
+        },
+        "readyEnvironments": {
+          "type": "integer",
+          "format": "int32",
+          "title": "readyEnvironments is the number of environments in Ready state"
+        }
+      }
+    },
+    "apisCatalogEntry": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the namespace template"
+        },
+        "description": {
+          "type": "string"
+        },
+        "params": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/namespaceParam"
+          },
+          "title": "params contains all the exported params. Params without documentation in the template are included with name only"
+        },
+        "namespaces": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "namespaces contains the managed namespaces using this template"
+        }
+      },
+      "title": "CatalogEntry describes the template and its usage"
+    },
+    "apisCatalogResponse": {
+      "type": "object",
+      "properties": {
+        "entries": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisCatalogEntry"
+          }
+        }
+      }
+    },
+    "apisClusterCondition": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "type": "string"
+        },
+        "status": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "lastTransitionTime": {
+          "type": "string",
+          "title": "lastTransitionTime in RFC3339 format"
+        }
+      }
+    },
+    "apisClusterEvent": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "type": "string"
+        },
+        "reason": {
+          "type": "string"
+        },
+        "message": {
+          "type": "string"
+        },
+        "count": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "lastTimestamp": {
+          "type": "string",
+          "title": "lastTimestamp in RFC3339 format"
+        }
+      }
+    },
+    "apisClusterInfo": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "spec": {
+          "$ref": "#/definitions/clusterCluster"
+        },
+        "status": {
+          "$ref": "#/definitions/apisClusterStatus"
+        },
+        "creationTimestamp": {
+          "type": "string",
+          "title": "creationTimestamp in RFC3339 format"
+        },
+        "resourceVersion": {
+          "type": "string"
+        }
+      },
+      "title": "ClusterInfo represents the Cluster custom resource. Credentials are always redacted"
+    },
+    "apisClusterNamespaceStatus": {
+      "type": "object",
+      "properties": {
+        "clusterName": {
+          "type": "string"
+        },
+        "namespace": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "lastSyncTime": {
+          "type": "string",
+          "title": "lastSyncTime in RFC3339 format"
+        }
+      }
+    },
+    "apisClusterStatus": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        },
+        "retryCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "namespaceCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "namespaceStateCounts": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "integer",
+            "format": "int32"
+          },
+          "title": "namespaceStateCounts contains the number of managed namespaces in the cluster per state"
+        },
+        "kubernetesVersion": {
+          "type": "string"
+        },
+        "nodeCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "allocatableCPU": {
+          "type": "string"
+        },
+        "allocatableMemory": {
+          "type": "string"
+        },
+        "apiLatency": {
+          "type": "string"
+        },
+        "lastProbeTime": {
+          "type": "string",
+          "title": "lastProbeTime in RFC3339 format"
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisClusterCondition"
+          }
+        }
+      }
+    },
+    "apisDeleteApplicationResponse": {
+      "type": "object"
+    },
+    "apisDeleteNamespaceResponse": {
+      "type": "object"
+    },
+    "apisDeleteTemplateResponse": {
+      "type": "object"
+    },
+    "apisDescribeClusterResponse": {
+      "type": "object",
+      "properties": {
+        "cluster": {
+          "$ref": "#/definitions/apisClusterInfo"
+        },
+        "namespaces": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisHostedNamespace"
+          },
+          "title": "namespaces contains the managed namespaces targeting the cluster"
+        },
+        "events": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisClusterEvent"
+          },
+          "title": "events contains the most recent events of the cluster"
+        }
+      }
+    },
+    "apisEnvironmentStatus": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the environment"
+        },
+        "namespaceName": {
+          "type": "string",
+          "title": "namespaceName is the name of the managed namespace created for the environment"
+        },
+        "state": {
+          "type": "string"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "clusters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisClusterNamespaceStatus"
+          },
+          "title": "clusters contains the state of the namespace in each of the target clusters"
+        }
+      }
+    },
+    "apisHostedNamespace": {
+      "type": "object",
+      "properties": {
+        "managedNamespace": {
+          "type": "string",
+          "title": "managedNamespace is the name of the managed namespace resource"
+        },
+        "namespace": {
+          "type": "string",
+          "title": "namespace is the name of the namespace in the cluster"
+        },
+        "state": {
+          "type": "string"
+        },
+        "errorDescription": {
+          "type": "string"
+        }
+      },
+      "title": "HostedNamespace represents the managed namespace created in the cluster"
+    },
+    "apisListApplicationsResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisApplication"
+          }
+        },
+        "nextPageToken": {
+          "type": "string",
+          "title": "nextPageToken is empty if there are no more applications"
+        }
+      }
+    },
+    "apisListClustersResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisClusterInfo"
+          }
+        }
+      }
+    },
+    "apisListNamespacesResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisManagedNamespace"
+          }
+        },
+        "nextPageToken": {
+          "type": "string",
+          "title": "nextPageToken is empty if there are no more namespaces"
+        }
+      }
+    },
+    "apisListTemplatesResponse": {
+      "type": "object",
+      "properties": {
+        "items": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisTemplate"
+          }
+        },
+        "nextPageToken": {
+          "type": "string",
+          "title": "nextPageToken is empty if there are no more templates"
+        }
+      }
+    },
+    "apisManagedNamespace": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the managed namespace"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "labels of the managed namespace"
+        },
+        "spec": {
+          "$ref": "#/definitions/namespaceNamespace",
+          "title": "spec is the desired state of the managed namespace"
+        },
+        "status": {
+          "$ref": "#/definitions/apisManagedNamespaceStatus",
+          "title": "status is the observed state of the managed namespace. Ignored in create/update requests"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "title": "resourceVersion must be provided to update only if the managed namespace is not modified in the meantime"
+        },
+        "creationTimestamp": {
+          "type": "string",
+          "title": "creationTimestamp in RFC3339 format"
+        }
+      },
+      "title": "ManagedNamespace represents the ManagedNamespace custom resource in the manager namespace"
+    },
+    "apisManagedNamespaceStatus": {
+      "type": "object",
+      "properties": {
+        "state": {
+          "type": "string"
+        },
+        "retryCount": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "clusterName": {
+          "type": "string",
+          "title": "clusterName is the cluster chosen by the placement"
+        },
+        "clusters": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apisClusterNamespaceStatus"
+          },
+          "title": "clusters contains the state of the namespace in each of the target clusters"
+        }
+      }
+    },
+    "apisResyncNamespaceRequest": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string"
+        }
+      }
+    },
+    "apisTemplate": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the namespace template"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "labels of the namespace template"
+        },
+        "spec": {
+          "$ref": "#/definitions/namespaceNamespaceTemplate",
+          "title": "spec of the namespace template"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "title": "resourceVersion must be provided to update only if the template is not modified in the meantime"
+        },
+        "creationTimestamp": {
+          "type": "string",
+          "title": "creationTimestamp in RFC3339 format"
+        }
+      },
+      "title": "Template represents the NamespaceTemplate custom resource"
+    },
+    "apisUnregisterClusterResponse": {
+      "type": "object"
+    },
+    "apisWatchEvent": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "type": "string",
+          "title": "type of the event. One of ADDED, MODIFIED or DELETED"
+        },
+        "kind": {
+          "type": "string",
+          "title": "kind of the resource. One of Cluster, ManagedNamespace or Application"
+        },
+        "name": {
+          "type": "string"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "title": "resourceVersion of the resource. Can be used to resume the watch"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          }
+        },
+        "state": {
+          "type": "string",
+          "title": "state of the resource"
+        },
+        "previousState": {
+          "type": "string",
+          "title": "previousState is the state of the resource in the previous event. Different from state in case of a status transition"
+        },
+        "errorDescription": {
+          "type": "string"
+        },
+        "cluster": {
+          "$ref": "#/definitions/apisClusterInfo"
+        },
+        "managedNamespace": {
+          "$ref": "#/definitions/apisManagedNamespace"
+        },
+        "application": {
+          "$ref": "#/definitions/apisApplication"
+        }
+      }
+    },
+    "applicationApplication": {
+      "type": "object",
+      "properties": {
+        "appName": {
+          "type": "string",
+          "title": "Application Name\n+kubebuilder:validation:MinLength=1\nLeave 5 characters to the env since namespace will be constructed using ${appName}-${env}\n+kubebuilder:validation:MaxLength=59\n+kubebuilder:validation:Pattern=^[a-z0-9-]+$\n+kubebuilder:validation:Required\n+required"
+        },
+        "appParams": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "appParams can be used to pass the values to the underlying template being used\nIf included, it tries to replace it in the template mentioned with exported fields\nIf the same entry is provided in namespace params too then it will be overwritten by namespace param value\n+optional"
+        },
+        "prunePolicy": {
+          "type": "string",
+          "title": "prunePolicy decides what happens to the managed namespace of the environment removed from the application\nPrune deletes the managed namespace and Retain leaves it without the application as the owner. Default is Prune\n+kubebuilder:validation:Enum=Prune;Retain\n+optional"
+        },
+        "team": {
+          "type": "string",
+          "title": "team owning the application. It can be used in the namespace naming pattern\n+kubebuilder:validation:MaxLength=63\n+kubebuilder:validation:Pattern=^[a-z0-9-]*$\n+optional"
+        },
+        "rollout": {
+          "$ref": "#/definitions/applicationRollout",
+          "title": "rollout decides how the changes are rolled out to the environments\n+optional"
+        },
+        "environments": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/applicationEnvironment"
+          },
+          "title": "List of environments to be created for this application\n+kubebuilder:validation:MinItems=1"
+        }
+      }
+    },
+    "applicationEnvironment": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "Application environment\n+kubebuilder:validation:MinLength=1\n+kubebuilder:validation:Pattern=^[a-z0-9-]+$\n+required"
+        },
+        "namespaceName": {
+          "type": "string",
+          "title": "namespaceName overrides the managed namespace name derived from the naming pattern configured in the manager\nDefault pattern is {app}-{env}. Placeholders {app}, {env}, {cluster} and {team} are supported\nThe name is passed to the template as the name param unless the param is provided already\n+kubebuilder:validation:MaxLength=63\n+kubebuilder:validation:Pattern=^[a-z0-9-]*$\n+optional"
+        },
+        "namespace": {
+          "$ref": "#/definitions/namespaceNamespace",
+          "title": "Each environment must have one namespace\n+required"
+        }
+      }
+    },
+    "applicationRollout": {
+      "type": "object",
+      "properties": {
+        "strategy": {
+          "type": "string",
+          "title": "strategy AllAtOnce updates all the environments together. Progressive updates the environments in the listed order\nEach environment is updated only after the previous one is ready for the soak time. Default is AllAtOnce\n+kubebuilder:validation:Enum=AllAtOnce;Progressive\n+optional"
+        },
+        "soakTimeSeconds": {
+          "type": "integer",
+          "format": "int32",
+          "title": "soakTimeSeconds is the time previous environment must be ready before the next environment is updated\n+kubebuilder:validation:Minimum=0\n+optional"
+        },
+        "manualPromotion": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "manualPromotion holds each next environment until it is promoted with the manager.keikoproj.io/promote annotation\nset to the environment name\n+optional"
+        }
+      }
+    },
+    "clusterCluster": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "Name contains cluster name"
+        },
+        "cloud": {
+          "type": "string",
+          "title": "Type contains kubernetes cluster installation type. ex: AWS, GCP"
+        },
+        "config": {
+          "$ref": "#/definitions/clusterConfig",
+          "title": "Config contains info to connect to the target cluster\nThis is same as config struct in https://github.com/kubernetes/client-go/blob/master/rest/config.go\nbut have to define it again here with whatever we need"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Labels to be added to the cluster. Namespaces can use these labels to select the cluster\n+optional"
+        },
+        "deletionPolicy": {
+          "type": "string",
+          "title": "deletionPolicy decides what happens to the managed namespaces when the cluster is deleted\nAllowed values are\n- Block: cluster can not be deleted while managed namespaces exist (default)\n- Cascade: namespaces get deleted in the cluster along with the managed namespaces\n- Orphan: managed namespaces get deleted but namespaces are left as is in the cluster\n+kubebuilder:validation:Enum=Block;Cascade;Orphan\n+optional"
+        },
+        "mode": {
+          "type": "string",
+          "title": "mode decides how manager reaches the cluster\nAllowed values are\n- direct: manager connects to the cluster api server using the config (default)\n- agent: agent running inside the cluster pulls the desired state from manager and reports the status back\n+kubebuilder:validation:Enum=direct;agent\n+optional"
+        }
+      }
+    },
+    "clusterConfig": {
+      "type": "object",
+      "properties": {
+        "host": {
+          "type": "string",
+          "description": "Host must be a host string, a host:port pair, or a URL to the base of the apiserver.\nIf a URL is given then the (optional) Path of that URL represents a prefix that must\nbe appended to all request URIs used to access the apiserver. This allows a frontend\nproxy to easily relocate all of the apiserver endpoints."
+        },
+        "username": {
+          "type": "string"
+        },
+        "password": {
+          "type": "string",
+          "title": "password contains basic auth password"
+        },
+        "bearerToken": {
+          "type": "string",
+          "description": "Server requires Bearer authentication. This client will not attempt to use\nrefresh tokens for an OAuth2 flow.\nTODO: demonstrate an OAuth2 compatible client."
+        },
+        "bearerTokenSecret": {
+          "type": "string",
+          "title": "Secret containing a BearerToken.\nIf set, The last successfully read value takes precedence over BearerToken.\n+optional"
+        },
+        "tlsClientConfig": {
+          "$ref": "#/definitions/clusterTLSClientConfig",
+          "title": "TLSClientConfig contains settings to enable transport layer security\n+optional"
+        },
+        "agentToken": {
+          "type": "string",
+          "title": "AgentToken is used by the agent to authenticate with the manager.\nIt is returned only while registering a cluster in agent mode and never stored in the cluster resource\n+optional"
+        }
+      },
+      "title": "Config holds the common attributes that can be passed to a Kubernetes client on\ninitialization.\n+optional"
+    },
+    "clusterTLSClientConfig": {
+      "type": "object",
+      "properties": {
+        "inSecure": {
+          "type": "boolean",
+          "format": "boolean",
+          "description": "Server should be accessed without verifying the TLS certificate. For testing only."
+        },
+        "serverName": {
+          "type": "string",
+          "description": "ServerName is passed to the server for SNI and is used in the client to check server\nceritificates against. If ServerName is empty, the hostname used to contact the\nserver is used."
+        },
+        "certData": {
+          "type": "string",
+          "format": "byte",
+          "title": "CertData holds PEM-encoded bytes (typically read from a client certificate file).\nCertData takes precedence over CertFile"
+        },
+        "keyData": {
+          "type": "string",
+          "format": "byte",
+          "title": "KeyData holds PEM-encoded bytes (typically read from a client certificate key file).\nKeyData takes precedence over KeyFile"
+        },
+        "caData": {
+          "type": "string",
+          "format": "byte",
+          "title": "CAData holds PEM-encoded bytes (typically read from a root certificates bundle).\nCAData takes precedence over CAFile"
+        },
+        "nextProtos": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "NextProtos is a list of supported application level protocols, in order of preference.\nUsed to populate tls.Config.NextProtos.\nTo indicate to the server http/1.1 is preferred over http/2, set to [\"http/1.1\", \"h2\"] (though the server is free to ignore that preference).\nTo use only http/1.1, set to [\"http/1.1\"]."
+        }
+      },
+      "title": "TLSClientConfig contains settings to enable transport layer security"
+    },
+    "corev1Namespace": {
+      "type": "object",
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/v1ObjectMeta",
+          "title": "Standard object's metadata.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional"
+        },
+        "spec": {
+          "$ref": "#/definitions/v1NamespaceSpec",
+          "title": "Spec defines the behavior of the Namespace.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status\n+optional"
+        },
+        "status": {
+          "$ref": "#/definitions/v1NamespaceStatus",
+          "title": "Status describes the current status of a Namespace.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status\n+optional"
+        }
+      },
+      "description": "Namespace provides a scope for Names.\nUse of multiple namespaces is optional."
+    },
+    "namespaceCustomResource": {
+      "type": "object",
+      "properties": {
+        "GVK": {
+          "$ref": "#/definitions/namespaceGroupVersionKind",
+          "title": "GroupVersionKind should be used to provide the specific GVK for this custom resource"
+        },
+        "manifest": {
+          "type": "string",
+          "title": "manifest should be used to provide the custom resource manifest and must be in JSON"
+        }
+      }
+    },
+    "namespaceGroupVersionKind": {
+      "type": "object",
+      "properties": {
+        "group": {
+          "type": "string",
+          "title": "group -custom resource group\n+required"
+        },
+        "version": {
+          "type": "string",
+          "title": "version - custom resource version\n+required"
+        },
+        "kind": {
+          "type": "string",
+          "title": "kind - custom resource kind\n+required"
+        }
+      },
+      "title": "GroupVersionKind can be used to provide GVK of a custom resource"
+    },
+    "namespaceNamespace": {
+      "type": "object",
+      "properties": {
+        "clusterName": {
+          "type": "string",
+          "title": "Name of the cluster in which this namespace to be created\nEither clusterName or clusterSelector must be provided. clusterName takes precedence over clusterSelector\n+kubebuilder:validation:MaxLength=63\n+kubebuilder:validation:Pattern=^[a-z0-9-]*$\n+optional"
+        },
+        "templateName": {
+          "type": "string",
+          "title": "Name of the template to be used to create this namespace\nThis template must be already exists in the manager\n+optional"
+        },
+        "params": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "params can be used to pass the values to the underlying template being used\nIf included, it tries to replace it in the template mentioned with exported fields\n+optional"
+        },
+        "nsResources": {
+          "$ref": "#/definitions/namespaceNamespaceResources",
+          "title": "NamespaceResources to be created. If templateName also included in the request,\nmake sure to use this to add \"additional resources\" only apart from the template\nDO NOT Provide namespace in the resources section if you already provided the templateName\n+optional"
+        },
+        "clusterSelector": {
+          "$ref": "#/definitions/v1LabelSelector",
+          "title": "clusterSelector selects the candidate clusters based on cluster labels when clusterName is not provided\nChosen cluster is recorded in the status and namespace stays in that cluster even if the labels change later\n+optional"
+        },
+        "placementStrategy": {
+          "type": "string",
+          "title": "placementStrategy decides which one of the clusters matching the clusterSelector to be chosen\nAllowed values are\n- spread: cluster with the least number of managed namespaces (default)\n- least-loaded: cluster with the most allocatable cpu per managed namespace\n- pinned: first matching cluster in the alphabetical order\n+kubebuilder:validation:Enum=spread;least-loaded;pinned\n+optional"
+        },
+        "clusterNames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "clusterNames can be used to create the same namespace in multiple clusters\nNamespace is reconciled in each of the clusters independently. clusterName, if provided, is added to the list\n+optional"
+        },
+        "deletionPolicy": {
+          "type": "string",
+          "title": "deletionPolicy decides what happens to the namespace in the clusters when the managed namespace is deleted\nor when the cluster is removed from the list\nAllowed values are\n- Delete: namespace gets deleted in the cluster\n- Orphan: namespace is left as is in the cluster (default)\n+kubebuilder:validation:Enum=Delete;Orphan\n+optional"
+        }
+      }
+    },
+    "namespaceNamespaceResources": {
+      "type": "object",
+      "properties": {
+        "namespace": {
+          "$ref": "#/definitions/corev1Namespace",
+          "title": "Namespace is mandatory\n+required"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/namespaceResource"
+          },
+          "title": "Resources to be created and must include at least namespace\n+optional"
+        }
+      }
+    },
+    "namespaceNamespaceTemplate": {
+      "type": "object",
+      "properties": {
+        "exportedParamName": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "exportedParamName to be exported from this template\nThese params will be passed in namespace creation and values will be replaced\nIf you are using params in the template, make sure to to include in the resources with ${exportedParamName}\n+optional"
+        },
+        "nsResources": {
+          "$ref": "#/definitions/namespaceNamespaceResources",
+          "title": "NamespaceResources consists of all the resources to be created in namespace including custom resources\n+required"
+        },
+        "description": {
+          "type": "string",
+          "title": "description of the template to be shown in the template catalog\n+optional"
+        },
+        "params": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/namespaceParam"
+          },
+          "title": "params documents the exported params and provides the default values\nDefault value is used when the namespace doesn't provide the value for the param\n+optional"
+        }
+      }
+    },
+    "namespaceParam": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "name of the exported param\n+required"
+        },
+        "description": {
+          "type": "string",
+          "title": "description of the param\n+optional"
+        },
+        "defaultValue": {
+          "type": "string",
+          "title": "defaultValue is used when the namespace doesn't provide the value\n+optional"
+        },
+        "required": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "required params must be provided by the namespace when there is no default value\n+optional"
+        }
+      }
+    },
+    "namespaceResource": {
+      "type": "object",
+      "properties": {
+        "serviceAccount": {
+          "$ref": "#/definitions/v1ServiceAccount",
+          "title": "ServiceAccount to be created for this namespace.\nMust include type=ServiceAccount and only service account will be read at\nthe server side and everything else will be ignored\n+optional"
+        },
+        "role": {
+          "$ref": "#/definitions/v1Role",
+          "title": "Role to be created for this namespace.\nMust include type=Role and only Role will be read at\nthe server side and everything else will be ignored\n+optional"
+        },
+        "roleBinding": {
+          "$ref": "#/definitions/v1RoleBinding",
+          "title": "RoleBinding to bind the role and service account for this namespace.\nMust include type=RoleBinding and only RoleBinding will be read at\nthe server side and everything else will be ignored\n+optional"
+        },
+        "resourceQuota": {
+          "$ref": "#/definitions/v1ResourceQuota",
+          "title": "ResourceQuota to be created for this namespace.\nMust include type=ResourceQuota and only ResourceQuota will be read at\nthe server side and everything else will be ignored.\n+optional"
+        },
+        "customResource": {
+          "$ref": "#/definitions/namespaceCustomResource",
+          "title": "CustomResource to be created for this namespace\nMust include type=CustomResource and only CustomResource will be read at\nthe server side and everything else will be ignored\n+optional"
+        },
+        "name": {
+          "type": "string",
+          "title": "+required"
+        },
+        "type": {
+          "type": "string",
+          "title": "Type represents which k8s resource is being included in the resource entry\nAllowed values are\n- ServiceAccount\n- Role\n- RoleBinding\n- ResourceQuota\n- CustomResource\n+kubebuilder:validation:Enum=ServiceAccount;Role;RoleBinding;ResourceQuota;CustomResource"
+        },
+        "dependsOn": {
+          "type": "string",
+          "title": "dependsOn is an optional field and can be used to delay the creation until the referenced resource got created\ndependsOn should provide the name of the resource it dependent on\n+optional"
+        },
+        "createOnly": {
+          "type": "string",
+          "title": "createOnly param can be used to control whether resource to be created only once and do not overwrite in subsequent reconcile process\n+optional\n+kubebuilder:validation:Enum=\"true\";\"false\""
+        }
+      }
+    },
+    "protobufAny": {
+      "type": "object",
+      "properties": {
+        "type_url": {
+          "type": "string"
+        },
+        "value": {
+          "type": "string",
+          "format": "byte"
+        }
+      }
+    },
+    "resourceQuantity": {
+      "type": "string"
+    },
+    "runtimeError": {
+      "type": "object",
+      "properties": {
+        "error": {
+          "type": "string"
+        },
+        "code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    },
+    "runtimeStreamError": {
+      "type": "object",
+      "properties": {
+        "grpc_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "http_code": {
+          "type": "integer",
+          "format": "int32"
+        },
+        "message": {
+          "type": "string"
+        },
+        "http_status": {
+          "type": "string"
+        },
+        "details": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/protobufAny"
+          }
+        }
+      }
+    },
+    "v1FieldsV1": {
+      "type": "object"
+    },
+    "v1LabelSelector": {
+      "type": "object",
+      "properties": {
+        "matchLabels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "matchLabels is a map of {key,value} pairs. A single {key,value} in the matchLabels\nmap is equivalent to an element of matchExpressions, whose key field is \"key\", the\noperator is \"In\", and the values array contains only \"value\". The requirements are ANDed.\n+optional"
+        },
+        "matchExpressions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1LabelSelectorRequirement"
+          },
+          "title": "matchExpressions is a list of label selector requirements. The requirements are ANDed.\n+optional"
+        }
+      },
+      "description": "A label selector is a label query over a set of resources. The result of matchLabels and\nmatchExpressions are ANDed. An empty label selector matches all objects. A null\nlabel selector matches no objects."
+    },
+    "v1LabelSelectorRequirement": {
+      "type": "object",
+      "properties": {
+        "key": {
+          "type": "string",
+          "title": "key is the label key that the selector applies to.\n+patchMergeKey=key\n+patchStrategy=merge"
+        },
+        "operator": {
+          "type": "string",
+          "description": "operator represents a key's relationship to a set of values.\nValid operators are In, NotIn, Exists and DoesNotExist."
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "values is an array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty. This array is replaced during a strategic\nmerge patch.\n+optional"
+        }
+      },
+      "description": "A label selector requirement is a selector that contains values, a key, and an operator that\nrelates the key and values."
+    },
+    "v1LocalObjectReference": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\nTODO: Add other useful fields. apiVersion, kind, uid?\n+optional"
+        }
+      },
+      "description": "LocalObjectReference contains enough information to let you locate the\nreferenced object inside the same namespace."
+    },
+    "v1ManagedFieldsEntry": {
+      "type": "object",
+      "properties": {
+        "manager": {
+          "type": "string",
+          "description": "Manager is an identifier of the workflow managing these fields."
+        },
+        "operation": {
+          "type": "string",
+          "description": "Operation is the type of operation which lead to this ManagedFieldsEntry being created.\nThe only valid values for this field are 'Apply' and 'Update'."
+        },
+        "apiVersion": {
+          "type": "string",
+          "description": "APIVersion defines the version of this resource that this field set\napplies to. The format is \"group/version\" just like the top-level\nAPIVersion field. It is necessary to track the version of a field\nset because it cannot be automatically converted."
+        },
+        "time": {
+          "$ref": "#/definitions/v1Time",
+          "title": "Time is timestamp of when these fields were set. It should always be empty if Operation is 'Apply'\n+optional"
+        },
+        "fieldsType": {
+          "type": "string",
+          "title": "FieldsType is the discriminator for the different fields format and version.\nThere is currently only one possible value: \"FieldsV1\""
+        },
+        "fieldsV1": {
+          "$ref": "#/definitions/v1FieldsV1",
+          "title": "FieldsV1 holds the first JSON version format as described in the \"FieldsV1\" type.\n+optional"
+        }
+      },
+      "description": "ManagedFieldsEntry is a workflow-id, a FieldSet and the group version of the resource\nthat the fieldset applies to."
+    },
+    "v1NamespaceCondition": {
+      "type": "object",
+      "properties": {
+        "type": {
+          "type": "string",
+          "description": "Type of namespace controller condition."
+        },
+        "status": {
+          "type": "string",
+          "description": "Status of the condition, one of True, False, Unknown."
+        },
+        "lastTransitionTime": {
+          "$ref": "#/definitions/v1Time",
+          "title": "+optional"
+        },
+        "reason": {
+          "type": "string",
+          "title": "+optional"
+        },
+        "message": {
+          "type": "string",
+          "title": "+optional"
+        }
+      },
+      "description": "NamespaceCondition contains details about state of namespace."
+    },
+    "v1NamespaceSpec": {
+      "type": "object",
+      "properties": {
+        "finalizers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Finalizers is an opaque list of values that must be empty to permanently remove object from storage.\nMore info: https://kubernetes.io/docs/tasks/administer-cluster/namespaces/\n+optional"
+        }
+      },
+      "description": "NamespaceSpec describes the attributes on a Namespace."
+    },
+    "v1NamespaceStatus": {
+      "type": "object",
+      "properties": {
+        "phase": {
+          "type": "string",
+          "title": "Phase is the current lifecycle phase of the namespace.\nMore info: https://kubernetes.io/docs/tasks/administer-cluster/namespaces/\n+optional"
+        },
+        "conditions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1NamespaceCondition"
+          },
+          "title": "Represents the latest available observations of a namespace's current state.\n+optional\n+patchMergeKey=type\n+patchStrategy=merge"
+        }
+      },
+      "description": "NamespaceStatus is information about the current status of a Namespace."
+    },
+    "v1ObjectMeta": {
+      "type": "object",
+      "properties": {
+        "name": {
+          "type": "string",
+          "title": "Name must be unique within a namespace. Is required when creating resources, although\nsome resources may allow a client to request the generation of an appropriate name\nautomatically. Name is primarily intended for creation idempotence and configuration\ndefinition.\nCannot be updated.\nMore info: http://kubernetes.io/docs/user-guide/identifiers#names\n+optional"
+        },
+        "generateName": {
+          "type": "string",
+          "description": "GenerateName is an optional prefix, used by the server, to generate a unique\nname ONLY IF the Name field has not been provided.\nIf this field is used, the name returned to the client will be different\nthan the name passed. This value will also be combined with a unique suffix.\nThe provided value has the same validation rules as the Name field,\nand may be truncated by the length of the suffix required to make the value\nunique on the server.\n\nIf this field is specified and the generated name exists, the server will\nNOT return a 409 - instead, it will either return 201 Created or 500 with Reason\nServerTimeout indicating a unique name could not be found in the time allotted, and the client\nshould retry (optionally after the time indicated in the Retry-After header).\n\nApplied only if Name is not specified.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#idempotency\n+optional"
+        },
+        "namespace": {
+          "type": "string",
+          "description": "Namespace defines the space within each name must be unique. An empty namespace is\nequivalent to the \"default\" namespace, but \"default\" is the canonical representation.\nNot all objects are required to be scoped to a namespace - the value of this field for\nthose objects will be empty.\n\nMust be a DNS_LABEL.\nCannot be updated.\nMore info: http://kubernetes.io/docs/user-guide/namespaces\n+optional"
+        },
+        "selfLink": {
+          "type": "string",
+          "description": "SelfLink is a URL representing this object.\nPopulated by the system.\nRead-only.\n\nDEPRECATED\nKubernetes will stop propagating this field in 1.20 release and the field is planned\nto be removed in 1.21 release.\n+optional"
+        },
+        "uid": {
+          "type": "string",
+          "description": "UID is the unique in time and space value for this object. It is typically generated by\nthe server on successful creation of a resource and is not allowed to change on PUT\noperations.\n\nPopulated by the system.\nRead-only.\nMore info: http://kubernetes.io/docs/user-guide/identifiers#uids\n+optional"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "description": "An opaque value that represents the internal version of this object that can\nbe used by clients to determine when objects have changed. May be used for optimistic\nconcurrency, change detection, and the watch operation on a resource or set of resources.\nClients must treat these values as opaque and passed unmodified back to the server.\nThey may only be valid for a particular resource or set of resources.\n\nPopulated by the system.\nRead-only.\nValue must be treated as opaque by clients and .\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency\n+optional"
+        },
+        "generation": {
+          "type": "string",
+          "format": "int64",
+          "title": "A sequence number representing a specific generation of the desired state.\nPopulated by the system. Read-only.\n+optional"
+        },
+        "creationTimestamp": {
+          "$ref": "#/definitions/v1Time",
+          "description": "CreationTimestamp is a timestamp representing the server time when this object was\ncreated. It is not guaranteed to be set in happens-before order across separate operations.\nClients may not set this value. It is represented in RFC3339 form and is in UTC.\n\nPopulated by the system.\nRead-only.\nNull for lists.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional"
+        },
+        "deletionTimestamp": {
+          "$ref": "#/definitions/v1Time",
+          "description": "DeletionTimestamp is RFC 3339 date and time at which this resource will be deleted. This\nfield is set by the server when a graceful deletion is requested by the user, and is not\ndirectly settable by a client. The resource is expected to be deleted (no longer visible\nfrom resource lists, and not reachable by name) after the time in this field, once the\nfinalizers list is empty. As long as the finalizers list contains items, deletion is blocked.\nOnce the deletionTimestamp is set, this value may not be unset or be set further into the\nfuture, although it may be shortened or the resource may be deleted prior to this time.\nFor example, a user may request that a pod is deleted in 30 seconds. The Kubelet will react\nby sending a graceful termination signal to the containers in the pod. After that 30 seconds,\nthe Kubelet will send a hard termination signal (SIGKILL) to the container and after cleanup,\nremove the pod from the API. In the presence of network partitions, this object may still\nexist after this timestamp, until an administrator or automated process can determine the\nresource is fully terminated.\nIf not set, graceful deletion of the object has not been requested.\n\nPopulated by the system when a graceful deletion is requested.\nRead-only.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional"
+        },
+        "deletionGracePeriodSeconds": {
+          "type": "string",
+          "format": "int64",
+          "title": "Number of seconds allowed for this object to gracefully terminate before\nit will be removed from the system. Only set when deletionTimestamp is also set.\nMay only be shortened.\nRead-only.\n+optional"
+        },
+        "labels": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Map of string keys and values that can be used to organize and categorize\n(scope and select) objects. May match selectors of replication controllers\nand services.\nMore info: http://kubernetes.io/docs/user-guide/labels\n+optional"
+        },
+        "annotations": {
+          "type": "object",
+          "additionalProperties": {
+            "type": "string"
+          },
+          "title": "Annotations is an unstructured key value map stored with a resource that may be\nset by external tools to store and retrieve arbitrary metadata. They are not\nqueryable and should be preserved when modifying objects.\nMore info: http://kubernetes.io/docs/user-guide/annotations\n+optional"
+        },
+        "ownerReferences": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1OwnerReference"
+          },
+          "title": "List of objects depended by this object. If ALL objects in the list have\nbeen deleted, this object will be garbage collected. If this object is managed by a controller,\nthen an entry in this list will point to this controller, with the controller field set to true.\nThere cannot be more than one managing controller.\n+optional\n+patchMergeKey=uid\n+patchStrategy=merge"
+        },
+        "finalizers": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Must be empty before the object is deleted from the registry. Each entry\nis an identifier for the responsible component that will remove the entry\nfrom the list. If the deletionTimestamp of the object is non-nil, entries\nin this list can only be removed.\nFinalizers may be processed and removed in any order.  Order is NOT enforced\nbecause it introduces significant risk of stuck finalizers.\nfinalizers is a shared field, any actor with permission can reorder it.\nIf the finalizer list is processed in order, then this can lead to a situation\nin which the component responsible for the first finalizer in the list is\nwaiting for a signal (field value, external system, or other) produced by a\ncomponent responsible for a finalizer later in the list, resulting in a deadlock.\nWithout enforced ordering finalizers are free to order amongst themselves and\nare not vulnerable to ordering changes in the list.\n+optional\n+patchStrategy=merge"
+        },
+        "clusterName": {
+          "type": "string",
+          "title": "The name of the cluster which the object belongs to.\nThis is used to distinguish resources with same name and namespace in different clusters.\nThis field is not set anywhere right now and apiserver is going to ignore it if set in create or update request.\n+optional"
+        },
+        "managedFields": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ManagedFieldsEntry"
+          },
+          "description": "ManagedFields maps workflow-id and version to the set of fields\nthat are managed by that workflow. This is mostly for internal\nhousekeeping, and users typically shouldn't need to set or\nunderstand this field. A workflow can be the user's name, a\ncontroller's name, or the name of a specific apply path like\n\"ci-cd\". The set of fields is always in the version that the\nworkflow used when modifying the object.\n\n+optional"
+        }
+      },
+      "description": "ObjectMeta is metadata that all persisted resources must have, which includes all objects\nusers must create."
+    },
+    "v1ObjectReference": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string",
+          "title": "Kind of the referent.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds\n+optional"
+        },
+        "namespace": {
+          "type": "string",
+          "title": "Namespace of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/namespaces/\n+optional"
+        },
+        "name": {
+          "type": "string",
+          "title": "Name of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#names\n+optional"
+        },
+        "uid": {
+          "type": "string",
+          "title": "UID of the referent.\nMore info: https://kubernetes.io/docs/concepts/overview/working-with-objects/names/#uids\n+optional"
+        },
+        "apiVersion": {
+          "type": "string",
+          "title": "API version of the referent.\n+optional"
+        },
+        "resourceVersion": {
+          "type": "string",
+          "title": "Specific resourceVersion to which this reference is made, if any.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#concurrency-control-and-consistency\n+optional"
+        },
+        "fieldPath": {
+          "type": "string",
+          "title": "If referring to a piece of an object instead of an entire object, this string\nshould contain a valid JSON/Go field access statement, such as desiredState.manifest.containers[2].\nFor example, if the object reference is to a container within a pod, this would take on a value like:\n\"spec.containers{name}\" (where \"name\" refers to the name of the container that triggered\nthe event) or if no container name is specified \"spec.containers[2]\" (container with\nindex 2 in this pod). This syntax is chosen only to have some well-defined way of\nreferencing a part of an object.\nTODO: this design is not final and this field is subject to change in the future.\n+optional"
+        }
+      },
+      "title": "ObjectReference contains enough information to let you inspect or modify the referred object.\n+k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object"
+    },
+    "v1OwnerReference": {
+      "type": "object",
+      "properties": {
+        "apiVersion": {
+          "type": "string",
+          "description": "API version of the referent."
+        },
+        "kind": {
+          "type": "string",
+          "title": "Kind of the referent.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#types-kinds"
+        },
+        "name": {
+          "type": "string",
+          "title": "Name of the referent.\nMore info: http://kubernetes.io/docs/user-guide/identifiers#names"
+        },
+        "uid": {
+          "type": "string",
+          "title": "UID of the referent.\nMore info: http://kubernetes.io/docs/user-guide/identifiers#uids"
+        },
+        "controller": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "If true, this reference points to the managing controller.\n+optional"
+        },
+        "blockOwnerDeletion": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "If true, AND if the owner has the \"foregroundDeletion\" finalizer, then\nthe owner cannot be deleted from the key-value store until this\nreference is removed.\nDefaults to false.\nTo set this field, a user needs \"delete\" permission of the owner,\notherwise 422 (Unprocessable Entity) will be returned.\n+optional"
+        }
+      },
+      "description": "OwnerReference contains enough information to let you identify an owning\nobject. An owning object must be in the same namespace as the dependent, or\nbe cluster-scoped, so there is no namespace field."
+    },
+    "v1PolicyRule": {
+      "type": "object",
+      "properties": {
+        "verbs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "description": "Verbs is a list of Verbs that apply to ALL the ResourceKinds and AttributeRestrictions contained in this rule.  VerbAll represents all kinds."
+        },
+        "apiGroups": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "APIGroups is the name of the APIGroup that contains the resources.  If multiple API groups are specified, any action requested against one of\nthe enumerated resources in any API group will be allowed.\n+optional"
+        },
+        "resources": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "Resources is a list of resources this rule applies to.  ResourceAll represents all resources.\n+optional"
+        },
+        "resourceNames": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "ResourceNames is an optional white list of names that the rule applies to.  An empty set means that everything is allowed.\n+optional"
+        },
+        "nonResourceURLs": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "NonResourceURLs is a set of partial urls that a user should have access to.  *s are allowed, but only as the full, final step in the path\nSince non-resource URLs are not namespaced, this field is only applicable for ClusterRoles referenced from a ClusterRoleBinding.\nRules can either apply to API resources (such as \"pods\" or \"secrets\") or non-resource URL paths (such as \"/api\"),  but not both.\n+optional"
+        }
+      },
+      "description": "PolicyRule holds information that describes a policy rule, but does not contain information\nabout who the rule applies to or which namespace the rule applies to."
+    },
+    "v1ResourceQuota": {
+      "type": "object",
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/v1ObjectMeta",
+          "title": "Standard object's metadata.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional"
+        },
+        "spec": {
+          "$ref": "#/definitions/v1ResourceQuotaSpec",
+          "title": "Spec defines the desired quota.\nhttps://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status\n+optional"
+        },
+        "status": {
+          "$ref": "#/definitions/v1ResourceQuotaStatus",
+          "title": "Status defines the actual enforced quota and its current usage.\nhttps://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#spec-and-status\n+optional"
+        }
+      },
+      "title": "ResourceQuota sets aggregate quota restrictions enforced per namespace"
+    },
+    "v1ResourceQuotaSpec": {
+      "type": "object",
+      "properties": {
+        "hard": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/resourceQuantity"
+          },
+          "title": "hard is the set of desired hard limits for each named resource.\nMore info: https://kubernetes.io/docs/concepts/policy/resource-quotas/\n+optional"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "A collection of filters that must match each object tracked by a quota.\nIf not specified, the quota matches all objects.\n+optional"
+        },
+        "scopeSelector": {
+          "$ref": "#/definitions/v1ScopeSelector",
+          "title": "scopeSelector is also a collection of filters like scopes that must match each object tracked by a quota\nbut expressed using ScopeSelectorOperator in combination with possible values.\nFor a resource to match, both scopes AND scopeSelector (if specified in spec), must be matched.\n+optional"
+        }
+      },
+      "description": "ResourceQuotaSpec defines the desired hard limits to enforce for Quota."
+    },
+    "v1ResourceQuotaStatus": {
+      "type": "object",
+      "properties": {
+        "hard": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/resourceQuantity"
+          },
+          "title": "Hard is the set of enforced hard limits for each named resource.\nMore info: https://kubernetes.io/docs/concepts/policy/resource-quotas/\n+optional"
+        },
+        "used": {
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/resourceQuantity"
+          },
+          "title": "Used is the current observed total usage of the resource in the namespace.\n+optional"
+        }
+      },
+      "description": "ResourceQuotaStatus defines the enforced hard limits and observed use."
+    },
+    "v1Role": {
+      "type": "object",
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/v1ObjectMeta",
+          "title": "Standard object's metadata.\n+optional"
+        },
+        "rules": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1PolicyRule"
+          },
+          "title": "Rules holds all the PolicyRules for this Role\n+optional"
+        }
+      },
+      "description": "Role is a namespaced, logical grouping of PolicyRules that can be referenced as a unit by a RoleBinding."
+    },
+    "v1RoleBinding": {
+      "type": "object",
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/v1ObjectMeta",
+          "title": "Standard object's metadata.\n+optional"
+        },
+        "subjects": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1Subject"
+          },
+          "title": "Subjects holds references to the objects the role applies to.\n+optional"
+        },
+        "roleRef": {
+          "$ref": "#/definitions/v1RoleRef",
+          "description": "RoleRef can reference a Role in the current namespace or a ClusterRole in the global namespace.\nIf the RoleRef cannot be resolved, the Authorizer must return an error."
+        }
+      },
+      "description": "RoleBinding references a role, but does not contain it.  It can reference a Role in the same namespace or a ClusterRole in the global namespace.\nIt adds who information via Subjects and namespace information by which namespace it exists in.  RoleBindings in a given\nnamespace only have effect in that namespace."
+    },
+    "v1RoleRef": {
+      "type": "object",
+      "properties": {
+        "apiGroup": {
+          "type": "string",
+          "title": "APIGroup is the group for the resource being referenced"
+        },
+        "kind": {
+          "type": "string",
+          "title": "Kind is the type of resource being referenced"
+        },
+        "name": {
+          "type": "string",
+          "title": "Name is the name of resource being referenced"
+        }
+      },
+      "title": "RoleRef contains information that points to the role being used"
+    },
+    "v1ScopeSelector": {
+      "type": "object",
+      "properties": {
+        "matchExpressions": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ScopedResourceSelectorRequirement"
+          },
+          "title": "A list of scope selector requirements by scope of the resources.\n+optional"
+        }
+      },
+      "description": "A scope selector represents the AND of the selectors represented\nby the scoped-resource selector requirements."
+    },
+    "v1ScopedResourceSelectorRequirement": {
+      "type": "object",
+      "properties": {
+        "scopeName": {
+          "type": "string",
+          "description": "The name of the scope that the selector applies to."
+        },
+        "operator": {
+          "type": "string",
+          "description": "Represents a scope's relationship to a set of values.\nValid operators are In, NotIn, Exists, DoesNotExist."
+        },
+        "values": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "title": "An array of string values. If the operator is In or NotIn,\nthe values array must be non-empty. If the operator is Exists or DoesNotExist,\nthe values array must be empty.\nThis array is replaced during a strategic merge patch.\n+optional"
+        }
+      },
+      "description": "A scoped-resource selector requirement is a selector that contains values, a scope name, and an operator\nthat relates the scope name and values."
+    },
+    "v1ServiceAccount": {
+      "type": "object",
+      "properties": {
+        "metadata": {
+          "$ref": "#/definitions/v1ObjectMeta",
+          "title": "Standard object's metadata.\nMore info: https://git.k8s.io/community/contributors/devel/sig-architecture/api-conventions.md#metadata\n+optional"
+        },
+        "secrets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1ObjectReference"
+          },
+          "title": "Secrets is the list of secrets allowed to be used by pods running using this ServiceAccount.\nMore info: https://kubernetes.io/docs/concepts/configuration/secret\n+optional\n+patchMergeKey=name\n+patchStrategy=merge"
+        },
+        "imagePullSecrets": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/v1LocalObjectReference"
+          },
+          "title": "ImagePullSecrets is a list of references to secrets in the same namespace to use for pulling any images\nin pods that reference this ServiceAccount. ImagePullSecrets are distinct from Secrets because Secrets\ncan be mounted in the pod, but ImagePullSecrets are only accessed by the kubelet.\nMore info: https://kubernetes.io/docs/concepts/containers/images/#specifying-imagepullsecrets-on-a-pod\n+optional"
+        },
+        "automountServiceAccountToken": {
+          "type": "boolean",
+          "format": "boolean",
+          "title": "AutomountServiceAccountToken indicates whether pods running as this service account should have an API token automatically mounted.\nCan be overridden at the pod level.\n+optional"
+        }
+      },
+      "title": "ServiceAccount binds together:\n* a name, understood by users, and perhaps by peripheral systems, for an identity\n* a principal that can be authenticated and authorized\n* a set of secrets"
+    },
+    "v1Subject": {
+      "type": "object",
+      "properties": {
+        "kind": {
+          "type": "string",
+          "description": "Kind of object being referenced. Values defined by this API group are \"User\", \"Group\", and \"ServiceAccount\".\nIf the Authorizer does not recognized the kind value, the Authorizer should report an error."
+        },
+        "apiGroup": {
+          "type": "string",
+          "title": "APIGroup holds the API group of the referenced subject.\nDefaults to \"\" for ServiceAccount subjects.\nDefaults to \"rbac.authorization.k8s.io\" for User and Group subjects.\n+optional"
+        },
+        "name": {
+          "type": "string",
+          "description": "Name of the object being referenced."
+        },
+        "namespace": {
+          "type": "string",
+          "title": "Namespace of the referenced object.  If the object kind is non-namespace, such as \"User\" or \"Group\", and this value is not empty\nthe Authorizer should report an error.\n+optional"
+        }
+      },
+      "description": "Subject contains a reference to the object or user identities a role binding applies to.  This can either hold a direct API object reference,\nor a value for non-objects such as user and group names."
+    },
+    "v1Time": {
+      "type": "string",
+      "format": "date-time"
+    }
+  }
+}
+`
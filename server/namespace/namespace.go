@@ -0,0 +1,205 @@
+package namespace
+
+import (
+	"context"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/internal/utils"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"github.com/keikoproj/manager/server/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+type namespaceService struct {
+	k8sClient *k8s.Client
+}
+
+func New(sClient *k8s.Client) *namespaceService {
+	return &namespaceService{
+		k8sClient: sClient,
+	}
+}
+
+//Create creates the managed namespace in the manager namespace
+func (n *namespaceService) Create(ctx context.Context, req *apis.ManagedNamespace) (*apis.ManagedNamespace, error) {
+	log := log.Logger(ctx, "server.namespace", "Create")
+	log.Info("Request received", "name", req.Name)
+
+	if req.Name == "" || req.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "name and spec are required")
+	}
+	cr := &v1alpha1.ManagedNamespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   req.Name,
+			Labels: req.Labels,
+		},
+		Spec: v1alpha1.ManagedNamespaceSpec{
+			Namespace: *req.Spec,
+		},
+	}
+	tracing.Inject(ctx, cr)
+	if err := n.k8sClient.CreateManagedNamespace(ctx, cr, common.ManagerDeployedNamespace); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr), nil
+}
+
+//Get returns the managed namespace along with the status
+func (n *namespaceService) Get(ctx context.Context, req *apis.GetNamespaceRequest) (*apis.ManagedNamespace, error) {
+	cr, err := n.k8sClient.GetManagedNamespace(ctx, req.Name, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr), nil
+}
+
+//List returns the managed namespaces matching the filters ordered by name
+func (n *namespaceService) List(ctx context.Context, req *apis.ListNamespacesRequest) (*apis.ListNamespacesResponse, error) {
+	log := log.Logger(ctx, "server.namespace", "List")
+
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	items, err := n.k8sClient.ListManagedNamespaces(ctx, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+
+	resp := &apis.ListNamespacesResponse{}
+	for i := range items {
+		mns := &items[i]
+		//Page token is the name of the last namespace returned in the previous page
+		if req.PageToken != "" && mns.Name <= req.PageToken {
+			continue
+		}
+		if !matches(mns, req) {
+			continue
+		}
+		if len(resp.Items) == pageSize {
+			resp.NextPageToken = resp.Items[len(resp.Items)-1].Name
+			break
+		}
+		resp.Items = append(resp.Items, ToProto(mns))
+	}
+	log.V(1).Info("Namespaces listed", "count", len(resp.Items))
+	return resp, nil
+}
+
+//Update updates the spec and labels of the managed namespace
+func (n *namespaceService) Update(ctx context.Context, req *apis.ManagedNamespace) (*apis.ManagedNamespace, error) {
+	log := log.Logger(ctx, "server.namespace", "Update")
+	log.Info("Request received", "name", req.Name)
+
+	if req.Name == "" || req.Spec == nil {
+		return nil, status.Error(codes.InvalidArgument, "name and spec are required")
+	}
+	cr, err := n.k8sClient.GetManagedNamespace(ctx, req.Name, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	//Resource version in the request makes sure the changes made in the meantime are not overwritten
+	if req.ResourceVersion != "" {
+		cr.SetResourceVersion(req.ResourceVersion)
+	}
+	cr.Labels = util.MergeLabels(cr.Labels, req.Labels)
+	cr.Spec.Namespace = *req.Spec
+	tracing.Inject(ctx, cr)
+	if err := n.k8sClient.UpdateManagedNamespace(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr), nil
+}
+
+//Delete deletes the managed namespace. Namespace in the clusters is deleted based on the deletion policy
+func (n *namespaceService) Delete(ctx context.Context, req *apis.DeleteNamespaceRequest) (*apis.DeleteNamespaceResponse, error) {
+	log := log.Logger(ctx, "server.namespace", "Delete")
+	log.Info("Request received", "name", req.Name)
+
+	if err := n.k8sClient.DeleteManagedNamespace(ctx, req.Name, common.ManagerDeployedNamespace); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return &apis.DeleteNamespaceResponse{}, nil
+}
+
+//Resync triggers the reconcile of the managed namespace by updating the resync annotation
+func (n *namespaceService) Resync(ctx context.Context, req *apis.ResyncNamespaceRequest) (*apis.ManagedNamespace, error) {
+	log := log.Logger(ctx, "server.namespace", "Resync")
+	log.Info("Request received", "name", req.Name)
+
+	cr, err := n.k8sClient.GetManagedNamespace(ctx, req.Name, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	if cr.Annotations == nil {
+		cr.Annotations = make(map[string]string)
+	}
+	cr.Annotations[common.ResyncAnnotation] = time.Now().UTC().Format(time.RFC3339Nano)
+	tracing.Inject(ctx, cr)
+	if err := n.k8sClient.UpdateManagedNamespace(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return ToProto(cr), nil
+}
+
+//matches returns true if the managed namespace matches all the filters in the request
+func matches(mns *v1alpha1.ManagedNamespace, req *apis.ListNamespacesRequest) bool {
+	if req.ClusterName != "" && !utils.ContainsString(mns.TargetClusterNames(), req.ClusterName) {
+		return false
+	}
+	if req.TemplateName != "" && mns.Spec.TemplateName != req.TemplateName {
+		return false
+	}
+	if req.State != "" && string(mns.Status.State) != req.State {
+		return false
+	}
+	return true
+}
+
+//ToProto converts the managed namespace custom resource to the api representation
+func ToProto(mns *v1alpha1.ManagedNamespace) *apis.ManagedNamespace {
+	spec := mns.Spec.Namespace
+	res := &apis.ManagedNamespace{
+		Name:              mns.Name,
+		Labels:            mns.Labels,
+		Spec:              &spec,
+		ResourceVersion:   mns.ResourceVersion,
+		CreationTimestamp: util.Timestamp(&mns.CreationTimestamp),
+		Status: &apis.ManagedNamespaceStatus{
+			State:            string(mns.Status.State),
+			RetryCount:       int32(mns.Status.RetryCount),
+			ErrorDescription: mns.Status.ErrorDescription,
+			ClusterName:      mns.Status.ClusterName,
+		},
+	}
+	for _, cs := range mns.Status.Clusters {
+		res.Status.Clusters = append(res.Status.Clusters, &apis.ClusterNamespaceStatus{
+			ClusterName:      cs.ClusterName,
+			Namespace:        cs.Namespace,
+			State:            string(cs.State),
+			ErrorDescription: cs.ErrorDescription,
+			LastSyncTime:     util.Timestamp(cs.LastSyncTime),
+		})
+	}
+	return res
+}
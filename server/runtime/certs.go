@@ -0,0 +1,147 @@
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+	"github.com/keikoproj/manager/pkg/log"
+	"io/ioutil"
+	"os"
+	"sync"
+	"time"
+)
+
+//CertReloader serves the latest serving certificate and client CA from the disk
+//Certificates mounted from the kubernetes secrets get rotated without restarting the server
+type CertReloader struct {
+	certFile     string
+	keyFile      string
+	clientCAFile string
+
+	mu        sync.RWMutex
+	cert      *tls.Certificate
+	clientCAs *x509.CertPool
+	modTimes  map[string]time.Time
+}
+
+//NewCertReloader loads the certificates and fails if they are not valid
+func NewCertReloader(certFile string, keyFile string, clientCAFile string) (*CertReloader, error) {
+	c := &CertReloader{
+		certFile:     certFile,
+		keyFile:      keyFile,
+		clientCAFile: clientCAFile,
+	}
+	if err := c.load(); err != nil {
+		return nil, err
+	}
+	return c, nil
+}
+
+//Run checks the files for the changes every interval until the context is done
+//Existing certificates are kept if the new files are not valid
+func (c *CertReloader) Run(ctx context.Context, interval time.Duration) {
+	log := log.Logger(ctx, "server.runtime", "CertReloader", "Run")
+
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+			if !c.changed() {
+				continue
+			}
+			if err := c.load(); err != nil {
+				log.Error(err, "unable to reload the certificates, continuing with the existing ones")
+				continue
+			}
+			log.Info("Certificates reloaded successfully")
+		}
+	}
+}
+
+//TLSConfig returns the TLS config which picks up the latest certificates for every new connection
+//nextProtos are the application protocols offered to the clients
+func (c *CertReloader) TLSConfig(nextProtos ...string) *tls.Config {
+	return &tls.Config{
+		MinVersion: tls.VersionTLS12,
+		GetConfigForClient: func(*tls.ClientHelloInfo) (*tls.Config, error) {
+			c.mu.RLock()
+			defer c.mu.RUnlock()
+			cfg := &tls.Config{
+				MinVersion:   tls.VersionTLS12,
+				Certificates: []tls.Certificate{*c.cert},
+				NextProtos:   nextProtos,
+			}
+			//Clients without certificate can still authenticate with the bearer token
+			if c.clientCAs != nil {
+				cfg.ClientCAs = c.clientCAs
+				cfg.ClientAuth = tls.VerifyClientCertIfGiven
+			}
+			return cfg, nil
+		},
+	}
+}
+
+func (c *CertReloader) load() error {
+	modTimes, err := c.stat()
+	if err != nil {
+		return err
+	}
+	cert, err := tls.LoadX509KeyPair(c.certFile, c.keyFile)
+	if err != nil {
+		return err
+	}
+	var pool *x509.CertPool
+	if c.clientCAFile != "" {
+		ca, err := ioutil.ReadFile(c.clientCAFile)
+		if err != nil {
+			return err
+		}
+		pool = x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(ca) {
+			return fmt.Errorf("unable to parse the client CA file %s", c.clientCAFile)
+		}
+	}
+
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.cert = &cert
+	c.clientCAs = pool
+	c.modTimes = modTimes
+	return nil
+}
+
+//changed returns true if any of the files got modified since the last load
+func (c *CertReloader) changed() bool {
+	modTimes, err := c.stat()
+	if err != nil {
+		//Files might be in the middle of the update, check again in the next interval
+		return false
+	}
+	c.mu.RLock()
+	defer c.mu.RUnlock()
+	for file, t := range modTimes {
+		if !t.Equal(c.modTimes[file]) {
+			return true
+		}
+	}
+	return false
+}
+
+func (c *CertReloader) stat() (map[string]time.Time, error) {
+	modTimes := make(map[string]time.Time)
+	for _, file := range []string{c.certFile, c.keyFile, c.clientCAFile} {
+		if file == "" {
+			continue
+		}
+		info, err := os.Stat(file)
+		if err != nil {
+			return nil, err
+		}
+		modTimes[file] = info.ModTime()
+	}
+	return modTimes, nil
+}
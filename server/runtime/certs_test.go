@@ -0,0 +1,157 @@
+package runtime_test
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/tls"
+	"crypto/x509"
+	"crypto/x509/pkix"
+	"encoding/pem"
+	"io/ioutil"
+	"math/big"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/keikoproj/manager/server/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+//writeCert writes the self signed certificate with the common name and returns the cert and key file paths
+func writeCert(dir string, commonName string) (string, string) {
+	key, err := rsa.GenerateKey(rand.Reader, 2048)
+	Expect(err).NotTo(HaveOccurred())
+	template := &x509.Certificate{
+		SerialNumber:          big.NewInt(time.Now().UnixNano()),
+		Subject:               pkix.Name{CommonName: commonName},
+		NotBefore:             time.Now().Add(-time.Hour),
+		NotAfter:              time.Now().Add(time.Hour),
+		IsCA:                  true,
+		BasicConstraintsValid: true,
+		KeyUsage:              x509.KeyUsageDigitalSignature | x509.KeyUsageCertSign,
+		ExtKeyUsage:           []x509.ExtKeyUsage{x509.ExtKeyUsageServerAuth},
+	}
+	der, err := x509.CreateCertificate(rand.Reader, template, template, &key.PublicKey, key)
+	Expect(err).NotTo(HaveOccurred())
+
+	certFile := filepath.Join(dir, "tls.crt")
+	keyFile := filepath.Join(dir, "tls.key")
+	Expect(ioutil.WriteFile(certFile, pem.EncodeToMemory(&pem.Block{Type: "CERTIFICATE", Bytes: der}), 0600)).To(Succeed())
+	Expect(ioutil.WriteFile(keyFile, pem.EncodeToMemory(&pem.Block{Type: "RSA PRIVATE KEY", Bytes: x509.MarshalPKCS1PrivateKey(key)}), 0600)).To(Succeed())
+	return certFile, keyFile
+}
+
+//servedCert returns the common name of the certificate served to the new connections
+func servedCert(reloader *runtime.CertReloader) (string, *tls.Config) {
+	cfg, err := reloader.TLSConfig("h2").GetConfigForClient(&tls.ClientHelloInfo{})
+	Expect(err).NotTo(HaveOccurred())
+	Expect(cfg.Certificates).To(HaveLen(1))
+	cert, err := x509.ParseCertificate(cfg.Certificates[0].Certificate[0])
+	Expect(err).NotTo(HaveOccurred())
+	return cert.Subject.CommonName, cfg
+}
+
+var _ = Describe("server.runtime.certs test cases", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "runtime-certs")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	Describe("NewCertReloader() test cases", func() {
+		Context("valid certificate without client CA", func() {
+			It("should serve the certificate without verifying the clients", func() {
+				certFile, keyFile := writeCert(dir, "server-1")
+				reloader, err := runtime.NewCertReloader(certFile, keyFile, "")
+				Expect(err).NotTo(HaveOccurred())
+				name, cfg := servedCert(reloader)
+				Expect(name).To(Equal("server-1"))
+				Expect(cfg.NextProtos).To(Equal([]string{"h2"}))
+				Expect(cfg.ClientAuth).To(Equal(tls.NoClientCert))
+			})
+		})
+
+		Context("valid certificate with client CA", func() {
+			It("should verify the client certificates if given", func() {
+				certFile, keyFile := writeCert(dir, "server-1")
+				reloader, err := runtime.NewCertReloader(certFile, keyFile, certFile)
+				Expect(err).NotTo(HaveOccurred())
+				_, cfg := servedCert(reloader)
+				Expect(cfg.ClientAuth).To(Equal(tls.VerifyClientCertIfGiven))
+				Expect(cfg.ClientCAs).NotTo(BeNil())
+			})
+		})
+
+		Context("client CA is not a certificate", func() {
+			It("should return error", func() {
+				certFile, keyFile := writeCert(dir, "server-1")
+				_, err := runtime.NewCertReloader(certFile, keyFile, keyFile)
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("certificate files don't exist", func() {
+			It("should return error", func() {
+				_, err := runtime.NewCertReloader(filepath.Join(dir, "tls.crt"), filepath.Join(dir, "tls.key"), "")
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Run() test cases", func() {
+		var (
+			ctx      context.Context
+			cancel   context.CancelFunc
+			reloader *runtime.CertReloader
+			keyFile  string
+		)
+
+		BeforeEach(func() {
+			var certFile string
+			certFile, keyFile = writeCert(dir, "server-1")
+			var err error
+			reloader, err = runtime.NewCertReloader(certFile, keyFile, "")
+			Expect(err).NotTo(HaveOccurred())
+			ctx, cancel = context.WithCancel(context.Background())
+			go reloader.Run(ctx, 10*time.Millisecond)
+		})
+
+		AfterEach(func() {
+			cancel()
+		})
+
+		Context("certificate is rotated on the disk", func() {
+			It("should serve the new certificate", func() {
+				certFile, keyFile := writeCert(dir, "server-2")
+				//Modification time must change even if the files are rewritten within the same second
+				modTime := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(certFile, modTime, modTime)).To(Succeed())
+				Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+				Eventually(func() string {
+					name, _ := servedCert(reloader)
+					return name
+				}).Should(Equal("server-2"))
+			})
+		})
+
+		Context("new files are not valid", func() {
+			It("should keep serving the existing certificate", func() {
+				Expect(ioutil.WriteFile(keyFile, []byte("not a key"), 0600)).To(Succeed())
+				modTime := time.Now().Add(time.Minute)
+				Expect(os.Chtimes(keyFile, modTime, modTime)).To(Succeed())
+				Consistently(func() string {
+					name, _ := servedCert(reloader)
+					return name
+				}, 100*time.Millisecond).Should(Equal("server-1"))
+			})
+		})
+	})
+})
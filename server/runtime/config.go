@@ -0,0 +1,177 @@
+package runtime
+
+import (
+	"fmt"
+	"io/ioutil"
+	"net"
+	"time"
+
+	"github.com/keikoproj/manager/pkg/audit"
+	"github.com/keikoproj/manager/pkg/tracing"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/yaml"
+)
+
+//Config contains the server runtime options
+type Config struct {
+	//BindAddress is the address server listens on. Default is :10000
+	BindAddress string `json:"bindAddress,omitempty"`
+	//TLS contains the serving certificate and the client CA used for mTLS
+	TLS TLSConfig `json:"tls,omitempty"`
+	//Auth contains the authentication options
+	Auth AuthConfig `json:"auth,omitempty"`
+	//Keepalive contains the keepalive options enforced on the connections
+	Keepalive KeepaliveConfig `json:"keepalive,omitempty"`
+	//Reflection registers the grpc reflection service. Default is true
+	Reflection *bool `json:"reflection,omitempty"`
+	//Audit contains the sinks the audit records are written to
+	Audit audit.Config `json:"audit,omitempty"`
+	//Tracing contains the span exporter options
+	Tracing tracing.Config `json:"tracing,omitempty"`
+	//Gateway contains the REST gateway options
+	Gateway GatewayConfig `json:"gateway,omitempty"`
+	//MetricsBindAddress is the address prometheus metrics are served on. Default is :9090. Set to 0 to disable
+	MetricsBindAddress string `json:"metricsBindAddress,omitempty"`
+	//ShutdownTimeout is the time given to the in-flight rpcs to complete on SIGTERM. Default is 30s
+	ShutdownTimeout metav1.Duration `json:"shutdownTimeout,omitempty"`
+}
+
+//TLSConfig contains the certificate files. Files are reloaded when they change on the disk
+type TLSConfig struct {
+	Enabled  bool   `json:"enabled,omitempty"`
+	CertFile string `json:"certFile,omitempty"`
+	KeyFile  string `json:"keyFile,omitempty"`
+	//ClientCAFile is used to verify the client certificates. Enables mTLS authentication
+	ClientCAFile string `json:"clientCAFile,omitempty"`
+	//ReloadInterval is how often the files are checked for the changes. Default is 30s
+	ReloadInterval metav1.Duration `json:"reloadInterval,omitempty"`
+}
+
+//AuthConfig contains the authentication options
+type AuthConfig struct {
+	//Enabled authenticates and authorizes every request. Default is true
+	Enabled *bool `json:"enabled,omitempty"`
+}
+
+//GatewayConfig contains the REST gateway options. Gateway uses the same certificates as the grpc server
+//REST callers authenticate with the bearer token. Client certificates are accepted by the grpc server only
+type GatewayConfig struct {
+	//Enabled serves the REST APIs and the OpenAPI document. Default is true
+	Enabled *bool `json:"enabled,omitempty"`
+	//BindAddress is the address gateway listens on. Default is :8080
+	BindAddress string `json:"bindAddress,omitempty"`
+}
+
+//KeepaliveConfig contains the keepalive options
+type KeepaliveConfig struct {
+	//Time after which server pings the idle client. Default is 2m
+	Time metav1.Duration `json:"time,omitempty"`
+	//Timeout to wait for the ping ack before closing the connection. Default is 20s
+	Timeout metav1.Duration `json:"timeout,omitempty"`
+	//MinTime is the minimum interval between the client pings. Default is 30s
+	MinTime metav1.Duration `json:"minTime,omitempty"`
+	//PermitWithoutStream allows the client pings without active streams. Default is true
+	PermitWithoutStream *bool `json:"permitWithoutStream,omitempty"`
+	//MaxConnectionIdle closes the connections idle for this long. Default is infinity
+	MaxConnectionIdle metav1.Duration `json:"maxConnectionIdle,omitempty"`
+	//MaxConnectionAge closes the connections older than this. Default is infinity
+	MaxConnectionAge metav1.Duration `json:"maxConnectionAge,omitempty"`
+	//MaxConnectionAgeGrace is the time given to the rpcs to complete after max connection age. Default is infinity
+	MaxConnectionAgeGrace metav1.Duration `json:"maxConnectionAgeGrace,omitempty"`
+}
+
+//LoadConfig reads the config file and fills in the defaults. Only the defaults are returned if the path is empty
+func LoadConfig(path string) (*Config, error) {
+	cfg := &Config{}
+	if path != "" {
+		b, err := ioutil.ReadFile(path)
+		if err != nil {
+			return nil, err
+		}
+		if err := yaml.UnmarshalStrict(b, cfg); err != nil {
+			return nil, fmt.Errorf("unable to parse the config file %s: %v", path, err)
+		}
+	}
+	cfg.setDefaults()
+	return cfg, nil
+}
+
+//Validate validates the config
+func (c *Config) Validate() error {
+	if c.TLS.Enabled && (c.TLS.CertFile == "" || c.TLS.KeyFile == "") {
+		return fmt.Errorf("cert and key files are required when TLS is enabled")
+	}
+	if !c.TLS.Enabled && c.TLS.ClientCAFile != "" {
+		return fmt.Errorf("TLS must be enabled to verify the client certificates")
+	}
+	if c.GatewayEnabled() && c.Gateway.BindAddress == c.BindAddress {
+		return fmt.Errorf("gateway and grpc server can not listen on the same address %s", c.BindAddress)
+	}
+	if c.MetricsEnabled() && (c.MetricsBindAddress == c.BindAddress || (c.GatewayEnabled() && c.MetricsBindAddress == c.Gateway.BindAddress)) {
+		return fmt.Errorf("metrics can not be served on the grpc server or gateway address %s", c.MetricsBindAddress)
+	}
+	return nil
+}
+
+//ReflectionEnabled returns true if the reflection service to be registered
+func (c *Config) ReflectionEnabled() bool {
+	return c.Reflection == nil || *c.Reflection
+}
+
+//AuthEnabled returns true if the requests to be authenticated
+func (c *Config) AuthEnabled() bool {
+	return c.Auth.Enabled == nil || *c.Auth.Enabled
+}
+
+//GatewayEnabled returns true if the REST gateway to be served
+func (c *Config) GatewayEnabled() bool {
+	return c.Gateway.Enabled == nil || *c.Gateway.Enabled
+}
+
+//MetricsEnabled returns true if the metrics to be served
+func (c *Config) MetricsEnabled() bool {
+	return c.MetricsBindAddress != "0"
+}
+
+//LocalEndpoint returns the address to connect to the grpc server from the same host
+func (c *Config) LocalEndpoint() string {
+	host, port, err := net.SplitHostPort(c.BindAddress)
+	if err != nil {
+		return c.BindAddress
+	}
+	if host == "" || host == "0.0.0.0" || host == "::" {
+		host = "localhost"
+	}
+	return net.JoinHostPort(host, port)
+}
+
+func (c *Config) setDefaults() {
+	if c.BindAddress == "" {
+		c.BindAddress = ":10000"
+	}
+	if c.Gateway.BindAddress == "" {
+		c.Gateway.BindAddress = ":8080"
+	}
+	if c.MetricsBindAddress == "" {
+		c.MetricsBindAddress = ":9090"
+	}
+	if c.ShutdownTimeout.Duration == 0 {
+		c.ShutdownTimeout.Duration = 30 * time.Second
+	}
+	if c.TLS.ReloadInterval.Duration == 0 {
+		c.TLS.ReloadInterval.Duration = 30 * time.Second
+	}
+	if c.Keepalive.Time.Duration == 0 {
+		c.Keepalive.Time.Duration = 2 * time.Minute
+	}
+	if c.Keepalive.Timeout.Duration == 0 {
+		c.Keepalive.Timeout.Duration = 20 * time.Second
+	}
+	if c.Keepalive.MinTime.Duration == 0 {
+		c.Keepalive.MinTime.Duration = 30 * time.Second
+	}
+	if c.Keepalive.PermitWithoutStream == nil {
+		permit := true
+		c.Keepalive.PermitWithoutStream = &permit
+	}
+}
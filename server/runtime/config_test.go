@@ -0,0 +1,147 @@
+package runtime_test
+
+import (
+	"io/ioutil"
+	"os"
+	"path/filepath"
+	"time"
+
+	"github.com/keikoproj/manager/server/runtime"
+	. "github.com/onsi/ginkgo"
+	. "github.com/onsi/gomega"
+)
+
+var _ = Describe("server.runtime.config test cases", func() {
+	var dir string
+
+	BeforeEach(func() {
+		var err error
+		dir, err = ioutil.TempDir("", "runtime-config")
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	AfterEach(func() {
+		os.RemoveAll(dir)
+	})
+
+	writeConfig := func(content string) string {
+		path := filepath.Join(dir, "server.yaml")
+		Expect(ioutil.WriteFile(path, []byte(content), 0600)).To(Succeed())
+		return path
+	}
+
+	Describe("LoadConfig() test cases", func() {
+		Context("without the config file", func() {
+			It("should return the defaults", func() {
+				cfg, err := runtime.LoadConfig("")
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.BindAddress).To(Equal(":10000"))
+				Expect(cfg.Gateway.BindAddress).To(Equal(":8080"))
+				Expect(cfg.MetricsBindAddress).To(Equal(":9090"))
+				Expect(cfg.ShutdownTimeout.Duration).To(Equal(30 * time.Second))
+				Expect(cfg.TLS.ReloadInterval.Duration).To(Equal(30 * time.Second))
+				Expect(cfg.Keepalive.Time.Duration).To(Equal(2 * time.Minute))
+				Expect(*cfg.Keepalive.PermitWithoutStream).To(BeTrue())
+				Expect(cfg.AuthEnabled()).To(BeTrue())
+				Expect(cfg.GatewayEnabled()).To(BeTrue())
+				Expect(cfg.ReflectionEnabled()).To(BeTrue())
+				Expect(cfg.MetricsEnabled()).To(BeTrue())
+				Expect(cfg.Validate()).To(Succeed())
+			})
+		})
+
+		Context("config file overrides the defaults", func() {
+			It("should keep the values from the file", func() {
+				cfg, err := runtime.LoadConfig(writeConfig(`
+bindAddress: ":11000"
+auth:
+  enabled: false
+gateway:
+  enabled: false
+metricsBindAddress: "0"
+shutdownTimeout: 5s
+`))
+				Expect(err).NotTo(HaveOccurred())
+				Expect(cfg.BindAddress).To(Equal(":11000"))
+				Expect(cfg.ShutdownTimeout.Duration).To(Equal(5 * time.Second))
+				Expect(cfg.AuthEnabled()).To(BeFalse())
+				Expect(cfg.GatewayEnabled()).To(BeFalse())
+				Expect(cfg.MetricsEnabled()).To(BeFalse())
+			})
+		})
+
+		Context("config file with unknown fields", func() {
+			It("should return error", func() {
+				_, err := runtime.LoadConfig(writeConfig("bindAdress: \":11000\"\n"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+
+		Context("config file doesn't exist", func() {
+			It("should return error", func() {
+				_, err := runtime.LoadConfig(filepath.Join(dir, "missing.yaml"))
+				Expect(err).To(HaveOccurred())
+			})
+		})
+	})
+
+	Describe("Validate() test cases", func() {
+		var cfg *runtime.Config
+
+		BeforeEach(func() {
+			var err error
+			cfg, err = runtime.LoadConfig("")
+			Expect(err).NotTo(HaveOccurred())
+		})
+
+		Context("TLS enabled without the certificate", func() {
+			It("should return error", func() {
+				cfg.TLS.Enabled = true
+				cfg.TLS.CertFile = "tls.crt"
+				Expect(cfg.Validate()).NotTo(Succeed())
+			})
+		})
+
+		Context("client CA without TLS", func() {
+			It("should return error", func() {
+				cfg.TLS.ClientCAFile = "ca.crt"
+				Expect(cfg.Validate()).NotTo(Succeed())
+			})
+		})
+
+		Context("gateway on the grpc address", func() {
+			It("should return error", func() {
+				cfg.Gateway.BindAddress = cfg.BindAddress
+				Expect(cfg.Validate()).NotTo(Succeed())
+			})
+			It("should be allowed if the gateway is disabled", func() {
+				disabled := false
+				cfg.Gateway.Enabled = &disabled
+				cfg.Gateway.BindAddress = cfg.BindAddress
+				Expect(cfg.Validate()).To(Succeed())
+			})
+		})
+
+		Context("metrics on the gateway address", func() {
+			It("should return error", func() {
+				cfg.MetricsBindAddress = cfg.Gateway.BindAddress
+				Expect(cfg.Validate()).NotTo(Succeed())
+			})
+		})
+	})
+
+	Describe("LocalEndpoint() test cases", func() {
+		It("should connect to the loopback for the wildcard addresses", func() {
+			for bind, endpoint := range map[string]string{
+				":10000":          "localhost:10000",
+				"0.0.0.0:10000":   "localhost:10000",
+				"[::]:10000":      "localhost:10000",
+				"10.0.0.1:10000":  "10.0.0.1:10000",
+				"invalid-address": "invalid-address",
+			} {
+				cfg := &runtime.Config{BindAddress: bind}
+				Expect(cfg.LocalEndpoint()).To(Equal(endpoint), bind)
+			}
+		})
+	})
+})
@@ -0,0 +1,174 @@
+package runtime
+
+import (
+	"context"
+	"crypto/tls"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/health"
+	"google.golang.org/grpc/keepalive"
+	"google.golang.org/grpc/reflection"
+	"net"
+	"net/http"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
+)
+
+//Server is the grpc server with health, reflection, keepalive, certificate reload and graceful shutdown
+//Services must be registered on the embedded grpc server before Run
+type Server struct {
+	*grpc.Server
+	//Health reports NOT_SERVING until the caller marks the server ready
+	Health *health.Server
+	//Gateway is the http handler served on the gateway address if the gateway is enabled
+	Gateway http.Handler
+
+	config *Config
+	certs  *CertReloader
+}
+
+//NewServer returns the server based on the config. Options are appended to the options derived from the config
+func NewServer(cfg *Config, opts ...grpc.ServerOption) (*Server, error) {
+	if err := cfg.Validate(); err != nil {
+		return nil, err
+	}
+	s := &Server{
+		Health: health.NewServer(),
+		config: cfg,
+	}
+
+	serverOpts := []grpc.ServerOption{
+		grpc.KeepaliveParams(keepalive.ServerParameters{
+			Time:                  cfg.Keepalive.Time.Duration,
+			Timeout:               cfg.Keepalive.Timeout.Duration,
+			MaxConnectionIdle:     cfg.Keepalive.MaxConnectionIdle.Duration,
+			MaxConnectionAge:      cfg.Keepalive.MaxConnectionAge.Duration,
+			MaxConnectionAgeGrace: cfg.Keepalive.MaxConnectionAgeGrace.Duration,
+		}),
+		grpc.KeepaliveEnforcementPolicy(keepalive.EnforcementPolicy{
+			MinTime:             cfg.Keepalive.MinTime.Duration,
+			PermitWithoutStream: *cfg.Keepalive.PermitWithoutStream,
+		}),
+	}
+	if cfg.TLS.Enabled {
+		certs, err := NewCertReloader(cfg.TLS.CertFile, cfg.TLS.KeyFile, cfg.TLS.ClientCAFile)
+		if err != nil {
+			return nil, err
+		}
+		s.certs = certs
+		serverOpts = append(serverOpts, grpc.Creds(credentials.NewTLS(certs.TLSConfig("h2"))))
+	}
+	s.Server = grpc.NewServer(append(serverOpts, opts...)...)
+
+	healthpb.RegisterHealthServer(s.Server, s.Health)
+	s.Health.SetServingStatus("", healthpb.HealthCheckResponse_NOT_SERVING)
+	if cfg.ReflectionEnabled() {
+		reflection.Register(s.Server)
+	}
+	return s, nil
+}
+
+//DialLocal returns the connection to the server from the same process. Used by the gateway to proxy the REST requests
+//Gateway supports the bearer tokens only. Authorization header is forwarded to the server but the client certificate
+//verified by the gateway is not, since every proxied request arrives on this connection with no caller identity of its own
+func (s *Server) DialLocal(ctx context.Context) (*grpc.ClientConn, error) {
+	opts := []grpc.DialOption{grpc.WithInsecure()}
+	if s.certs != nil {
+		//Server certificate is not issued for the loopback address. Connection never leaves the host
+		opts = []grpc.DialOption{grpc.WithTransportCredentials(credentials.NewTLS(&tls.Config{InsecureSkipVerify: true}))}
+	}
+	return grpc.DialContext(ctx, s.config.LocalEndpoint(), opts...)
+}
+
+//Run serves the requests until the context is done and then drains the in-flight rpcs
+//Rpcs still running after the shutdown timeout are cancelled
+func (s *Server) Run(ctx context.Context) error {
+	log := log.Logger(ctx, "server.runtime", "Server", "Run")
+
+	lis, err := net.Listen("tcp", s.config.BindAddress)
+	if err != nil {
+		log.Error(err, "failed to listen", "address", s.config.BindAddress)
+		return err
+	}
+	if s.certs != nil {
+		go s.certs.Run(ctx, s.config.TLS.ReloadInterval.Duration)
+	}
+
+	errCh := make(chan error, 3)
+	go func() {
+		errCh <- s.Serve(lis)
+	}()
+	log.Info("Server is up and running", "address", s.config.BindAddress)
+
+	var gw *http.Server
+	if s.Gateway != nil && s.config.GatewayEnabled() {
+		gw = &http.Server{Addr: s.config.Gateway.BindAddress, Handler: s.Gateway}
+		go func() {
+			var err error
+			if s.certs != nil {
+				gw.TLSConfig = s.certs.TLSConfig("h2", "http/1.1")
+				err = gw.ListenAndServeTLS("", "")
+			} else {
+				err = gw.ListenAndServe()
+			}
+			if err != http.ErrServerClosed {
+				errCh <- err
+			}
+		}()
+		log.Info("Gateway is up and running", "address", s.config.Gateway.BindAddress)
+	}
+
+	if s.config.MetricsEnabled() {
+		//Metrics are served until the server is drained
+		ms := &http.Server{Addr: s.config.MetricsBindAddress, Handler: metrics.Handler()}
+		defer ms.Close()
+		go func() {
+			if err := ms.ListenAndServe(); err != http.ErrServerClosed {
+				errCh <- err
+			}
+		}()
+		log.Info("Metrics are served", "address", s.config.MetricsBindAddress)
+	}
+
+	select {
+	case err := <-errCh:
+		return err
+	case <-ctx.Done():
+	}
+
+	log.Info("Shutting down the server", "timeout", s.config.ShutdownTimeout.Duration)
+	//Load balancers and clients watching the health stop sending new requests
+	s.Health.Shutdown()
+	shutdownCtx, cancel := context.WithTimeout(context.Background(), s.config.ShutdownTimeout.Duration)
+	defer cancel()
+	stopped := make(chan struct{})
+	go func() {
+		s.GracefulStop()
+		close(stopped)
+	}()
+	gwStopped := make(chan struct{})
+	go func() {
+		//Gateway requests are proxied to the grpc server, so both are drained together
+		if gw != nil {
+			gw.Shutdown(shutdownCtx)
+		}
+		close(gwStopped)
+	}()
+	select {
+	case <-stopped:
+		log.Info("All the in-flight requests completed")
+	case <-shutdownCtx.Done():
+		log.Info("Shutdown timeout reached, cancelling the remaining requests")
+		s.Stop()
+	}
+	if gw != nil {
+		select {
+		case <-gwStopped:
+		case <-shutdownCtx.Done():
+			gw.Close()
+		}
+	}
+	return nil
+}
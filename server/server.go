@@ -4,22 +4,41 @@ import (
 	"context"
 	"flag"
 	"fmt"
+	"github.com/keikoproj/manager/pkg/audit"
 	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/metrics"
+	"github.com/keikoproj/manager/pkg/tracing"
 
 	"github.com/keikoproj/manager/pkg/k8s"
 	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/server/agent"
+	"github.com/keikoproj/manager/server/application"
+	"github.com/keikoproj/manager/server/auth"
 	"github.com/keikoproj/manager/server/cluster"
+	"github.com/keikoproj/manager/server/gateway"
+	"github.com/keikoproj/manager/server/namespace"
+	"github.com/keikoproj/manager/server/runtime"
+	"github.com/keikoproj/manager/server/template"
+	"github.com/keikoproj/manager/server/watch"
 	"google.golang.org/grpc"
-	"google.golang.org/grpc/credentials"
-	"google.golang.org/grpc/testdata"
-	"net"
+	"os"
+	"os/signal"
+	"syscall"
+
+	healthpb "google.golang.org/grpc/health/grpc_health_v1"
 )
 
+//Flags take precedence over the values in the config file
 var (
-	tls      = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
-	certFile = flag.String("cert_file", "", "The TLS cert file")
-	keyFile  = flag.String("key_file", "", "The TLS key file")
-	port     = flag.Int("port", 10000, "The server port")
+	configFile  = flag.String("config", "", "The server config file")
+	tls         = flag.Bool("tls", false, "Connection uses TLS if true, else plain TCP")
+	certFile    = flag.String("cert_file", "", "The TLS cert file")
+	keyFile     = flag.String("key_file", "", "The TLS key file")
+	bindAddress = flag.String("bind_address", "", "The address server listens on. Default is :10000")
+	port        = flag.Int("port", 10000, "The server port. Deprecated: use bind_address")
+	clientCA    = flag.String("client_ca_file", "", "The CA cert file to verify the client certificates. Enables mTLS authentication")
+	enableAuth  = flag.Bool("auth", true, "Authenticate and authorize every request using mTLS client identity or kubernetes bearer token")
+	gatewayAddr = flag.String("gateway_bind_address", "", "The address REST gateway listens on. Default is :8080")
 )
 
 func main() {
@@ -27,30 +46,143 @@ func main() {
 	log := log.Logger(context.Background(), "main")
 
 	flag.Parse()
-	lis, err := net.Listen("tcp", fmt.Sprintf("localhost:%d", *port))
+	cfg, err := runtime.LoadConfig(*configFile)
+	if err != nil {
+		log.Error(err, "unable to load the config")
+		os.Exit(1)
+	}
+	applyFlags(cfg)
+	if err := cfg.Validate(); err != nil {
+		log.Error(err, "invalid config")
+		os.Exit(1)
+	}
+
+	//Lets get k8s client here
+	sClient := k8s.NewK8sSelfClientDoOrDie()
+	auditor, err := audit.NewFromConfig(cfg.Audit)
+	if err != nil {
+		log.Error(err, "unable to create the audit sinks")
+		os.Exit(1)
+	}
+	defer auditor.Close()
+	audit.SetDefault(auditor)
+
+	shutdownTracing, err := tracing.Setup(context.Background(), "manager-server", cfg.Tracing)
+	if err != nil {
+		log.Error(err, "unable to set up the tracing")
+		os.Exit(1)
+	}
+	defer shutdownTracing(context.Background())
+
+	//Tracing is the outermost so that the spans cover the time spent in the other interceptors
+	//Audit interceptor is outside the authentication so that the rejected requests are recorded too
+	auditInterceptor := &audit.Interceptor{Kind: auth.MutatedKind}
+	unary := []grpc.UnaryServerInterceptor{tracing.UnaryServerInterceptor(), metrics.UnaryServerInterceptor(), auditInterceptor.Unary()}
+	stream := []grpc.StreamServerInterceptor{tracing.StreamServerInterceptor(), metrics.StreamServerInterceptor(), auditInterceptor.Stream()}
+	if cfg.AuthEnabled() {
+		interceptor := &auth.Interceptor{
+			Authenticator: auth.Chain(auth.TLSAuthenticator{}, auth.TokenReviewAuthenticator{Client: sClient.ClientInterface()}),
+			Authorizer:    auth.SubjectAccessReviewAuthorizer{Client: sClient.ClientInterface()},
+			//Agents authenticate with the agent token issued during the cluster registration
+			//Health and reflection are used by the probes and tools without credentials
+			SkipPrefixes: []string{"/apis.AgentService/", "/grpc.health.v1.Health/", "/grpc.reflection.v1alpha.ServerReflection/"},
+		}
+		unary = append(unary, interceptor.Unary())
+		stream = append(stream, interceptor.Stream())
+	} else {
+		log.Info("Authentication is disabled. Anyone who can reach the server can manage the clusters")
+	}
+	server, err := runtime.NewServer(cfg, grpc.UnaryInterceptor(runtime.ChainUnaryInterceptors(unary...)), grpc.StreamInterceptor(runtime.ChainStreamInterceptors(stream...)))
+	if err != nil {
+		log.Error(err, "unable to create the server")
+		os.Exit(1)
+	}
+
+	apis.RegisterClusterServiceServer(server.Server, cluster.New(sClient))
+	apis.RegisterAgentServiceServer(server.Server, agent.New(sClient))
+	apis.RegisterNamespaceServiceServer(server.Server, namespace.New(sClient))
+	apis.RegisterApplicationServiceServer(server.Server, application.New(sClient))
+	apis.RegisterTemplateServiceServer(server.Server, template.New(sClient))
+
+	ctx, cancel := context.WithCancel(context.Background())
+	sigs := make(chan os.Signal, 1)
+	signal.Notify(sigs, syscall.SIGINT, syscall.SIGTERM)
+	go func() {
+		<-sigs
+		cancel()
+	}()
+
+	//Watch service is backed by the informer cache
+	informers, err := k8s.NewK8sSelfCache()
 	if err != nil {
-		log.Error(err, "failed to listen")
+		log.Error(err, "unable to create the informer cache")
+		os.Exit(1)
 	}
-	var opts []grpc.ServerOption
-	if *tls {
-		if *certFile == "" {
-			*certFile = testdata.Path("server1.pem")
+	watchService, err := watch.New(ctx, informers)
+	if err != nil {
+		log.Error(err, "unable to create the watch service")
+		os.Exit(1)
+	}
+	apis.RegisterWatchServiceServer(server.Server, watchService)
+	go func() {
+		if err := informers.Start(ctx.Done()); err != nil {
+			log.Error(err, "unable to start the informer cache")
+			os.Exit(1)
 		}
-		if *keyFile == "" {
-			*keyFile = testdata.Path("server1.key")
+	}()
+	if !informers.WaitForCacheSync(ctx.Done()) {
+		log.Error(nil, "unable to sync the informer cache")
+		os.Exit(1)
+	}
+	if cfg.GatewayEnabled() {
+		conn, err := server.DialLocal(ctx)
+		if err != nil {
+			log.Error(err, "unable to connect the gateway to the server")
+			os.Exit(1)
 		}
-		creds, err := credentials.NewServerTLSFromFile(*certFile, *keyFile)
+		defer conn.Close()
+		server.Gateway, err = gateway.New(ctx, conn)
 		if err != nil {
-			log.Error(err, "Failed to generate credentials")
+			log.Error(err, "unable to create the gateway")
+			os.Exit(1)
 		}
-		opts = []grpc.ServerOption{grpc.Creds(creds)}
 	}
-	grpcServer := grpc.NewServer(opts...)
+	server.Health.SetServingStatus("", healthpb.HealthCheckResponse_SERVING)
 
-	//Lets get k8s client here
-	sClient := k8s.NewK8sSelfClientDoOrDie()
-	apis.RegisterClusterServiceServer(grpcServer, cluster.New(sClient))
-	log.Info("Server is up and running")
-	grpcServer.Serve(lis)
+	if err := server.Run(ctx); err != nil {
+		log.Error(err, "server stopped unexpectedly")
+		os.Exit(1)
+	}
+}
 
+//applyFlags overrides the config with the flags set explicitly
+func applyFlags(cfg *runtime.Config) {
+	set := make(map[string]bool)
+	flag.Visit(func(f *flag.Flag) {
+		set[f.Name] = true
+	})
+	if set["port"] {
+		cfg.BindAddress = fmt.Sprintf(":%d", *port)
+	}
+	if set["bind_address"] {
+		cfg.BindAddress = *bindAddress
+	}
+	if set["tls"] {
+		cfg.TLS.Enabled = *tls
+	}
+	if set["cert_file"] {
+		cfg.TLS.CertFile = *certFile
+	}
+	if set["key_file"] {
+		cfg.TLS.KeyFile = *keyFile
+	}
+	if set["client_ca_file"] {
+		cfg.TLS.ClientCAFile = *clientCA
+	}
+	if set["auth"] {
+		cfg.Auth.Enabled = enableAuth
+	}
+	if set["gateway_bind_address"] {
+		cfg.Gateway.BindAddress = *gatewayAddr
+	}
 }
@@ -0,0 +1,245 @@
+package template
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/k8s"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/tracing"
+	"github.com/keikoproj/manager/pkg/validation"
+	"github.com/keikoproj/manager/server/util"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"sort"
+
+	pb "github.com/keikoproj/manager/pkg/grpc/proto/namespace"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const (
+	defaultPageSize = 100
+	maxPageSize     = 500
+)
+
+type templateService struct {
+	k8sClient *k8s.Client
+}
+
+func New(sClient *k8s.Client) *templateService {
+	return &templateService{
+		k8sClient: sClient,
+	}
+}
+
+//Create creates the namespace template
+func (t *templateService) Create(ctx context.Context, req *apis.Template) (*apis.Template, error) {
+	log := log.Logger(ctx, "server.template", "Create")
+	log.Info("Request received", "name", req.Name)
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+	cr := &v1alpha1.NamespaceTemplate{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   req.Name,
+			Labels: req.Labels,
+		},
+		Spec: v1alpha1.NamespaceTemplateSpec{
+			NamespaceTemplate: *req.Spec,
+		},
+	}
+	tracing.Inject(ctx, cr)
+	if err := t.k8sClient.CreateNamespaceTemplate(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return toProto(cr), nil
+}
+
+//Get returns the namespace template
+func (t *templateService) Get(ctx context.Context, req *apis.GetTemplateRequest) (*apis.Template, error) {
+	cr, err := t.k8sClient.GetNamespaceTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return toProto(cr), nil
+}
+
+//List returns the namespace templates ordered by name
+func (t *templateService) List(ctx context.Context, req *apis.ListTemplatesRequest) (*apis.ListTemplatesResponse, error) {
+	pageSize := int(req.PageSize)
+	if pageSize <= 0 {
+		pageSize = defaultPageSize
+	}
+	if pageSize > maxPageSize {
+		pageSize = maxPageSize
+	}
+
+	items, err := t.listTemplates(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &apis.ListTemplatesResponse{}
+	for i := range items {
+		//Page token is the name of the last template returned in the previous page
+		if req.PageToken != "" && items[i].Name <= req.PageToken {
+			continue
+		}
+		if len(resp.Items) == pageSize {
+			resp.NextPageToken = resp.Items[len(resp.Items)-1].Name
+			break
+		}
+		resp.Items = append(resp.Items, toProto(&items[i]))
+	}
+	return resp, nil
+}
+
+//Update updates the spec and labels of the namespace template
+func (t *templateService) Update(ctx context.Context, req *apis.Template) (*apis.Template, error) {
+	log := log.Logger(ctx, "server.template", "Update")
+	log.Info("Request received", "name", req.Name)
+
+	if err := validate(ctx, req); err != nil {
+		return nil, err
+	}
+	cr, err := t.k8sClient.GetNamespaceTemplate(ctx, req.Name)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	//Resource version in the request makes sure the changes made in the meantime are not overwritten
+	if req.ResourceVersion != "" {
+		cr.SetResourceVersion(req.ResourceVersion)
+	}
+	cr.Labels = req.Labels
+	cr.Spec.NamespaceTemplate = *req.Spec
+	tracing.Inject(ctx, cr)
+	if err := t.k8sClient.UpdateNamespaceTemplate(ctx, cr); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return toProto(cr), nil
+}
+
+//Delete deletes the namespace template if it is not used by any managed namespace
+func (t *templateService) Delete(ctx context.Context, req *apis.DeleteTemplateRequest) (*apis.DeleteTemplateResponse, error) {
+	log := log.Logger(ctx, "server.template", "Delete")
+	log.Info("Request received", "name", req.Name)
+
+	usage, err := t.usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	if len(usage[req.Name]) > 0 {
+		return nil, status.Error(codes.FailedPrecondition, fmt.Sprintf("template %s is used by managed namespaces %v", req.Name, usage[req.Name]))
+	}
+	if err := t.k8sClient.DeleteNamespaceTemplate(ctx, req.Name); err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	return &apis.DeleteTemplateResponse{}, nil
+}
+
+//Catalog returns the description, param documentation and the usage of the templates
+func (t *templateService) Catalog(ctx context.Context, req *apis.CatalogRequest) (*apis.CatalogResponse, error) {
+	var items []v1alpha1.NamespaceTemplate
+	if req.Name != "" {
+		cr, err := t.k8sClient.GetNamespaceTemplate(ctx, req.Name)
+		if err != nil {
+			return nil, util.ToGRPCError(err)
+		}
+		items = append(items, *cr)
+	} else {
+		list, err := t.listTemplates(ctx)
+		if err != nil {
+			return nil, err
+		}
+		items = list
+	}
+
+	usage, err := t.usage(ctx)
+	if err != nil {
+		return nil, err
+	}
+	resp := &apis.CatalogResponse{}
+	for i := range items {
+		resp.Entries = append(resp.Entries, &apis.CatalogEntry{
+			Name:        items[i].Name,
+			Description: items[i].Spec.Description,
+			Params:      params(&items[i]),
+			Namespaces:  usage[items[i].Name],
+		})
+	}
+	return resp, nil
+}
+
+func (t *templateService) listTemplates(ctx context.Context) ([]v1alpha1.NamespaceTemplate, error) {
+	items, err := t.k8sClient.ListNamespaceTemplates(ctx)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	sort.Slice(items, func(i, j int) bool {
+		return items[i].Name < items[j].Name
+	})
+	return items, nil
+}
+
+//usage returns the names of the managed namespaces for each template
+func (t *templateService) usage(ctx context.Context) (map[string][]string, error) {
+	list, err := t.k8sClient.ListManagedNamespaces(ctx, common.ManagerDeployedNamespace)
+	if err != nil {
+		return nil, util.ToGRPCError(err)
+	}
+	usage := make(map[string][]string)
+	for _, mns := range list {
+		if mns.Spec.TemplateName != "" {
+			usage[mns.Spec.TemplateName] = append(usage[mns.Spec.TemplateName], mns.Name)
+		}
+	}
+	for _, names := range usage {
+		sort.Strings(names)
+	}
+	return usage, nil
+}
+
+//params returns the documentation of all the exported params in the template
+func params(cr *v1alpha1.NamespaceTemplate) []*pb.Param {
+	documented := make(map[string]*pb.Param)
+	for _, p := range cr.Spec.Params {
+		documented[p.Name] = p
+	}
+	var res []*pb.Param
+	for _, name := range cr.Spec.ExportedParamName {
+		if p, ok := documented[name]; ok {
+			res = append(res, p)
+			continue
+		}
+		res = append(res, &pb.Param{Name: name})
+	}
+	return res
+}
+
+func validate(ctx context.Context, req *apis.Template) error {
+	if req.Name == "" || req.Spec == nil || req.Spec.NsResources == nil || req.Spec.NsResources.Namespace == nil {
+		return status.Error(codes.InvalidArgument, "name and spec with the namespace resource are required")
+	}
+	if err := validation.ValidateTemplate(ctx, req.Spec.NsResources); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	if err := validation.ValidateParams(ctx, req.Spec); err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	return nil
+}
+
+//toProto converts the namespace template custom resource to the api representation
+func toProto(cr *v1alpha1.NamespaceTemplate) *apis.Template {
+	spec := cr.Spec.NamespaceTemplate
+	res := &apis.Template{
+		Name:              cr.Name,
+		Labels:            cr.Labels,
+		Spec:              &spec,
+		ResourceVersion:   cr.ResourceVersion,
+		CreationTimestamp: util.Timestamp(&cr.CreationTimestamp),
+	}
+	return res
+}
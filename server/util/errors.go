@@ -0,0 +1,31 @@
+package util
+
+import (
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+
+	apierrs "k8s.io/apimachinery/pkg/api/errors"
+)
+
+//ToGRPCError converts the kubernetes api errors to the grpc errors with the matching code
+func ToGRPCError(err error) error {
+	if err == nil {
+		return nil
+	}
+	if _, ok := status.FromError(err); ok {
+		return err
+	}
+	switch {
+	case apierrs.IsNotFound(err):
+		return status.Error(codes.NotFound, err.Error())
+	case apierrs.IsAlreadyExists(err):
+		return status.Error(codes.AlreadyExists, err.Error())
+	case apierrs.IsConflict(err):
+		return status.Error(codes.Aborted, err.Error())
+	case apierrs.IsInvalid(err), apierrs.IsBadRequest(err):
+		return status.Error(codes.InvalidArgument, err.Error())
+	case apierrs.IsForbidden(err):
+		return status.Error(codes.PermissionDenied, err.Error())
+	}
+	return status.Error(codes.Internal, err.Error())
+}
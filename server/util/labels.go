@@ -0,0 +1,28 @@
+package util
+
+import (
+	"github.com/keikoproj/manager/internal/config/common"
+	"github.com/keikoproj/manager/internal/utils"
+)
+
+//controllerLabels are owned by the controllers and can't be changed through the api
+var controllerLabels = []string{common.ApplicationLabel, common.EnvironmentLabel}
+
+//MergeLabels merges the requested labels into the existing labels.
+//Labels owned by the controllers are always kept as is
+func MergeLabels(existing map[string]string, requested map[string]string) map[string]string {
+	if len(requested) == 0 {
+		return existing
+	}
+	merged := make(map[string]string, len(existing)+len(requested))
+	for k, v := range existing {
+		merged[k] = v
+	}
+	for k, v := range requested {
+		if utils.ContainsString(controllerLabels, k) {
+			continue
+		}
+		merged[k] = v
+	}
+	return merged
+}
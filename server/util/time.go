@@ -0,0 +1,15 @@
+package util
+
+import (
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+//Timestamp formats the time in RFC3339 the same way as in the custom resources. Empty string is returned for nil or zero time
+func Timestamp(t *metav1.Time) string {
+	if t == nil || t.IsZero() {
+		return ""
+	}
+	return t.UTC().Format(time.RFC3339)
+}
@@ -0,0 +1,175 @@
+package watch
+
+import (
+	"context"
+	"fmt"
+	"github.com/keikoproj/manager/api/v1alpha1"
+	"github.com/keikoproj/manager/internal/config/common"
+	apis "github.com/keikoproj/manager/pkg/grpc/proto/apis"
+	"github.com/keikoproj/manager/pkg/log"
+	"github.com/keikoproj/manager/pkg/watch"
+	"github.com/keikoproj/manager/server/application"
+	"github.com/keikoproj/manager/server/cluster"
+	"github.com/keikoproj/manager/server/namespace"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/grpc/status"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	toolscache "k8s.io/client-go/tools/cache"
+)
+
+const (
+	KindCluster          = "Cluster"
+	KindManagedNamespace = "ManagedNamespace"
+	KindApplication      = "Application"
+
+	//historySize is the number of events kept to resume the watch from
+	historySize = 1000
+	//bufferSize is the number of events buffered for each watcher before it gets dropped
+	bufferSize = 100
+)
+
+type watchService struct {
+	broadcaster *watch.Broadcaster
+	cache       cache.Cache
+}
+
+//New returns the watch service which streams the changes observed by the informers in the cache
+//Cache must be started after this to receive the events
+func New(ctx context.Context, informers cache.Cache) (*watchService, error) {
+	log := log.Logger(ctx, "server.watch", "New")
+
+	w := &watchService{
+		broadcaster: watch.NewBroadcaster(historySize, bufferSize),
+		cache:       informers,
+	}
+	for _, obj := range []runtime.Object{&v1alpha1.Cluster{}, &v1alpha1.ManagedNamespace{}, &v1alpha1.Application{}} {
+		informer, err := informers.GetInformer(obj)
+		if err != nil {
+			log.Error(err, "unable to get the informer", "type", fmt.Sprintf("%T", obj))
+			return nil, err
+		}
+		informer.AddEventHandler(toolscache.ResourceEventHandlerFuncs{
+			AddFunc: func(obj interface{}) {
+				w.publish(watch.Added, obj)
+			},
+			UpdateFunc: func(oldObj, newObj interface{}) {
+				w.publish(watch.Modified, newObj)
+			},
+			DeleteFunc: func(obj interface{}) {
+				if tombstone, ok := obj.(toolscache.DeletedFinalStateUnknown); ok {
+					obj = tombstone.Obj
+				}
+				w.publish(watch.Deleted, obj)
+			},
+		})
+	}
+	return w, nil
+}
+
+//Watch streams the events matching the request until the client cancels the request
+func (w *watchService) Watch(req *apis.WatchRequest, stream apis.WatchService_WatchServer) error {
+	log := log.Logger(stream.Context(), "server.watch", "Watch")
+	log.Info("Request received", "kinds", req.Kinds, "name", req.Name, "resourceVersion", req.ResourceVersion)
+
+	filter, err := newFilter(req)
+	if err != nil {
+		return status.Error(codes.InvalidArgument, err.Error())
+	}
+	sub, err := w.broadcaster.Subscribe(req.ResourceVersion, filter)
+	if err == watch.ErrExpired {
+		return status.Error(codes.OutOfRange, err.Error())
+	}
+	if err != nil {
+		return status.Error(codes.Internal, err.Error())
+	}
+	defer w.broadcaster.Unsubscribe(sub)
+
+	for {
+		select {
+		case <-stream.Context().Done():
+			return nil
+		case event, ok := <-sub.Events():
+			if !ok {
+				return status.Error(codes.ResourceExhausted, "watcher is too slow to keep up with the events")
+			}
+			if err := stream.Send(event); err != nil {
+				log.Error(err, "unable to send the event")
+				return err
+			}
+		}
+	}
+}
+
+//publish converts the object to the event and hands it over to the broadcaster
+func (w *watchService) publish(eventType string, obj interface{}) {
+	event := &apis.WatchEvent{
+		Type: eventType,
+	}
+	switch o := obj.(type) {
+	case *v1alpha1.Cluster:
+		event.Kind = KindCluster
+		event.Name = o.Name
+		event.ResourceVersion = o.ResourceVersion
+		event.Labels = o.Labels
+		event.State = string(o.Status.State)
+		event.ErrorDescription = o.Status.ErrorDescription
+		event.Object = &apis.WatchEvent_Cluster{Cluster: cluster.ToProto(o)}
+	case *v1alpha1.ManagedNamespace:
+		event.Kind = KindManagedNamespace
+		event.Name = o.Name
+		event.ResourceVersion = o.ResourceVersion
+		event.Labels = o.Labels
+		event.State = string(o.Status.State)
+		event.ErrorDescription = o.Status.ErrorDescription
+		event.Object = &apis.WatchEvent_ManagedNamespace{ManagedNamespace: namespace.ToProto(o)}
+	case *v1alpha1.Application:
+		event.Kind = KindApplication
+		event.Name = o.Name
+		event.ResourceVersion = o.ResourceVersion
+		event.Labels = o.Labels
+		event.State = string(o.Status.State)
+		event.ErrorDescription = o.Status.ErrorDescription
+		event.Object = &apis.WatchEvent_Application{Application: application.ToProto(o, w.lookupManagedNamespace)}
+	default:
+		return
+	}
+	w.broadcaster.Publish(event)
+}
+
+//lookupManagedNamespace returns the managed namespace from the cache
+func (w *watchService) lookupManagedNamespace(name string) *v1alpha1.ManagedNamespace {
+	mns := &v1alpha1.ManagedNamespace{}
+	if err := w.cache.Get(context.Background(), client.ObjectKey{Namespace: common.ManagerDeployedNamespace, Name: name}, mns); err != nil {
+		return nil
+	}
+	return mns
+}
+
+func newFilter(req *apis.WatchRequest) (watch.Filter, error) {
+	kinds := make(map[string]bool)
+	for _, kind := range req.Kinds {
+		switch kind {
+		case KindCluster, KindManagedNamespace, KindApplication:
+			kinds[kind] = true
+		default:
+			return nil, fmt.Errorf("unknown kind %s. Allowed values are %s, %s and %s", kind, KindCluster, KindManagedNamespace, KindApplication)
+		}
+	}
+	selector, err := labels.Parse(req.LabelSelector)
+	if err != nil {
+		return nil, err
+	}
+	return func(event *apis.WatchEvent) bool {
+		if len(kinds) > 0 && !kinds[event.Kind] {
+			return false
+		}
+		if req.Name != "" && event.Name != req.Name {
+			return false
+		}
+		return selector.Matches(labels.Set(event.Labels))
+	}, nil
+}